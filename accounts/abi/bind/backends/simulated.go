@@ -87,11 +87,19 @@ func NewSimulatedBackend(alloc core.GenesisAlloc, gasLimit uint64) *SimulatedBac
 //
 // Create a simulated backend based on existing Ethereum service
 func NewSimulatedBackendFrom(ethereum *eth.Ethereum) *SimulatedBackend {
+	return NewSimulatedBackendFromChain(ethereum.ChainDb(), ethereum.BlockChain())
+}
+
+// Quorum
+//
+// Create a simulated backend on top of an existing chain, e.g. to call the
+// contracts of a node which is not running
+func NewSimulatedBackendFromChain(database ethdb.Database, blockchain *core.BlockChain) *SimulatedBackend {
 	backend := &SimulatedBackend{
-		database:   ethereum.ChainDb(),
-		blockchain: ethereum.BlockChain(),
-		config:     ethereum.ChainConfig(),
-		events:     filters.NewEventSystem(new(event.TypeMux), &filterBackend{ethereum.ChainDb(), ethereum.BlockChain()}, false),
+		database:   database,
+		blockchain: blockchain,
+		config:     blockchain.Config(),
+		events:     filters.NewEventSystem(new(event.TypeMux), &filterBackend{database, blockchain}, false),
 	}
 	backend.rollback()
 	return backend
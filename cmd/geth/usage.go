@@ -69,7 +69,9 @@ var AppHelpFlagGroups = []flagGroup{
 		Flags: []cli.Flag{
 			configFileFlag,
 			utils.DataDirFlag,
+			utils.AncientFlag,
 			utils.KeyStoreDirFlag,
+			utils.DBEngineFlag,
 			utils.NoUSBFlag,
 			utils.NetworkIdFlag,
 			utils.TestnetFlag,
@@ -77,6 +79,7 @@ var AppHelpFlagGroups = []flagGroup{
 			utils.OttomanFlag,
 			utils.SyncModeFlag,
 			utils.GCModeFlag,
+			utils.SnapshotFlag,
 			utils.EthStatsURLFlag,
 			utils.IdentityFlag,
 			utils.LightServFlag,
@@ -126,6 +129,10 @@ var AppHelpFlagGroups = []flagGroup{
 			utils.TxPoolAccountQueueFlag,
 			utils.TxPoolGlobalQueueFlag,
 			utils.TxPoolLifetimeFlag,
+			utils.TxPoolSenderRateFlag,
+			utils.TxPoolSenderBurstFlag,
+			utils.TxPoolPeerRateFlag,
+			utils.TxPoolPeerBurstFlag,
 		},
 	},
 	{
@@ -152,6 +159,10 @@ var AppHelpFlagGroups = []flagGroup{
 		Name: "QUORUM",
 		Flags: []cli.Flag{
 			utils.EnableNodePermissionFlag,
+			utils.PermissionOrgCacheFlag,
+			utils.PermissionNodeCacheFlag,
+			utils.PermissionRoleCacheFlag,
+			utils.PermissionAccountCacheFlag,
 		},
 	},
 	{
@@ -186,6 +197,7 @@ var AppHelpFlagGroups = []flagGroup{
 			utils.IPCPathFlag,
 			utils.RPCCORSDomainFlag,
 			utils.RPCVirtualHostsFlag,
+			utils.RPCAuthFlag,
 			utils.JSpathFlag,
 			utils.ExecFlag,
 			utils.PreloadJSFlag,
@@ -275,6 +287,7 @@ var AppHelpFlagGroups = []flagGroup{
 		Flags: []cli.Flag{
 			utils.IstanbulRequestTimeoutFlag,
 			utils.IstanbulBlockPeriodFlag,
+			utils.IstanbulEjectOnEquivocationFlag,
 		},
 	},
 	{
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ethereum/quorum/accounts/abi/bind/backends"
+	"github.com/ethereum/quorum/cmd/utils"
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/node"
+	"github.com/ethereum/quorum/params"
+	"github.com/ethereum/quorum/permission"
+	"gopkg.in/urfave/cli.v1"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	permissionAttachFlag = cli.StringFlag{
+		Name:  "attach",
+		Value: node.DefaultIPCEndpoint(clientIdentifier),
+		Usage: "API endpoint to attach to",
+	}
+	permissionFromFlag = cli.StringFlag{
+		Name:  "from",
+		Usage: "Unlocked account sending the permission transactions",
+	}
+	permissionDryRunFlag = cli.BoolFlag{
+		Name:  "dryrun",
+		Usage: "Validate the plan without sending any transaction",
+	}
+	permissionConfigOnlyFlag = cli.BoolFlag{
+		Name:  "configonly",
+		Usage: "Only validate the config, without checking the contracts in the chain",
+	}
+
+	permissionCommand = cli.Command{
+		Name:     "permission",
+		Usage:    "Manage the permissions of a running node",
+		Category: "PERMISSION COMMANDS",
+		Subcommands: []cli.Command{
+			{
+				Name:      "apply",
+				Usage:     "Apply a declarative permission plan",
+				ArgsUsage: "<planFile>",
+				Action:    utils.MigrateFlags(applyPermissionPlan),
+				Category:  "PERMISSION COMMANDS",
+				Flags: []cli.Flag{
+					permissionAttachFlag,
+					permissionFromFlag,
+					permissionDryRunFlag,
+				},
+				Description: `
+    quorumd permission apply --from <account> [--dryrun] plan.yaml
+
+reads the orgs, sub orgs, roles, accounts and nodes of a YAML or JSON plan and
+sends the transactions adding what is missing to the node attached to, using
+quorumPermission_applyPlan. Every step is validated first, nothing is sent if
+any step is invalid. Steps depending on earlier steps awaiting approval are
+deferred, run the command again once those are approved.`,
+			},
+			{
+				Name:     "check",
+				Usage:    "Check the permission config of the node",
+				Action:   utils.MigrateFlags(checkPermissionConfig),
+				Category: "PERMISSION COMMANDS",
+				Flags: []cli.Flag{
+					utils.DataDirFlag,
+					utils.AncientFlag,
+					utils.CacheFlag,
+					permissionConfigOnlyFlag,
+				},
+				Description: `
+    quorumd permission check [--configonly]
+
+validates the permission-config.json of the data directory: the contract
+addresses, the network admin org and roles, the accounts and the sub org
+breadth and depth. Unless --configonly is given, the contracts are checked in
+the local chain as well: code must be deployed at every address, the
+upgradable contract must point to the interface and, once the network booted,
+the network admin org and roles must match. Every problem found is reported.
+Run it before starting the node, as the chain database is opened.`,
+			},
+		},
+	}
+)
+
+// applyPermissionPlan reads the plan file and applies it on the attached node.
+func applyPermissionPlan(ctx *cli.Context) error {
+	if len(ctx.Args()) != 1 {
+		utils.Fatalf("This command requires an argument.")
+	}
+	if !common.IsHexAddress(ctx.String(permissionFromFlag.Name)) {
+		utils.Fatalf("Invalid --%s account", permissionFromFlag.Name)
+	}
+	blob, err := ioutil.ReadFile(ctx.Args().First())
+	if err != nil {
+		utils.Fatalf("Failed to read plan: %v", err)
+	}
+	// JSON documents are valid YAML
+	var plan permission.Plan
+	if err := yaml.UnmarshalStrict(blob, &plan); err != nil {
+		utils.Fatalf("Failed to parse plan: %v", err)
+	}
+	client, err := dialRPC(ctx.String(permissionAttachFlag.Name))
+	if err != nil {
+		utils.Fatalf("Unable to attach to node: %v", err)
+	}
+	defer client.Close()
+
+	txa := map[string]interface{}{"from": common.HexToAddress(ctx.String(permissionFromFlag.Name))}
+	var result permission.PlanResult
+	if err := client.Call(&result, "quorumPermission_applyPlan", plan, ctx.Bool(permissionDryRunFlag.Name), txa); err != nil {
+		utils.Fatalf("Failed to apply plan: %v", err)
+	}
+	printPlanResult(result)
+
+	switch {
+	case len(result.Steps) == 0:
+		fmt.Println("Nothing to do, the permissions match the plan")
+	case result.Applied:
+		fmt.Println("Plan applied")
+	case ctx.Bool(permissionDryRunFlag.Name):
+		fmt.Println("Dry run, no transaction sent")
+	default:
+		utils.Fatalf("Plan not applied")
+	}
+	return nil
+}
+
+// printPlanResult prints the steps of a plan, one per line.
+func printPlanResult(result permission.PlanResult) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	for _, step := range result.Steps {
+		var details []string
+		if step.RoleId != "" {
+			details = append(details, "role="+step.RoleId)
+		}
+		if step.Account != nil {
+			details = append(details, "account="+step.Account.Hex())
+		}
+		if step.EnodeId != "" {
+			details = append(details, "enode="+step.EnodeId)
+		}
+		status := step.Status
+		if step.Msg != "" {
+			status += ": " + step.Msg
+		}
+		if step.TxHash != nil {
+			status += " " + step.TxHash.Hex()
+		}
+		fmt.Fprintf(w, "+ %s\t%s\t%s\t%s\n", step.Action, step.OrgId, strings.Join(details, " "), status)
+	}
+	w.Flush()
+}
+
+// checkPermissionConfig reports the problems of the permission config of the
+// data directory and of the contracts it refers to.
+func checkPermissionConfig(ctx *cli.Context) error {
+	stack, _ := makeConfigNode(ctx)
+	config, err := permission.ParsePermissionConfig(stack.DataDir())
+	errs, invalid := err.(permission.ConfigErrors)
+	switch {
+	case invalid:
+	case err != nil:
+		utils.Fatalf("Failed to load %s: %v", params.PERMISSION_MODEL_CONFIG, err)
+	case !ctx.Bool(permissionConfigOnlyFlag.Name):
+		chain, chainDb := utils.MakeChain(ctx, stack)
+		defer chainDb.Close()
+		errs = permission.CheckPermissionContracts(&config, backends.NewSimulatedBackendFromChain(chainDb, chain))
+	}
+	for _, err := range errs {
+		fmt.Println("-", err)
+	}
+	if len(errs) > 0 {
+		utils.Fatalf("Found %d problems in %s", len(errs), params.PERMISSION_MODEL_CONFIG)
+	}
+	fmt.Printf("%s is valid\n", params.PERMISSION_MODEL_CONFIG)
+	return nil
+}
@@ -0,0 +1,143 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of go-ethereum.
+//
+// go-ethereum is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-ethereum is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-ethereum. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"github.com/ethereum/quorum/cmd/utils"
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/core"
+	"github.com/ethereum/quorum/core/rawdb"
+	"github.com/ethereum/quorum/core/state/pruner"
+	"github.com/ethereum/quorum/core/state/snapshot"
+	"github.com/ethereum/quorum/core/types"
+	"github.com/ethereum/quorum/ethdb"
+	"github.com/ethereum/quorum/log"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var (
+	pruneKeepFlag = cli.Uint64Flag{
+		Name:  "keep",
+		Usage: "Number of most recent blocks whose state is kept",
+		Value: 128,
+	}
+	pruneBloomSizeFlag = cli.Uint64Flag{
+		Name:  "bloomfilter.size",
+		Usage: "Megabytes of memory allocated to the bloom filter of the kept state",
+		Value: 2048,
+	}
+
+	snapshotCommand = cli.Command{
+		Name:     "snapshot",
+		Usage:    "Manage the state of the chain database",
+		Category: "BLOCKCHAIN COMMANDS",
+		Subcommands: []cli.Command{
+			{
+				Name:     "prune-state",
+				Usage:    "Delete the public and private state of old blocks",
+				Action:   utils.MigrateFlags(pruneState),
+				Category: "BLOCKCHAIN COMMANDS",
+				Flags: []cli.Flag{
+					utils.DataDirFlag,
+					utils.AncientFlag,
+					utils.DBEngineFlag,
+					utils.CacheFlag,
+					utils.CacheDatabaseFlag,
+					pruneKeepFlag,
+					pruneBloomSizeFlag,
+				},
+				Description: `
+    quorumd snapshot prune-state [--keep <blocks>] [--bloomfilter.size <MB>]
+
+deletes the public and private state of all the blocks but the genesis block
+and the most recent ones, 128 by default. The trie nodes and contract codes
+reachable from the kept public state roots and from the private state roots
+they map to are marked first, then every other one is deleted and the database
+is compacted. Nothing is deleted if the state of the head block is missing.
+
+The node must be stopped while pruning. A larger bloom filter keeps less of the
+deleted state by mistake, it should be at least a few bytes per trie node.`,
+			},
+			{
+				Name:     "verify-state",
+				Usage:    "Verify the flat snapshots of the public and private state",
+				Action:   utils.MigrateFlags(verifyState),
+				Category: "BLOCKCHAIN COMMANDS",
+				Flags: []cli.Flag{
+					utils.DataDirFlag,
+					utils.AncientFlag,
+					utils.DBEngineFlag,
+					utils.CacheFlag,
+					utils.CacheDatabaseFlag,
+				},
+				Description: `
+    quorumd snapshot verify-state
+
+rebuilds the account and storage tries from the flat snapshots of the public
+and private state written by a node running with --snapshot, and checks they
+hash to the state roots of the snapshots. The node must be stopped, it writes
+the snapshots of its head states on shutdown.`,
+			},
+		},
+	}
+)
+
+func pruneState(ctx *cli.Context) error {
+	stack, _ := makeConfigNode(ctx)
+	db := utils.MakeChainDatabase(ctx, stack)
+	defer db.Close()
+
+	bloomSize := ctx.Uint64(pruneBloomSizeFlag.Name) * 1024 * 1024
+	if err := pruner.NewPruner(db, bloomSize).Prune(ctx.Uint64(pruneKeepFlag.Name)); err != nil {
+		utils.Fatalf("Failed to prune the state: %v", err)
+	}
+	return nil
+}
+
+func verifyState(ctx *cli.Context) error {
+	stack, _ := makeConfigNode(ctx)
+	db := utils.MakeChainDatabase(ctx, stack)
+	defer db.Close()
+
+	head := rawdb.ReadHeadBlockHash(db)
+	number := rawdb.ReadHeaderNumber(db, head)
+	if number == nil {
+		utils.Fatalf("Head block not found")
+	}
+	header := rawdb.ReadHeader(db, head, *number)
+
+	privateRoot := core.GetPrivateStateRoot(db, header.Root)
+	if privateRoot == (common.Hash{}) {
+		privateRoot = types.EmptyRootHash
+	}
+	for _, state := range []struct {
+		name string
+		db   ethdb.Database
+		root common.Hash
+	}{
+		{"public", db, header.Root},
+		{"private", ethdb.NewTable(db, core.PrivateSnapshotPrefix), privateRoot},
+	} {
+		if root := snapshot.Root(state.db); root != state.root {
+			log.Warn("Snapshot is not of the head state, it is regenerated on startup", "state", state.name, "snapshot", root, "head", state.root)
+		}
+		if err := snapshot.Verify(state.db); err != nil {
+			utils.Fatalf("Invalid %s state snapshot: %v", state.name, err)
+		}
+	}
+	return nil
+}
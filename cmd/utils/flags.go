@@ -40,6 +40,7 @@ import (
 	"github.com/ethereum/quorum/consensus/ethash"
 	"github.com/ethereum/quorum/core"
 	"github.com/ethereum/quorum/core/state"
+	"github.com/ethereum/quorum/core/types"
 	"github.com/ethereum/quorum/core/vm"
 	"github.com/ethereum/quorum/crypto"
 	"github.com/ethereum/quorum/dashboard"
@@ -122,10 +123,19 @@ var (
 		Usage: "Data directory for the databases and keystore",
 		Value: DirectoryString{node.DefaultDataDir()},
 	}
+	AncientFlag = DirectoryFlag{
+		Name:  "datadir.ancient",
+		Usage: "Data directory for ancient chain segments (default = inside chaindata)",
+	}
 	KeyStoreDirFlag = DirectoryFlag{
 		Name:  "keystore",
 		Usage: "Directory for the keystore (default = inside the datadir)",
 	}
+	DBEngineFlag = cli.StringFlag{
+		Name:  "db.engine",
+		Usage: "Storage engine of the databases (" + strings.Join(ethdb.Engines(), ", ") + ")",
+		Value: ethdb.DefaultEngine,
+	}
 	NoUSBFlag = cli.BoolFlag{
 		Name:  "nousb",
 		Usage: "Disables monitoring for and managing USB hardware wallets",
@@ -175,6 +185,10 @@ var (
 		Usage: `Blockchain garbage collection mode ("full", "archive")`,
 		Value: "full",
 	}
+	SnapshotFlag = cli.BoolFlag{
+		Name:  "snapshot",
+		Usage: "Read the public and private states through flat snapshots, generated on startup if missing",
+	}
 	LightServFlag = cli.IntFlag{
 		Name:  "lightserv",
 		Usage: "Maximum percentage of time allowed for serving LES requests (0-90)",
@@ -293,6 +307,26 @@ var (
 		Usage: "Maximum amount of time non-executable transaction are queued",
 		Value: eth.DefaultConfig.TxPool.Lifetime,
 	}
+	TxPoolSenderRateFlag = cli.Float64Flag{
+		Name:  "txpool.senderrate",
+		Usage: "Remote transactions admitted per second per sender (0 = unlimited)",
+		Value: eth.DefaultConfig.TxPool.SenderRate,
+	}
+	TxPoolSenderBurstFlag = cli.Uint64Flag{
+		Name:  "txpool.senderburst",
+		Usage: "Remote transactions admitted at once per sender",
+		Value: eth.DefaultConfig.TxPool.SenderBurst,
+	}
+	TxPoolPeerRateFlag = cli.Float64Flag{
+		Name:  "txpool.peerrate",
+		Usage: "Transactions admitted per second per relaying peer (0 = unlimited)",
+		Value: eth.DefaultConfig.TxPool.PeerRate,
+	}
+	TxPoolPeerBurstFlag = cli.Uint64Flag{
+		Name:  "txpool.peerburst",
+		Usage: "Transactions admitted at once per relaying peer",
+		Value: eth.DefaultConfig.TxPool.PeerBurst,
+	}
 	// Performance tuning settings
 	CacheFlag = cli.IntFlag{
 		Name:  "cache",
@@ -439,6 +473,10 @@ var (
 		Usage: "Comma separated list of virtual hostnames from which to accept requests (server enforced). Accepts '*' wildcard.",
 		Value: strings.Join(node.DefaultConfig.HTTPVirtualHosts, ","),
 	}
+	RPCAuthFlag = cli.StringFlag{
+		Name:  "rpcauth",
+		Usage: "JSON file configuring the JWT issuers and TLS client certificates authenticating HTTP-RPC and WS-RPC clients",
+	}
 	RPCApiFlag = cli.StringFlag{
 		Name:  "rpcapi",
 		Usage: "API's offered over the HTTP-RPC interface",
@@ -609,6 +647,26 @@ var (
 		Name:  "permissioned",
 		Usage: "If enabled, the node will allow only a defined list of nodes to connect",
 	}
+	PermissionOrgCacheFlag = cli.IntFlag{
+		Name:  "permissioned.cache.orgs",
+		Usage: "Number of orgs held in the permission cache",
+		Value: types.DefaultOrgMapLimit,
+	}
+	PermissionNodeCacheFlag = cli.IntFlag{
+		Name:  "permissioned.cache.nodes",
+		Usage: "Number of nodes held in the permission cache",
+		Value: types.DefaultNodeMapLimit,
+	}
+	PermissionRoleCacheFlag = cli.IntFlag{
+		Name:  "permissioned.cache.roles",
+		Usage: "Number of roles held in the permission cache",
+		Value: types.DefaultRoleMapLimit,
+	}
+	PermissionAccountCacheFlag = cli.IntFlag{
+		Name:  "permissioned.cache.accounts",
+		Usage: "Number of accounts held in the permission cache",
+		Value: types.DefaultAccountMapLimit,
+	}
 
 	// Istanbul settings
 	IstanbulRequestTimeoutFlag = cli.Uint64Flag{
@@ -621,6 +679,10 @@ var (
 		Usage: "Default minimum difference between two consecutive block's timestamps in seconds",
 		Value: eth.DefaultConfig.Istanbul.BlockPeriod,
 	}
+	IstanbulEjectOnEquivocationFlag = cli.BoolFlag{
+		Name:  "istanbul.ejectonequivocation",
+		Usage: "Vote validators caught signing conflicting messages out of the validator set",
+	}
 
 	// Metrics flags
 	MetricsEnabledFlag = cli.BoolFlag{
@@ -1022,6 +1084,9 @@ func SetNodeConfig(ctx *cli.Context, cfg *node.Config) {
 	setHTTP(ctx, cfg)
 	setWS(ctx, cfg)
 	setNodeUserIdent(ctx, cfg)
+	if ctx.GlobalIsSet(RPCAuthFlag.Name) {
+		cfg.RPCAuthFile = ctx.GlobalString(RPCAuthFlag.Name)
+	}
 
 	cfg.EnableNodePermission = ctx.GlobalBool(EnableNodePermissionFlag.Name)
 
@@ -1038,6 +1103,9 @@ func SetNodeConfig(ctx *cli.Context, cfg *node.Config) {
 		cfg.DataDir = filepath.Join(node.DefaultDataDir(), "ottoman")
 	}
 
+	if ctx.GlobalIsSet(DBEngineFlag.Name) {
+		cfg.DBEngine = ctx.GlobalString(DBEngineFlag.Name)
+	}
 	if ctx.GlobalIsSet(KeyStoreDirFlag.Name) {
 		cfg.KeyStoreDir = ctx.GlobalString(KeyStoreDirFlag.Name)
 	}
@@ -1099,6 +1167,18 @@ func setTxPool(ctx *cli.Context, cfg *core.TxPoolConfig) {
 	if ctx.GlobalIsSet(TxPoolLifetimeFlag.Name) {
 		cfg.Lifetime = ctx.GlobalDuration(TxPoolLifetimeFlag.Name)
 	}
+	if ctx.GlobalIsSet(TxPoolSenderRateFlag.Name) {
+		cfg.SenderRate = ctx.GlobalFloat64(TxPoolSenderRateFlag.Name)
+	}
+	if ctx.GlobalIsSet(TxPoolSenderBurstFlag.Name) {
+		cfg.SenderBurst = ctx.GlobalUint64(TxPoolSenderBurstFlag.Name)
+	}
+	if ctx.GlobalIsSet(TxPoolPeerRateFlag.Name) {
+		cfg.PeerRate = ctx.GlobalFloat64(TxPoolPeerRateFlag.Name)
+	}
+	if ctx.GlobalIsSet(TxPoolPeerBurstFlag.Name) {
+		cfg.PeerBurst = ctx.GlobalUint64(TxPoolPeerBurstFlag.Name)
+	}
 }
 
 func setEthash(ctx *cli.Context, cfg *eth.Config) {
@@ -1129,6 +1209,9 @@ func setIstanbul(ctx *cli.Context, cfg *eth.Config) {
 	if ctx.GlobalIsSet(IstanbulBlockPeriodFlag.Name) {
 		cfg.Istanbul.BlockPeriod = ctx.GlobalUint64(IstanbulBlockPeriodFlag.Name)
 	}
+	if ctx.GlobalIsSet(IstanbulEjectOnEquivocationFlag.Name) {
+		cfg.Istanbul.EjectOnEquivocation = ctx.GlobalBool(IstanbulEjectOnEquivocationFlag.Name)
+	}
 }
 
 // checkExclusive verifies that only a single instance of the provided flags was
@@ -1215,6 +1298,9 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *eth.Config) {
 		cfg.DatabaseCache = ctx.GlobalInt(CacheFlag.Name) * ctx.GlobalInt(CacheDatabaseFlag.Name) / 100
 	}
 	cfg.DatabaseHandles = makeDatabaseHandles()
+	if ctx.GlobalIsSet(AncientFlag.Name) {
+		cfg.DatabaseFreezer = ctx.GlobalString(AncientFlag.Name)
+	}
 
 	if gcmode := ctx.GlobalString(GCModeFlag.Name); gcmode != "full" && gcmode != "archive" {
 		Fatalf("--%s must be either 'full' or 'archive'", GCModeFlag.Name)
@@ -1230,6 +1316,7 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *eth.Config) {
 	if ctx.GlobalIsSet(CacheFlag.Name) || ctx.GlobalIsSet(CacheGCFlag.Name) {
 		cfg.TrieCache = ctx.GlobalInt(CacheFlag.Name) * ctx.GlobalInt(CacheGCFlag.Name) / 100
 	}
+	cfg.Snapshot = ctx.GlobalBool(SnapshotFlag.Name)
 	if ctx.GlobalIsSet(MinerNotifyFlag.Name) {
 		cfg.MinerNotify = strings.Split(ctx.GlobalString(MinerNotifyFlag.Name), ",")
 	}
@@ -1396,6 +1483,13 @@ func RegisterEthStatsService(stack *node.Node, url string) {
 //
 // Configure smart-contract-based permissioning service
 func RegisterPermissionService(ctx *cli.Context, stack *node.Node) {
+	for _, flag := range []cli.IntFlag{PermissionOrgCacheFlag, PermissionNodeCacheFlag, PermissionRoleCacheFlag, PermissionAccountCacheFlag} {
+		if ctx.GlobalInt(flag.Name) <= 0 {
+			Fatalf("Invalid --%s size, must be positive", flag.Name)
+		}
+	}
+	types.SetCacheSizes(ctx.GlobalInt(PermissionOrgCacheFlag.Name), ctx.GlobalInt(PermissionNodeCacheFlag.Name),
+		ctx.GlobalInt(PermissionRoleCacheFlag.Name), ctx.GlobalInt(PermissionAccountCacheFlag.Name))
 	if err := stack.Register(func(sctx *node.ServiceContext) (node.Service, error) {
 		permissionConfig, err := permission.ParsePermissionConfig(stack.DataDir())
 		if err != nil {
@@ -1439,12 +1533,15 @@ func MakeChainDatabase(ctx *cli.Context, stack *node.Node) ethdb.Database {
 	var (
 		cache   = ctx.GlobalInt(CacheFlag.Name) * ctx.GlobalInt(CacheDatabaseFlag.Name) / 100
 		handles = makeDatabaseHandles()
+
+		chainDb ethdb.Database
+		err     error
 	)
-	name := "chaindata"
 	if ctx.GlobalString(SyncModeFlag.Name) == "light" {
-		name = "lightchaindata"
+		chainDb, err = stack.OpenDatabase("lightchaindata", cache, handles)
+	} else {
+		chainDb, err = stack.OpenDatabaseWithFreezer("chaindata", cache, handles, ctx.GlobalString(AncientFlag.Name), "")
 	}
-	chainDb, err := stack.OpenDatabase(name, cache, handles)
 	if err != nil {
 		Fatalf("Could not open database: %v", err)
 	}
@@ -1506,6 +1603,7 @@ func MakeChain(ctx *cli.Context, stack *node.Node) (chain *core.BlockChain, chai
 		Disabled:      trieWriteCacheDisabled,
 		TrieNodeLimit: eth.DefaultConfig.TrieCache,
 		TrieTimeLimit: eth.DefaultConfig.TrieTimeout,
+		Snapshots:     ctx.GlobalBool(SnapshotFlag.Name),
 	}
 	if ctx.GlobalIsSet(CacheFlag.Name) || ctx.GlobalIsSet(CacheGCFlag.Name) {
 		cache.TrieNodeLimit = ctx.GlobalInt(CacheFlag.Name) * ctx.GlobalInt(CacheGCFlag.Name) / 100
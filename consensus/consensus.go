@@ -140,4 +140,11 @@ type Istanbul interface {
 
 	// Stop stops the engine
 	Stop() error
+
+	// StartAnnouncing starts advertising the local enode to the other validators
+	// and keeps direct connections to them through the given pool
+	StartAnnouncing(chain ChainReader, pool ValidatorPool) error
+
+	// StopAnnouncing stops advertising the local enode
+	StopAnnouncing() error
 }
@@ -71,5 +71,8 @@ type Backend interface {
 	// HasBadBlock returns whether the block with the hash is a bad block
 	HasBadProposal(hash common.Hash) bool
 
+	// RecordEvidence persists the proof that a validator equivocated
+	RecordEvidence(evidence *Evidence) error
+
 	Close() error
 }
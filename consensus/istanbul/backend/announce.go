@@ -0,0 +1,309 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/consensus"
+	"github.com/ethereum/quorum/consensus/istanbul"
+	"github.com/ethereum/quorum/consensus/istanbul/validator"
+	"github.com/ethereum/quorum/crypto"
+	"github.com/ethereum/quorum/p2p/enode"
+	"github.com/ethereum/quorum/rlp"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+const (
+	// announceInterval is the time between two announcements of the local enode
+	announceInterval = 1 * time.Minute
+)
+
+var (
+	// errInvalidAnnounce is returned when the enode of an announcement does not
+	// belong to the validator that signed it.
+	errInvalidAnnounce = errors.New("invalid validator announcement")
+	// errStartedAnnounce is returned if the announcements are already running
+	errStartedAnnounce = errors.New("started announcing")
+	// errStoppedAnnounce is returned if the announcements are not running
+	errStoppedAnnounce = errors.New("stopped announcing")
+)
+
+// announceMessage advertises the enode URL a validator can be reached at.
+type announceMessage struct {
+	Address   common.Address
+	Enode     string
+	Timestamp uint64
+	Signature []byte
+}
+
+// payloadNoSig returns the signed part of the announcement.
+func (m *announceMessage) payloadNoSig() ([]byte, error) {
+	return rlp.EncodeToBytes([]interface{}{m.Address, m.Enode, m.Timestamp})
+}
+
+// node verifies the signature of the announcement and returns the advertised node.
+func (m *announceMessage) node() (*enode.Node, error) {
+	data, err := m.payloadNoSig()
+	if err != nil {
+		return nil, err
+	}
+	signer, err := istanbul.GetSignatureAddress(data, m.Signature)
+	if err != nil {
+		return nil, err
+	}
+	if signer != m.Address {
+		return nil, errInvalidSignature
+	}
+	node, err := enode.ParseV4(m.Enode)
+	if err != nil {
+		return nil, err
+	}
+	// Validators sign with their node key, so the enode has to match the signer
+	if crypto.PubkeyToAddress(*node.Pubkey()) != m.Address {
+		return nil, errInvalidAnnounce
+	}
+	return node, nil
+}
+
+// StartAnnouncing implements consensus.Istanbul.StartAnnouncing
+func (sb *backend) StartAnnouncing(chain consensus.ChainReader, pool consensus.ValidatorPool) error {
+	sb.announceMu.Lock()
+	defer sb.announceMu.Unlock()
+	if sb.announceQuit != nil {
+		return errStartedAnnounce
+	}
+
+	sb.announceChain = chain
+	sb.validatorPool = pool
+	sb.announceQuit = make(chan struct{})
+
+	go sb.announceLoop(sb.announceQuit)
+	return nil
+}
+
+// StopAnnouncing implements consensus.Istanbul.StopAnnouncing
+func (sb *backend) StopAnnouncing() error {
+	sb.announceMu.Lock()
+	defer sb.announceMu.Unlock()
+	if sb.announceQuit == nil {
+		return errStoppedAnnounce
+	}
+
+	close(sb.announceQuit)
+	sb.announceQuit = nil
+	return nil
+}
+
+func (sb *backend) announceLoop(quit chan struct{}) {
+	ticker := time.NewTicker(announceInterval)
+	defer ticker.Stop()
+
+	sb.announce()
+	for {
+		select {
+		case <-ticker.C:
+			sb.announce()
+		case <-quit:
+			return
+		}
+	}
+}
+
+// announce updates the validator pool for the current validator set and, if
+// the local node is a validator, advertises its enode to the network.
+func (sb *backend) announce() {
+	valSet := sb.headValidators()
+	sb.updateValidatorPool(valSet)
+
+	if _, v := valSet.GetByAddress(sb.Address()); v == nil {
+		return
+	}
+
+	sb.announceMu.Lock()
+	self := sb.validatorPool.Self()
+	sb.announceMu.Unlock()
+
+	msg := &announceMessage{
+		Address:   sb.Address(),
+		Enode:     self.String(),
+		Timestamp: uint64(now().Unix()),
+	}
+	data, err := msg.payloadNoSig()
+	if err != nil {
+		sb.logger.Error("Failed to encode announcement", "err", err)
+		return
+	}
+	if msg.Signature, err = sb.Sign(data); err != nil {
+		sb.logger.Error("Failed to sign announcement", "err", err)
+		return
+	}
+	payload, err := rlp.EncodeToBytes(msg)
+	if err != nil {
+		sb.logger.Error("Failed to encode announcement", "err", err)
+		return
+	}
+	sb.knownMessages.Add(istanbul.RLPHash(payload), true)
+	sb.gossipAnnounce(payload)
+}
+
+// handleAnnounce records the enode of an announcing validator and relays the
+// announcement to the rest of the network.
+func (sb *backend) handleAnnounce(addr common.Address, payload []byte) error {
+	hash := istanbul.RLPHash(payload)
+
+	// Mark peer's message
+	ms, ok := sb.recentMessages.Get(addr)
+	var m *lru.ARCCache
+	if ok {
+		m, _ = ms.(*lru.ARCCache)
+	} else {
+		m, _ = lru.NewARC(inmemoryMessages)
+		sb.recentMessages.Add(addr, m)
+	}
+	m.Add(hash, true)
+
+	// Mark self known message
+	if _, ok := sb.knownMessages.Get(hash); ok {
+		return nil
+	}
+	sb.knownMessages.Add(hash, true)
+
+	msg := new(announceMessage)
+	if err := rlp.DecodeBytes(payload, msg); err != nil {
+		return errDecodeFailed
+	}
+	node, err := msg.node()
+	if err != nil {
+		return err
+	}
+
+	sb.announceMu.Lock()
+	running := sb.announceQuit != nil
+	sb.announceMu.Unlock()
+	if !running {
+		return nil
+	}
+
+	// Only relay announcements of current validators, and ignore outdated ones
+	valSet := sb.headValidators()
+	if _, v := valSet.GetByAddress(msg.Address); v == nil {
+		sb.logger.Debug("Ignoring announcement of non-validator", "address", msg.Address)
+		return nil
+	}
+	if msg.Timestamp > uint64(now().Add(announceInterval).Unix()) {
+		sb.logger.Debug("Ignoring announcement from the future", "address", msg.Address, "timestamp", msg.Timestamp)
+		return nil
+	}
+
+	sb.announceMu.Lock()
+	if prev, ok := sb.validatorEnodes[msg.Address]; ok && prev.Timestamp >= msg.Timestamp {
+		sb.announceMu.Unlock()
+		return nil
+	}
+	sb.validatorEnodes[msg.Address] = msg
+	sb.announceMu.Unlock()
+
+	sb.logger.Trace("Received validator announcement", "address", msg.Address, "enode", node)
+	sb.updateValidatorPool(valSet)
+	sb.gossipAnnounce(payload)
+	return nil
+}
+
+// gossipAnnounce sends an announcement to all peers that have not seen it yet.
+func (sb *backend) gossipAnnounce(payload []byte) {
+	if sb.broadcaster == nil {
+		return
+	}
+	hash := istanbul.RLPHash(payload)
+	for addr, p := range sb.broadcaster.AllPeers() {
+		ms, ok := sb.recentMessages.Get(addr)
+		var m *lru.ARCCache
+		if ok {
+			m, _ = ms.(*lru.ARCCache)
+			if _, k := m.Get(hash); k {
+				// This peer had this announcement, skip it
+				continue
+			}
+		} else {
+			m, _ = lru.NewARC(inmemoryMessages)
+		}
+
+		m.Add(hash, true)
+		sb.recentMessages.Add(addr, m)
+
+		go p.Send(istanbulAnnounceMsg, payload)
+	}
+}
+
+// updateValidatorPool keeps direct connections to all announced validators
+// while the local node is a validator, and drops the ones that left the set.
+func (sb *backend) updateValidatorPool(valSet istanbul.ValidatorSet) {
+	sb.announceMu.Lock()
+	defer sb.announceMu.Unlock()
+
+	if sb.validatorPool == nil {
+		return
+	}
+	_, self := valSet.GetByAddress(sb.Address())
+	for addr, msg := range sb.validatorEnodes {
+		if _, v := valSet.GetByAddress(addr); v == nil {
+			delete(sb.validatorEnodes, addr)
+			continue
+		}
+		node, err := msg.node()
+		if err != nil {
+			continue
+		}
+		if self != nil && addr != sb.Address() {
+			if prev, ok := sb.validatorPeers[addr]; ok {
+				if prev.ID() == node.ID() && prev.IP().Equal(node.IP()) && prev.TCP() == node.TCP() {
+					continue
+				}
+				// The validator moved, stop dialing its stale endpoint
+				sb.validatorPool.RemoveValidatorPeer(prev)
+			}
+			sb.validatorPool.AddValidatorPeer(node)
+			sb.validatorPeers[addr] = node
+		}
+	}
+	for addr, node := range sb.validatorPeers {
+		if _, v := valSet.GetByAddress(addr); v == nil || self == nil {
+			sb.validatorPool.RemoveValidatorPeer(node)
+			delete(sb.validatorPeers, addr)
+		}
+	}
+}
+
+// headValidators returns the validator set at the head of the announcing chain.
+func (sb *backend) headValidators() istanbul.ValidatorSet {
+	sb.announceMu.Lock()
+	chain := sb.announceChain
+	sb.announceMu.Unlock()
+
+	if chain == nil {
+		return validator.NewSet(nil, sb.config.ProposerPolicy)
+	}
+	header := chain.CurrentHeader()
+	snap, err := sb.snapshot(chain, header.Number.Uint64(), header.Hash(), nil)
+	if err != nil {
+		return validator.NewSet(nil, sb.config.ProposerPolicy)
+	}
+	return snap.ValSet
+}
@@ -0,0 +1,177 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"crypto/ecdsa"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/quorum/consensus/istanbul"
+	"github.com/ethereum/quorum/core"
+	"github.com/ethereum/quorum/core/vm"
+	"github.com/ethereum/quorum/crypto"
+	"github.com/ethereum/quorum/ethdb"
+	"github.com/ethereum/quorum/p2p/enode"
+	"github.com/ethereum/quorum/rlp"
+)
+
+type testValidatorPool struct {
+	self    *enode.Node
+	mu      sync.Mutex
+	nodes   map[enode.ID]*enode.Node
+	removed []*enode.Node
+}
+
+func (p *testValidatorPool) Self() *enode.Node {
+	return p.self
+}
+
+func (p *testValidatorPool) AddValidatorPeer(node *enode.Node) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.nodes[node.ID()] = node
+}
+
+func (p *testValidatorPool) RemoveValidatorPeer(node *enode.Node) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.nodes, node.ID())
+	p.removed = append(p.removed, node)
+}
+
+func (p *testValidatorPool) has(id enode.ID) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	_, ok := p.nodes[id]
+	return ok
+}
+
+func (p *testValidatorPool) wasRemoved(node *enode.Node) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, n := range p.removed {
+		if n.ID() == node.ID() && n.IP().Equal(node.IP()) && n.TCP() == node.TCP() {
+			return true
+		}
+	}
+	return false
+}
+
+func newAnnounceMessage(t *testing.T, key *ecdsa.PrivateKey, node *enode.Node) *announceMessage {
+	msg := &announceMessage{
+		Address:   crypto.PubkeyToAddress(key.PublicKey),
+		Enode:     node.String(),
+		Timestamp: uint64(now().Unix()),
+	}
+	signAnnounceMessage(t, key, msg)
+	return msg
+}
+
+func signAnnounceMessage(t *testing.T, key *ecdsa.PrivateKey, msg *announceMessage) {
+	data, err := msg.payloadNoSig()
+	if err != nil {
+		t.Fatalf("failed to encode announcement: %v", err)
+	}
+	if msg.Signature, err = crypto.Sign(crypto.Keccak256(data), key); err != nil {
+		t.Fatalf("failed to sign announcement: %v", err)
+	}
+}
+
+func TestAnnounceMessage(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	other, _ := crypto.GenerateKey()
+	node := enode.NewV4(&key.PublicKey, net.IP{127, 0, 0, 1}, 30303, 30303, 0)
+
+	msg := newAnnounceMessage(t, key, node)
+	got, err := msg.node()
+	if err != nil {
+		t.Fatalf("error mismatch: have %v, want nil", err)
+	}
+	if got.ID() != node.ID() {
+		t.Errorf("node mismatch: have %v, want %v", got, node)
+	}
+
+	// The enode must belong to the validator
+	msg = newAnnounceMessage(t, key, enode.NewV4(&other.PublicKey, net.IP{127, 0, 0, 1}, 30303, 30303, 0))
+	if _, err := msg.node(); err != errInvalidAnnounce {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidAnnounce)
+	}
+
+	// The announcement must be signed by the validator
+	msg = newAnnounceMessage(t, key, node)
+	msg.Address = crypto.PubkeyToAddress(other.PublicKey)
+	if _, err := msg.node(); err != errInvalidSignature {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidSignature)
+	}
+}
+
+func TestHandleAnnounce(t *testing.T) {
+	genesis, nodeKeys := getGenesisAndKeys(2)
+	memDB := ethdb.NewMemDatabase()
+	b, _ := New(istanbul.DefaultConfig, nodeKeys[0], memDB).(*backend)
+	genesis.MustCommit(memDB)
+	blockchain, err := core.NewBlockChain(memDB, nil, genesis.Config, b, vm.Config{}, nil)
+	if err != nil {
+		t.Fatalf("failed to create blockchain: %v", err)
+	}
+
+	self := enode.NewV4(&nodeKeys[0].PublicKey, net.IP{127, 0, 0, 1}, 30303, 30303, 0)
+	pool := &testValidatorPool{self: self, nodes: make(map[enode.ID]*enode.Node)}
+	if err := b.StartAnnouncing(blockchain, pool); err != nil {
+		t.Fatalf("error mismatch: have %v, want nil", err)
+	}
+	defer b.StopAnnouncing()
+
+	// An announcement of a non-validator is dropped
+	outsider, _ := crypto.GenerateKey()
+	outsiderNode := enode.NewV4(&outsider.PublicKey, net.IP{127, 0, 0, 2}, 30303, 30303, 0)
+	payload, _ := rlp.EncodeToBytes(newAnnounceMessage(t, outsider, outsiderNode))
+	if err := b.handleAnnounce(crypto.PubkeyToAddress(outsider.PublicKey), payload); err != nil {
+		t.Errorf("error mismatch: have %v, want nil", err)
+	}
+	if pool.has(outsiderNode.ID()) {
+		t.Errorf("non-validator was added to the validator pool")
+	}
+
+	// An announcement of the other validator opens a direct connection
+	node := enode.NewV4(&nodeKeys[1].PublicKey, net.IP{127, 0, 0, 3}, 30303, 30303, 0)
+	payload, _ = rlp.EncodeToBytes(newAnnounceMessage(t, nodeKeys[1], node))
+	if err := b.handleAnnounce(crypto.PubkeyToAddress(nodeKeys[1].PublicKey), payload); err != nil {
+		t.Errorf("error mismatch: have %v, want nil", err)
+	}
+	if !pool.has(node.ID()) {
+		t.Errorf("validator was not added to the validator pool")
+	}
+
+	// A newer announcement from another endpoint replaces the stale one
+	moved := enode.NewV4(&nodeKeys[1].PublicKey, net.IP{127, 0, 0, 4}, 30303, 30303, 0)
+	msg := newAnnounceMessage(t, nodeKeys[1], moved)
+	msg.Timestamp++
+	signAnnounceMessage(t, nodeKeys[1], msg)
+	payload, _ = rlp.EncodeToBytes(msg)
+	if err := b.handleAnnounce(crypto.PubkeyToAddress(nodeKeys[1].PublicKey), payload); err != nil {
+		t.Errorf("error mismatch: have %v, want nil", err)
+	}
+	if !pool.wasRemoved(node) {
+		t.Errorf("stale validator endpoint was not removed from the validator pool")
+	}
+	if pool.wasRemoved(moved) || !pool.has(moved.ID()) {
+		t.Errorf("moved validator endpoint is not in the validator pool")
+	}
+}
@@ -17,12 +17,20 @@
 package backend
 
 import (
+	"math/big"
+
 	"github.com/ethereum/quorum/common"
 	"github.com/ethereum/quorum/consensus"
+	"github.com/ethereum/quorum/consensus/istanbul"
+	"github.com/ethereum/quorum/consensus/istanbul/finality"
 	"github.com/ethereum/quorum/core/types"
 	"github.com/ethereum/quorum/rpc"
 )
 
+// maxProofRange is the maximum number of blocks a finality proof spans, bounding
+// the headers walked to build it.
+const maxProofRange = 16 * checkpointInterval
+
 // API is a user facing RPC API to dump Istanbul state
 type API struct {
 	chain    consensus.ChainReader
@@ -87,6 +95,99 @@ func (api *API) GetValidatorsAtHash(hash common.Hash) ([]common.Address, error)
 	return snap.validators(), nil
 }
 
+// GetCheckpoint retrieves a block and the validators sealing its child, to be used
+// as trusted checkpoint when verifying finality proofs.
+func (api *API) GetCheckpoint(number *rpc.BlockNumber) (*finality.Checkpoint, error) {
+	header := api.header(number)
+	if header == nil {
+		return nil, errUnknownBlock
+	}
+	snap, err := api.istanbul.snapshot(api.chain, header.Number.Uint64(), header.Hash(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return &finality.Checkpoint{
+		Number:     header.Number.Uint64(),
+		Hash:       header.Hash(),
+		Validators: snap.validators(),
+	}, nil
+}
+
+// GetFinalityProof retrieves a proof that the block was committed, holding the
+// headers that changed the validator set since the given checkpoint. A proof
+// spans at most maxProofRange blocks, longer ranges have to be proven from an
+// intermediate checkpoint.
+func (api *API) GetFinalityProof(number *rpc.BlockNumber, checkpoint rpc.BlockNumber) (*finality.Proof, error) {
+	header := api.header(number)
+	if header == nil {
+		return nil, errUnknownBlock
+	}
+	if checkpoint < 0 || uint64(checkpoint) >= header.Number.Uint64() {
+		return nil, errInvalidCheckpoint
+	}
+	if header.Number.Uint64()-uint64(checkpoint) > maxProofRange {
+		return nil, errProofRangeTooLarge
+	}
+	if !api.istanbul.config.IsValidatorSetEnforced(big.NewInt(int64(checkpoint) + 1)) {
+		return nil, errUnenforcedValidatorSet
+	}
+	start := api.chain.GetHeaderByNumber(uint64(checkpoint))
+	if start == nil {
+		return nil, errUnknownBlock
+	}
+	snap, err := api.istanbul.snapshot(api.chain, start.Number.Uint64(), start.Hash(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	proof := &finality.Proof{
+		Header:           header,
+		ValidatorChanges: []*types.Header{},
+	}
+	validators := snap.validators()
+	for n := start.Number.Uint64() + 1; n < header.Number.Uint64(); n++ {
+		h := api.chain.GetHeaderByNumber(n)
+		if h == nil {
+			return nil, errUnknownBlock
+		}
+		extra, err := types.ExtractIstanbulExtra(h)
+		if err != nil {
+			return nil, err
+		}
+		if !sameValidators(validators, extra.Validators) {
+			proof.ValidatorChanges = append(proof.ValidatorChanges, h)
+			validators = extra.Validators
+		}
+	}
+	return proof, nil
+}
+
+// header retrieves the requested block header, or the current one if none is
+// requested.
+func (api *API) header(number *rpc.BlockNumber) *types.Header {
+	if number == nil || *number == rpc.LatestBlockNumber {
+		return api.chain.CurrentHeader()
+	}
+	return api.chain.GetHeaderByNumber(uint64(number.Int64()))
+}
+
+// sameValidators returns whether both lists hold the same validators.
+func sameValidators(a, b []common.Address) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	known := make(map[common.Address]bool, len(a))
+	for _, addr := range a {
+		known[addr] = true
+	}
+	for _, addr := range b {
+		if !known[addr] {
+			return false
+		}
+	}
+	return true
+}
+
 // Candidates returns the current candidates the node tries to uphold and vote on.
 func (api *API) Candidates() map[common.Address]bool {
 	api.istanbul.candidatesLock.RLock()
@@ -116,3 +217,22 @@ func (api *API) Discard(address common.Address) {
 
 	delete(api.istanbul.candidates, address)
 }
+
+// GetEvidence retrieves the recorded proofs of validators signing conflicting
+// messages, optionally restricted to the given validator.
+func (api *API) GetEvidence(validator *common.Address) ([]*istanbul.Evidence, error) {
+	api.istanbul.evidenceMu.Lock()
+	defer api.istanbul.evidenceMu.Unlock()
+
+	recorded, err := loadEvidence(api.istanbul.db)
+	if err != nil {
+		return nil, err
+	}
+	evidence := make([]*istanbul.Evidence, 0, len(recorded))
+	for _, ev := range recorded {
+		if validator == nil || ev.Validator == *validator {
+			evidence = append(evidence, ev)
+		}
+	}
+	return evidence, nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"testing"
+
+	"github.com/ethereum/quorum/consensus/istanbul/finality"
+	"github.com/ethereum/quorum/core/types"
+	"github.com/ethereum/quorum/rpc"
+)
+
+func TestGetFinalityProof(t *testing.T) {
+	chain, engine := newBlockChain(1)
+	block := makeBlock(chain, engine, chain.Genesis())
+	if _, err := chain.InsertChain(types.Blocks{block}); err != nil {
+		t.Fatalf("failed to insert block: %v", err)
+	}
+	api := &API{chain: chain, istanbul: engine}
+
+	genesis := rpc.BlockNumber(0)
+	checkpoint, err := api.GetCheckpoint(&genesis)
+	if err != nil {
+		t.Fatalf("error mismatch: have %v, want nil", err)
+	}
+	number := rpc.BlockNumber(1)
+	proof, err := api.GetFinalityProof(&number, 0)
+	if err != nil {
+		t.Fatalf("error mismatch: have %v, want nil", err)
+	}
+	if proof.Header.Hash() != block.Hash() || len(proof.ValidatorChanges) != 0 {
+		t.Errorf("proof mismatch: have %v", proof)
+	}
+	if err := finality.Verify(checkpoint, proof, engine.config); err != nil {
+		t.Errorf("error mismatch: have %v, want nil", err)
+	}
+
+	// The checkpoint has to precede the block
+	if _, err := api.GetFinalityProof(&number, 1); err != errInvalidCheckpoint {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidCheckpoint)
+	}
+}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/ethereum/quorum/common"
 	"github.com/ethereum/quorum/consensus"
+	"github.com/ethereum/quorum/consensus/ethash"
 	"github.com/ethereum/quorum/consensus/istanbul"
 	istanbulCore "github.com/ethereum/quorum/consensus/istanbul/core"
 	"github.com/ethereum/quorum/consensus/istanbul/validator"
@@ -33,6 +34,7 @@ import (
 	"github.com/ethereum/quorum/ethdb"
 	"github.com/ethereum/quorum/event"
 	"github.com/ethereum/quorum/log"
+	"github.com/ethereum/quorum/p2p/enode"
 	lru "github.com/hashicorp/golang-lru"
 )
 
@@ -60,6 +62,12 @@ func New(config *istanbul.Config, privateKey *ecdsa.PrivateKey, db ethdb.Databas
 		coreStarted:      false,
 		recentMessages:   recentMessages,
 		knownMessages:    knownMessages,
+		validatorEnodes:  make(map[common.Address]*announceMessage),
+		validatorPeers:   make(map[common.Address]*enode.Node),
+	}
+	if config.ForkBlock != nil && config.ForkBlock.Sign() > 0 {
+		// Raft blocks are checked with the lightest engine, like raft itself does
+		backend.legacy = ethash.NewFullFaker()
 	}
 	backend.core = istanbulCore.New(backend, backend.config)
 	return backend
@@ -78,6 +86,7 @@ type backend struct {
 	chain            consensus.ChainReader
 	currentBlock     func() *types.Block
 	hasBadBlock      func(hash common.Hash) bool
+	legacy           consensus.Engine // engine handling the blocks before the Istanbul fork
 
 	// the channels for istanbul engine notifications
 	commitCh          chan *types.Block
@@ -98,10 +107,22 @@ type backend struct {
 
 	recentMessages *lru.ARCCache // the cache of peer's messages
 	knownMessages  *lru.ARCCache // the cache of self messages
+
+	evidenceMu sync.Mutex // Protects the recorded equivocation evidence
+
+	announceChain   consensus.ChainReader               // chain used to look up the validators to connect to
+	validatorPool   consensus.ValidatorPool             // pool of direct connections to other validators
+	validatorEnodes map[common.Address]*announceMessage // latest announcement of every validator
+	validatorPeers  map[common.Address]*enode.Node      // validators added to the pool
+	announceQuit    chan struct{}
+	announceMu      sync.Mutex // Protects the announcement fields
 }
 
 // zekun: HACK
 func (sb *backend) CalcDifficulty(chain consensus.ChainReader, time uint64, parent *types.Header) *big.Int {
+	if sb.isLegacy(new(big.Int).Add(parent.Number, common.Big1)) {
+		return sb.legacy.CalcDifficulty(chain, time, parent)
+	}
 	return new(big.Int)
 }
 
@@ -67,7 +67,7 @@ var (
 	// errInvalidUncleHash is returned if a block contains an non-empty uncle list.
 	errInvalidUncleHash = errors.New("non empty uncle hash")
 	// errInconsistentValidatorSet is returned if the validator set is inconsistent
-	errInconsistentValidatorSet = errors.New("non empty uncle hash")
+	errInconsistentValidatorSet = errors.New("inconsistent validator set")
 	// errInvalidTimestamp is returned if the timestamp of a block is lower than the previous block's timestamp + the minimum block period.
 	errInvalidTimestamp = errors.New("invalid timestamp")
 	// errInvalidVotingChain is returned if an authorization list is attempted to
@@ -82,6 +82,18 @@ var (
 	errEmptyCommittedSeals = errors.New("zero committed seals")
 	// errMismatchTxhashes is returned if the TxHash in header is mismatch.
 	errMismatchTxhashes = errors.New("mismatch transcations hashes")
+	// errLegacyBlock is returned when the validators are requested for a block
+	// that precedes the last block before the Istanbul fork.
+	errLegacyBlock = errors.New("block precedes istanbul fork")
+	// errInvalidCheckpoint is returned if a finality proof is requested from a
+	// checkpoint that doesn't precede the block.
+	errInvalidCheckpoint = errors.New("invalid checkpoint")
+	// errUnenforcedValidatorSet is returned if a finality proof is requested
+	// from a checkpoint before headers had to list their validators.
+	errUnenforcedValidatorSet = errors.New("checkpoint precedes the validator set fork block")
+	// errProofRangeTooLarge is returned if a finality proof is requested over
+	// more blocks than a single proof may span.
+	errProofRangeTooLarge = errors.New("finality proof range too large")
 )
 var (
 	defaultDifficulty = big.NewInt(1)
@@ -100,9 +112,18 @@ var (
 // block, which may be different from the header's coinbase if a consensus
 // engine is based on signatures.
 func (sb *backend) Author(header *types.Header) (common.Address, error) {
+	if sb.isLegacy(header.Number) {
+		return sb.legacy.Author(header)
+	}
 	return ecrecover(header)
 }
 
+// isLegacy returns whether the given block precedes the Istanbul fork and is
+// handled by the legacy engine.
+func (sb *backend) isLegacy(number *big.Int) bool {
+	return sb.legacy != nil && number.Cmp(sb.config.ForkBlock) < 0
+}
+
 // VerifyHeader checks whether a header conforms to the consensus rules of a
 // given engine. Verifying the seal may be done optionally here, or explicitly
 // via the VerifySeal method.
@@ -118,6 +139,9 @@ func (sb *backend) verifyHeader(chain consensus.ChainReader, header *types.Heade
 	if header.Number == nil {
 		return errUnknownBlock
 	}
+	if sb.isLegacy(header.Number) {
+		return sb.legacy.VerifyHeader(chain, header, false)
+	}
 
 	// Don't waste time checking blocks from the future
 	if header.Time.Cmp(big.NewInt(now().Unix())) > 0 {
@@ -169,7 +193,9 @@ func (sb *backend) verifyCascadingFields(chain consensus.ChainReader, header *ty
 	if parent == nil || parent.Number.Uint64() != number-1 || parent.Hash() != header.ParentHash {
 		return consensus.ErrUnknownAncestor
 	}
-	if parent.Time.Uint64()+sb.config.BlockPeriod > header.Time.Uint64() {
+	// Raft timestamps are in nanoseconds, so the first Istanbul block can't be
+	// compared against its parent
+	if !sb.isLegacy(parent.Number) && parent.Time.Uint64()+sb.config.GetBlockPeriod(header.Number) > header.Time.Uint64() {
 		return errInvalidTimestamp
 	}
 	// Verify validators in extraData. Validators in snapshot and extraData should be the same.
@@ -181,6 +207,19 @@ func (sb *backend) verifyCascadingFields(chain consensus.ChainReader, header *ty
 	for i, validator := range snap.validators() {
 		copy(validators[i*common.AddressLength:], validator[:])
 	}
+	if sb.config.IsValidatorSetEnforced(header.Number) {
+		extra, err := types.ExtractIstanbulExtra(header)
+		if err != nil {
+			return err
+		}
+		extraValidators := make([]byte, len(extra.Validators)*common.AddressLength)
+		for i, validator := range extra.Validators {
+			copy(extraValidators[i*common.AddressLength:], validator[:])
+		}
+		if !bytes.Equal(validators, extraValidators) {
+			return errInconsistentValidatorSet
+		}
+	}
 	if err := sb.verifySigner(chain, header, parents); err != nil {
 		return err
 	}
@@ -212,6 +251,9 @@ func (sb *backend) VerifyHeaders(chain consensus.ChainReader, headers []*types.H
 // VerifyUncles verifies that the given block's uncles conform to the consensus
 // rules of a given engine.
 func (sb *backend) VerifyUncles(chain consensus.ChainReader, block *types.Block) error {
+	if sb.isLegacy(block.Number()) {
+		return sb.legacy.VerifyUncles(chain, block)
+	}
 	if len(block.Uncles()) > 0 {
 		return errInvalidUncleHash
 	}
@@ -301,6 +343,9 @@ func (sb *backend) verifyCommittedSeals(chain consensus.ChainReader, header *typ
 // the consensus rules of the given engine.
 func (sb *backend) VerifySeal(chain consensus.ChainReader, header *types.Header) error {
 	// get parent header and ensure the signer is in parent's validator set
+	if sb.isLegacy(header.Number) {
+		return sb.legacy.VerifySeal(chain, header)
+	}
 	number := header.Number.Uint64()
 	if number == 0 {
 		return errUnknownBlock
@@ -316,6 +361,9 @@ func (sb *backend) VerifySeal(chain consensus.ChainReader, header *types.Header)
 // Prepare initializes the consensus fields of a block header according to the
 // rules of a particular engine. The changes are executed inline.
 func (sb *backend) Prepare(chain consensus.ChainReader, header *types.Header) error {
+	if sb.isLegacy(header.Number) {
+		return sb.legacy.Prepare(chain, header)
+	}
 	// unused fields, force to set to empty
 	header.Coinbase = common.Address{}
 	header.Nonce = emptyNonce
@@ -368,8 +416,8 @@ func (sb *backend) Prepare(chain consensus.ChainReader, header *types.Header) er
 	header.Extra = extra
 
 	// set header's timestamp
-	header.Time = new(big.Int).Add(parent.Time, new(big.Int).SetUint64(sb.config.BlockPeriod))
-	if header.Time.Int64() < time.Now().Unix() {
+	header.Time = new(big.Int).Add(parent.Time, new(big.Int).SetUint64(sb.config.GetBlockPeriod(header.Number)))
+	if sb.isLegacy(parent.Number) || header.Time.Int64() < time.Now().Unix() {
 		header.Time = big.NewInt(time.Now().Unix())
 	}
 	return nil
@@ -382,6 +430,9 @@ func (sb *backend) Prepare(chain consensus.ChainReader, header *types.Header) er
 // consensus rules that happen at finalization (e.g. block rewards).
 func (sb *backend) Finalize(chain consensus.ChainReader, header *types.Header, state *state.StateDB, txs []*types.Transaction,
 	uncles []*types.Header, receipts []*types.Receipt) (*types.Block, error) {
+	if sb.isLegacy(header.Number) {
+		return sb.legacy.Finalize(chain, header, state, txs, uncles, receipts)
+	}
 	// No block rewards in Istanbul, so the state remains as is and uncles are dropped
 	header.Root = state.IntermediateRoot(chain.Config().IsEIP158(header.Number))
 	header.UncleHash = nilUncleHash
@@ -393,6 +444,9 @@ func (sb *backend) Finalize(chain consensus.ChainReader, header *types.Header, s
 // Seal generates a new block for the given input block with the local miner's
 // seal place on top.
 func (sb *backend) Seal(chain consensus.ChainReader, block *types.Block, results chan<- *types.Block, stop <-chan struct{}) error {
+	if sb.isLegacy(block.Number()) {
+		return sb.legacy.Seal(chain, block, results, stop)
+	}
 
 	// update the block header timestamp and signature and propose the block to core engine
 	header := block.Header()
@@ -546,6 +600,20 @@ func (sb *backend) snapshot(chain consensus.ChainReader, number uint64, hash com
 				break
 			}
 		}
+		// If we're at the last block before the Istanbul fork, start from the
+		// configured validators
+		if sb.legacy != nil && number+1 == sb.config.ForkBlock.Uint64() {
+			snap = newSnapshot(sb.config.Epoch, number, hash, validator.NewSet(sb.config.ForkValidators, sb.config.ProposerPolicy))
+			if err := snap.store(sb.db); err != nil {
+				return nil, err
+			}
+			log.Trace("Stored Istanbul fork voting snapshot to disk", "number", number, "hash", hash)
+			break
+		}
+		// Raft blocks before that don't have any validators
+		if sb.isLegacy(new(big.Int).SetUint64(number + 1)) {
+			return nil, errLegacyBlock
+		}
 		// If we're at block zero, make a snapshot
 		if number == 0 {
 			genesis := chain.GetHeaderByNumber(0)
@@ -621,6 +689,9 @@ func sigHash(header *types.Header) (hash common.Hash) {
 
 // SealHash returns the hash of a block prior to it being sealed.
 func (sb *backend) SealHash(header *types.Header) common.Hash {
+	if sb.isLegacy(header.Number) {
+		return sb.legacy.SealHash(header)
+	}
 	return sigHash(header)
 }
 
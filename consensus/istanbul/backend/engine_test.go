@@ -316,6 +316,15 @@ func TestVerifyHeader(t *testing.T) {
 		t.Errorf("error mismatch: have %v, want %v", err, errInvalidTimestamp)
 	}
 
+	// validators not matching the snapshot
+	block = makeBlockWithoutSeal(chain, engine, chain.Genesis())
+	header = block.Header()
+	header.Extra, _ = prepareExtra(header, []common.Address{common.HexToAddress("0x1234567890")})
+	err = engine.VerifyHeader(chain, header, false)
+	if err != errInconsistentValidatorSet {
+		t.Errorf("error mismatch: have %v, want %v", err, errInconsistentValidatorSet)
+	}
+
 	// future block
 	block = makeBlockWithoutSeal(chain, engine, chain.Genesis())
 	header = block.Header()
@@ -580,3 +589,64 @@ func TestWriteCommittedSeals(t *testing.T) {
 		t.Errorf("error mismatch: have %v, want %v", err, errInvalidCommittedSeals)
 	}
 }
+
+func TestIstanbulFork(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+
+	// A raft chain switching over to Istanbul at block 2
+	chainConfig := *params.TestChainConfig
+	chainConfig.Istanbul = &params.IstanbulConfig{}
+	chainConfig.Ethash = nil
+	chainConfig.IstanbulBlock = big.NewInt(2)
+	chainConfig.IstanbulValidators = []common.Address{addr}
+	genesis := &core.Genesis{
+		Config:     &chainConfig,
+		Timestamp:  uint64(time.Now().Add(-time.Minute).UnixNano()),
+		GasLimit:   params.GenesisGasLimit,
+		Difficulty: big.NewInt(131072),
+	}
+
+	config := *istanbul.DefaultConfig
+	config.ForkBlock = chainConfig.IstanbulBlock
+	config.ForkValidators = chainConfig.IstanbulValidators
+
+	memDB := ethdb.NewMemDatabase()
+	b, _ := New(&config, key, memDB).(*backend)
+	genesisBlock := genesis.MustCommit(memDB)
+	chain, err := core.NewBlockChain(memDB, nil, genesis.Config, b, vm.Config{}, nil)
+	if err != nil {
+		t.Fatalf("failed to create blockchain: %v", err)
+	}
+
+	// Raft blocks are handled by the legacy engine
+	raftBlocks, _ := core.GenerateChain(genesis.Config, genesisBlock, b, memDB, 1, nil)
+	if _, err := chain.InsertChain(raftBlocks); err != nil {
+		t.Fatalf("failed to insert raft block: %v", err)
+	}
+	if _, err := b.snapshot(chain, 0, genesisBlock.Hash(), nil); err != errLegacyBlock {
+		t.Errorf("error mismatch: have %v, want %v", err, errLegacyBlock)
+	}
+	snap, err := b.snapshot(chain, 1, raftBlocks[0].Hash(), nil)
+	if err != nil {
+		t.Fatalf("error mismatch: have %v, want nil", err)
+	}
+	if validators := snap.validators(); len(validators) != 1 || validators[0] != addr {
+		t.Errorf("validators mismatch: have %v, want %v", validators, chainConfig.IstanbulValidators)
+	}
+
+	// The fork block is produced and verified by Istanbul
+	b.Start(chain, chain.CurrentBlock, chain.HasBadBlock)
+	defer b.Stop()
+
+	block := makeBlock(chain, b, raftBlocks[0])
+	if err := b.VerifyHeader(chain, block.Header(), false); err != nil {
+		t.Errorf("error mismatch: have %v, want nil", err)
+	}
+	if _, err := chain.InsertChain(types.Blocks{block}); err != nil {
+		t.Errorf("failed to insert fork block: %v", err)
+	}
+	if author, _ := b.Author(block.Header()); author != addr {
+		t.Errorf("author mismatch: have %v, want %v", author, addr)
+	}
+}
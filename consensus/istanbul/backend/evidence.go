@@ -0,0 +1,86 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/quorum/consensus/istanbul"
+	istanbulCore "github.com/ethereum/quorum/consensus/istanbul/core"
+	"github.com/ethereum/quorum/ethdb"
+)
+
+const (
+	dbKeyEvidence = "istanbul-evidence"
+)
+
+// loadEvidence loads all recorded equivocation evidence from the database.
+func loadEvidence(db ethdb.Database) ([]*istanbul.Evidence, error) {
+	if ok, err := db.Has([]byte(dbKeyEvidence)); err != nil || !ok {
+		return nil, err
+	}
+	blob, err := db.Get([]byte(dbKeyEvidence))
+	if err != nil {
+		return nil, err
+	}
+	var evidence []*istanbul.Evidence
+	if err := json.Unmarshal(blob, &evidence); err != nil {
+		return nil, err
+	}
+	return evidence, nil
+}
+
+// storeEvidence replaces the recorded equivocation evidence in the database.
+func storeEvidence(db ethdb.Database, evidence []*istanbul.Evidence) error {
+	blob, err := json.Marshal(evidence)
+	if err != nil {
+		return err
+	}
+	return db.Put([]byte(dbKeyEvidence), blob)
+}
+
+// RecordEvidence implements istanbul.Backend.RecordEvidence
+func (sb *backend) RecordEvidence(evidence *istanbul.Evidence) error {
+	if err := istanbulCore.VerifyEvidence(evidence); err != nil {
+		return err
+	}
+
+	sb.evidenceMu.Lock()
+	defer sb.evidenceMu.Unlock()
+
+	recorded, err := loadEvidence(sb.db)
+	if err != nil {
+		return err
+	}
+	// Only keep a single proof per validator, message type and view
+	for _, ev := range recorded {
+		if ev.Validator == evidence.Validator && ev.Code == evidence.Code && ev.View.Cmp(evidence.View) == 0 {
+			return nil
+		}
+	}
+	if err := storeEvidence(sb.db, append(recorded, evidence)); err != nil {
+		return err
+	}
+	sb.logger.Warn("Recorded equivocation evidence", "validator", evidence.Validator, "code", evidence.Code, "view", evidence.View)
+
+	if sb.config.EjectOnEquivocation {
+		sb.candidatesLock.Lock()
+		sb.candidates[evidence.Validator] = false
+		sb.candidatesLock.Unlock()
+	}
+	return nil
+}
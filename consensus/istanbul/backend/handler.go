@@ -34,8 +34,9 @@ import (
 )
 
 const (
-	istanbulMsg = 0x11
-	NewBlockMsg = 0x07
+	istanbulMsg         = 0x11
+	istanbulAnnounceMsg = 0x12
+	NewBlockMsg         = 0x07
 )
 
 var (
@@ -47,8 +48,8 @@ var (
 func (sb *backend) Protocol() consensus.Protocol {
 	return consensus.Protocol{
 		Name:     "istanbul",
-		Versions: []uint{64},
-		Lengths:  []uint64{18},
+		Versions: []uint{65, 64},
+		Lengths:  []uint64{19, 18},
 	}
 }
 
@@ -63,6 +64,15 @@ func (sb *backend) decode(msg p2p.Msg) ([]byte, common.Hash, error) {
 
 // HandleMsg implements consensus.Handler.HandleMsg
 func (sb *backend) HandleMsg(addr common.Address, msg p2p.Msg) (bool, error) {
+	if msg.Code == istanbulAnnounceMsg {
+		// Announcements are relayed by every node, whether the core is running or not
+		data, _, err := sb.decode(msg)
+		if err != nil {
+			return true, errDecodeFailed
+		}
+		return true, sb.handleAnnounce(addr, data)
+	}
+
 	sb.coreMu.Lock()
 	defer sb.coreMu.Unlock()
 
@@ -16,7 +16,11 @@
 
 package istanbul
 
-import "math/big"
+import (
+	"math/big"
+
+	"github.com/ethereum/quorum/common"
+)
 
 type ProposerPolicy uint64
 
@@ -26,17 +30,52 @@ const (
 )
 
 type Config struct {
-	RequestTimeout uint64         `toml:",omitempty"` // The timeout for each Istanbul round in milliseconds.
-	BlockPeriod    uint64         `toml:",omitempty"` // Default minimum difference between two consecutive block's timestamps in second
-	ProposerPolicy ProposerPolicy `toml:",omitempty"` // The policy for proposer selection
-	Epoch          uint64         `toml:",omitempty"` // The number of blocks after which to checkpoint and reset the pending votes
-	Ceil2Nby3Block *big.Int       `toml:",omitempty"` // Number of confirmations required to move from one state to next [2F + 1 to Ceil(2N/3)]
+	RequestTimeout      uint64           `toml:",omitempty"` // The timeout for each Istanbul round in milliseconds.
+	BlockPeriod         uint64           `toml:",omitempty"` // Default minimum difference between two consecutive block's timestamps in second
+	ProposerPolicy      ProposerPolicy   `toml:",omitempty"` // The policy for proposer selection
+	Epoch               uint64           `toml:",omitempty"` // The number of blocks after which to checkpoint and reset the pending votes
+	Ceil2Nby3Block      *big.Int         `toml:",omitempty"` // Number of confirmations required to move from one state to next [2F + 1 to Ceil(2N/3)]
+	ValidatorSetBlock   *big.Int         `toml:",omitempty"` // Block from which headers have to list the validators sealing them (nil = never)
+	EjectOnEquivocation bool             `toml:",omitempty"` // Whether to vote validators caught equivocating out of the validator set
+	ForkBlock           *big.Int         `toml:",omitempty"` // Block number Istanbul takes over from raft at (nil = from genesis)
+	ForkValidators      []common.Address `toml:",omitempty"` // Validator set of the first Istanbul block after a raft chain
+	BlockPeriods        []BlockPeriod    `toml:",omitempty"` // Block period transitions scheduled by the chain config
+}
+
+// BlockPeriod is the block period used from the given block on.
+type BlockPeriod struct {
+	Block  *big.Int
+	Period uint64
 }
 
 var DefaultConfig = &Config{
-	RequestTimeout: 10000,
-	BlockPeriod:    1,
-	ProposerPolicy: RoundRobin,
-	Epoch:          30000,
-	Ceil2Nby3Block: big.NewInt(0),
+	RequestTimeout:    10000,
+	BlockPeriod:       1,
+	ProposerPolicy:    RoundRobin,
+	Epoch:             30000,
+	Ceil2Nby3Block:    big.NewInt(0),
+	ValidatorSetBlock: big.NewInt(0),
+}
+
+// GetBlockPeriod returns the minimum number of seconds between the given block
+// and its parent. Once the chain config schedules transitions, they are
+// authoritative from genesis on and the local BlockPeriod setting is ignored.
+func (c *Config) GetBlockPeriod(number *big.Int) uint64 {
+	period := c.BlockPeriod
+	if len(c.BlockPeriods) > 0 {
+		period = c.BlockPeriods[0].Period
+	}
+	for _, transition := range c.BlockPeriods {
+		if transition.Block.Cmp(number) > 0 {
+			break
+		}
+		period = transition.Period
+	}
+	return period
+}
+
+// IsValidatorSetEnforced returns whether the validators listed in the header of
+// the given block have to match the validators sealing it.
+func (c *Config) IsValidatorSetEnforced(number *big.Int) bool {
+	return c.ValidatorSetBlock != nil && c.ValidatorSetBlock.Cmp(number) <= 0
 }
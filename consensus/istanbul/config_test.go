@@ -0,0 +1,61 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package istanbul
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestGetBlockPeriod(t *testing.T) {
+	config := &Config{
+		BlockPeriod: 1,
+		BlockPeriods: []BlockPeriod{
+			{Block: big.NewInt(0), Period: 3},
+			{Block: big.NewInt(10), Period: 5},
+			{Block: big.NewInt(20), Period: 2},
+		},
+	}
+	testCases := []struct {
+		number   int64
+		expected uint64
+	}{
+		{1, 3},
+		{9, 3},
+		{10, 5},
+		{19, 5},
+		{20, 2},
+		{1000, 2},
+	}
+	for _, test := range testCases {
+		if period := config.GetBlockPeriod(big.NewInt(test.number)); period != test.expected {
+			t.Errorf("block %d: period mismatch: have %v, want %v", test.number, period, test.expected)
+		}
+	}
+}
+
+func TestGetBlockPeriodWithoutTransitions(t *testing.T) {
+	config := &Config{BlockPeriod: 4}
+	if period := config.GetBlockPeriod(big.NewInt(10)); period != 4 {
+		t.Errorf("period mismatch: have %v, want %v", period, 4)
+	}
+	// The local setting never applies once transitions are scheduled
+	config.BlockPeriods = []BlockPeriod{{Block: big.NewInt(10), Period: 2}}
+	if period := config.GetBlockPeriod(big.NewInt(5)); period != 2 {
+		t.Errorf("period mismatch: have %v, want %v", period, 2)
+	}
+}
@@ -46,6 +46,7 @@ func New(backend istanbul.Backend, config *istanbul.Config) Engine {
 		backlogsMu:         new(sync.Mutex),
 		pendingRequests:    prque.New(),
 		pendingRequestsMu:  new(sync.Mutex),
+		signedMessages:     make(map[signedMessageKey]*messageSet),
 		consensusTimestamp: time.Time{},
 		roundMeter:         metrics.NewMeter(),
 		sequenceMeter:      metrics.NewMeter(),
@@ -90,6 +91,9 @@ type core struct {
 	pendingRequests   *prque.Prque
 	pendingRequestsMu *sync.Mutex
 
+	// the messages signed by each validator in the current sequence, used to detect equivocation
+	signedMessages map[signedMessageKey]*messageSet
+
 	consensusTimestamp time.Time
 	// the meter to record the round change rate
 	roundMeter metrics.Meter
@@ -237,6 +241,7 @@ func (c *core) startNewRound(round *big.Int) {
 			Round:    new(big.Int),
 		}
 		c.valSet = c.backend.Validators(lastProposal)
+		c.signedMessages = make(map[signedMessageKey]*messageSet)
 	}
 
 	// Update logger
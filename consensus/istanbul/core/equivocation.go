@@ -0,0 +1,112 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"bytes"
+
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/consensus/istanbul"
+)
+
+// signedMessageKey identifies the messages a validator is allowed to sign only
+// once within a sequence: one PRE-PREPARE, PREPARE and COMMIT per round.
+type signedMessageKey struct {
+	code  uint64
+	round uint64
+}
+
+// checkEquivocation remembers the PRE-PREPARE, PREPARE and COMMIT messages of the
+// current sequence. If the sender already signed a different message of the same
+// kind for the same view, the evidence is handed to the backend.
+func (c *core) checkEquivocation(msg *message, src istanbul.Validator) {
+	if msg.Code > msgCommit {
+		return
+	}
+	view, err := msg.view()
+	if err != nil || view.Sequence.Cmp(c.current.Sequence()) != 0 {
+		return
+	}
+
+	key := signedMessageKey{code: msg.Code, round: view.Round.Uint64()}
+	set, ok := c.signedMessages[key]
+	if !ok {
+		set = newMessageSet(c.valSet)
+		c.signedMessages[key] = set
+	}
+
+	prev := set.Conflicting(msg)
+	if prev == nil {
+		set.Add(msg)
+		return
+	}
+
+	logger := c.logger.New("from", src, "state", c.state)
+	logger.Warn("Validator equivocated", "code", msg.Code, "view", view)
+
+	first, err := prev.Payload()
+	if err != nil {
+		logger.Error("Failed to encode equivocating message", "err", err)
+		return
+	}
+	second, err := msg.Payload()
+	if err != nil {
+		logger.Error("Failed to encode equivocating message", "err", err)
+		return
+	}
+	evidence := &istanbul.Evidence{
+		Validator: msg.Address,
+		Code:      msg.Code,
+		View:      view,
+		First:     first,
+		Second:    second,
+	}
+	if err := c.backend.RecordEvidence(evidence); err != nil {
+		logger.Error("Failed to record equivocation evidence", "evidence", evidence, "err", err)
+	}
+}
+
+// VerifyEvidence checks that the evidence holds two different messages of the
+// same kind and view, both signed by the accused validator.
+func VerifyEvidence(evidence *istanbul.Evidence) error {
+	if evidence.View == nil || evidence.View.Round == nil || evidence.View.Sequence == nil {
+		return istanbul.ErrInvalidEvidence
+	}
+	recoverFn := func(data []byte, sig []byte) (common.Address, error) {
+		return istanbul.GetSignatureAddress(data, sig)
+	}
+
+	var msgs [2]*message
+	for i, payload := range [][]byte{evidence.First, evidence.Second} {
+		msg := new(message)
+		if err := msg.FromPayload(payload, recoverFn); err != nil {
+			return err
+		}
+		if msg.Address != evidence.Validator || msg.Code != evidence.Code || msg.Code > msgCommit {
+			return istanbul.ErrInvalidEvidence
+		}
+		view, err := msg.view()
+		if err != nil || view.Cmp(evidence.View) != 0 {
+			return istanbul.ErrInvalidEvidence
+		}
+		msgs[i] = msg
+	}
+	if bytes.Equal(msgs[0].Msg, msgs[1].Msg) {
+		return istanbul.ErrInvalidEvidence
+	}
+	return nil
+}
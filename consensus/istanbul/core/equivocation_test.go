@@ -0,0 +1,154 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/consensus/istanbul"
+	"github.com/ethereum/quorum/crypto"
+)
+
+func newSignedSubjectMessage(t *testing.T, key *ecdsa.PrivateKey, code uint64, view *istanbul.View, digest string) *message {
+	encoded, err := Encode(&istanbul.Subject{
+		View:   view,
+		Digest: common.StringToHash(digest),
+	})
+	if err != nil {
+		t.Fatalf("failed to encode subject: %v", err)
+	}
+	msg := &message{
+		Code:          code,
+		Msg:           encoded,
+		Address:       crypto.PubkeyToAddress(key.PublicKey),
+		CommittedSeal: []byte{},
+	}
+	data, err := msg.PayloadNoSig()
+	if err != nil {
+		t.Fatalf("failed to encode message: %v", err)
+	}
+	msg.Signature, err = crypto.Sign(crypto.Keccak256(data), key)
+	if err != nil {
+		t.Fatalf("failed to sign message: %v", err)
+	}
+	return msg
+}
+
+func TestCheckEquivocation(t *testing.T) {
+	sys := NewTestSystemWithBackend(4, 1)
+	backend := sys.backends[0]
+	c := backend.engine.(*core)
+	src := c.valSet.GetByIndex(1)
+
+	view := c.currentView()
+	first := newSignedSubjectMessage(t, mustGenerateKey(t), msgPrepare, view, "first")
+	first.Address = src.Address()
+	second := newSignedSubjectMessage(t, mustGenerateKey(t), msgPrepare, view, "second")
+	second.Address = src.Address()
+
+	c.checkEquivocation(first, src)
+	c.checkEquivocation(first, src)
+	if len(backend.evidence) != 0 {
+		t.Fatalf("evidence mismatch: have %v, want 0", len(backend.evidence))
+	}
+
+	// A different round is a different view, so it is not an equivocation
+	next := c.currentView()
+	next.Round = big.NewInt(1)
+	other := newSignedSubjectMessage(t, mustGenerateKey(t), msgPrepare, next, "second")
+	other.Address = src.Address()
+	c.checkEquivocation(other, src)
+	if len(backend.evidence) != 0 {
+		t.Fatalf("evidence mismatch: have %v, want 0", len(backend.evidence))
+	}
+
+	c.checkEquivocation(second, src)
+	if len(backend.evidence) != 1 {
+		t.Fatalf("evidence mismatch: have %v, want 1", len(backend.evidence))
+	}
+	evidence := backend.evidence[0]
+	if evidence.Validator != src.Address() || evidence.Code != msgPrepare || evidence.View.Cmp(view) != 0 {
+		t.Errorf("evidence mismatch: have %v", evidence)
+	}
+}
+
+func TestVerifyEvidence(t *testing.T) {
+	key := mustGenerateKey(t)
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	view := &istanbul.View{
+		Round:    big.NewInt(0),
+		Sequence: big.NewInt(1),
+	}
+	payload := func(msg *message) []byte {
+		p, err := msg.Payload()
+		if err != nil {
+			t.Fatalf("failed to encode message: %v", err)
+		}
+		return p
+	}
+
+	first := payload(newSignedSubjectMessage(t, key, msgCommit, view, "first"))
+	second := payload(newSignedSubjectMessage(t, key, msgCommit, view, "second"))
+	forged := payload(newSignedSubjectMessage(t, mustGenerateKey(t), msgCommit, view, "second"))
+
+	testCases := []struct {
+		evidence *istanbul.Evidence
+		expected error
+	}{
+		{
+			// conflicting commits signed by the same validator
+			&istanbul.Evidence{Validator: addr, Code: msgCommit, View: view, First: first, Second: second},
+			nil,
+		},
+		{
+			// the same message twice
+			&istanbul.Evidence{Validator: addr, Code: msgCommit, View: view, First: first, Second: first},
+			istanbul.ErrInvalidEvidence,
+		},
+		{
+			// the second message is signed by someone else
+			&istanbul.Evidence{Validator: addr, Code: msgCommit, View: view, First: first, Second: forged},
+			istanbul.ErrInvalidEvidence,
+		},
+		{
+			// message code mismatch
+			&istanbul.Evidence{Validator: addr, Code: msgPrepare, View: view, First: first, Second: second},
+			istanbul.ErrInvalidEvidence,
+		},
+		{
+			// view mismatch
+			&istanbul.Evidence{Validator: addr, Code: msgCommit, View: &istanbul.View{Round: big.NewInt(1), Sequence: big.NewInt(1)}, First: first, Second: second},
+			istanbul.ErrInvalidEvidence,
+		},
+	}
+	for i, test := range testCases {
+		if err := VerifyEvidence(test.evidence); err != test.expected {
+			t.Errorf("case %d: error mismatch: have %v, want %v", i, err, test.expected)
+		}
+	}
+}
+
+func mustGenerateKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	return key
+}
@@ -164,6 +164,8 @@ func (c *core) handleCheckedMsg(msg *message, src istanbul.Validator) error {
 		return err
 	}
 
+	c.checkEquivocation(msg, src)
+
 	switch msg.Code {
 	case msgPreprepare:
 		return testBacklog(c.handlePreprepare(msg, src))
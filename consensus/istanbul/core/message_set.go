@@ -17,6 +17,7 @@
 package core
 
 import (
+	"bytes"
 	"fmt"
 	"math/big"
 	"strings"
@@ -86,6 +87,18 @@ func (ms *messageSet) Get(addr common.Address) *message {
 	return ms.messages[addr]
 }
 
+// Conflicting returns the message previously added by the sender of msg if its
+// content differs from msg, or nil if there is none.
+func (ms *messageSet) Conflicting(msg *message) *message {
+	ms.messagesMu.Lock()
+	defer ms.messagesMu.Unlock()
+
+	if prev, ok := ms.messages[msg.Address]; ok && !bytes.Equal(prev.Msg, msg.Msg) {
+		return prev
+	}
+	return nil
+}
+
 // ----------------------------------------------------------------------------
 
 func (ms *messageSet) verify(msg *message) error {
@@ -104,3 +104,42 @@ func TestMessageSetWithSubject(t *testing.T) {
 		t.Errorf("the size of message set mismatch: have %v, want 1", ms.Size())
 	}
 }
+
+func TestMessageSetConflicting(t *testing.T) {
+	valSet := newTestValidatorSet(4)
+
+	ms := newMessageSet(valSet)
+
+	view := &istanbul.View{
+		Round:    new(big.Int),
+		Sequence: new(big.Int),
+	}
+	newMsg := func(digest string) *message {
+		rawSub, err := rlp.EncodeToBytes(&istanbul.Subject{
+			View:   view,
+			Digest: common.StringToHash(digest),
+		})
+		if err != nil {
+			t.Fatalf("error mismatch: have %v, want nil", err)
+		}
+		return &message{
+			Code:    msgCommit,
+			Msg:     rawSub,
+			Address: valSet.GetProposer().Address(),
+		}
+	}
+
+	first := newMsg("1234567890")
+	if prev := ms.Conflicting(first); prev != nil {
+		t.Errorf("conflicting message mismatch: have %v, want nil", prev)
+	}
+	if err := ms.Add(first); err != nil {
+		t.Errorf("error mismatch: have %v, want nil", err)
+	}
+	if prev := ms.Conflicting(newMsg("1234567890")); prev != nil {
+		t.Errorf("conflicting message mismatch: have %v, want nil", prev)
+	}
+	if prev := ms.Conflicting(newMsg("0987654321")); prev != first {
+		t.Errorf("conflicting message mismatch: have %v, want %v", prev, first)
+	}
+}
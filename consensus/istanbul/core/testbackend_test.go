@@ -42,6 +42,7 @@ type testSystemBackend struct {
 
 	committedMsgs []testCommittedMsgs
 	sentMsgs      [][]byte // store the message when Send is called by core
+	evidence      []*istanbul.Evidence
 
 	address common.Address
 	db      ethdb.Database
@@ -135,6 +136,11 @@ func (self *testSystemBackend) HasBadProposal(hash common.Hash) bool {
 	return false
 }
 
+func (self *testSystemBackend) RecordEvidence(evidence *istanbul.Evidence) error {
+	self.evidence = append(self.evidence, evidence)
+	return nil
+}
+
 func (self *testSystemBackend) LastProposal() (istanbul.Proposal, common.Address) {
 	l := len(self.committedMsgs)
 	if l > 0 {
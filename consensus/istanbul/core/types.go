@@ -22,6 +22,7 @@ import (
 	"io"
 
 	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/consensus/istanbul"
 	"github.com/ethereum/quorum/rlp"
 )
 
@@ -167,6 +168,28 @@ func (m *message) Decode(val interface{}) error {
 	return rlp.DecodeBytes(m.Msg, val)
 }
 
+// view decodes the view carried by a PRE-PREPARE, PREPARE, COMMIT or ROUND CHANGE message.
+func (m *message) view() (*istanbul.View, error) {
+	var view *istanbul.View
+	if m.Code == msgPreprepare {
+		var preprepare *istanbul.Preprepare
+		if err := m.Decode(&preprepare); err != nil {
+			return nil, err
+		}
+		view = preprepare.View
+	} else {
+		var subject *istanbul.Subject
+		if err := m.Decode(&subject); err != nil {
+			return nil, err
+		}
+		view = subject.View
+	}
+	if view == nil || view.Round == nil || view.Sequence == nil {
+		return nil, errInvalidMessage
+	}
+	return view, nil
+}
+
 func (m *message) String() string {
 	return fmt.Sprintf("{Code: %v, Address: %v}", m.Code, m.Address.String())
 }
@@ -26,4 +26,7 @@ var (
 	ErrStoppedEngine = errors.New("stopped engine")
 	// ErrStartedEngine is returned if the engine is already started
 	ErrStartedEngine = errors.New("started engine")
+	// ErrInvalidEvidence is returned if the evidence does not prove that a
+	// validator signed two conflicting messages for the same view
+	ErrInvalidEvidence = errors.New("invalid equivocation evidence")
 )
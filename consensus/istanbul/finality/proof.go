@@ -0,0 +1,179 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package finality implements proofs that an Istanbul block was committed by
+// the validators, which can be checked without running a node.
+//
+// From the validator set fork block on, every Istanbul header lists the validators
+// that were allowed to seal it in its extra-data, and carries the committed seals
+// of those validators. Starting from a trusted checkpoint past that block, a client
+// only needs the headers at which the validator set changed to follow the
+// validator set up to the block it is interested in.
+package finality
+
+import (
+	"errors"
+	"math"
+	"math/big"
+
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/consensus/istanbul"
+	istanbulCore "github.com/ethereum/quorum/consensus/istanbul/core"
+	"github.com/ethereum/quorum/consensus/istanbul/validator"
+	"github.com/ethereum/quorum/core/types"
+)
+
+var (
+	// ErrInvalidHeader is returned if a header of the proof is not an Istanbul
+	// header, or the headers are not ordered after the checkpoint.
+	ErrInvalidHeader = errors.New("invalid istanbul header")
+	// ErrInvalidSigner is returned if a header is not signed by one of its validators.
+	ErrInvalidSigner = errors.New("invalid proposer seal")
+	// ErrInvalidCommittedSeals is returned if a header is not committed by a
+	// quorum of its validators.
+	ErrInvalidCommittedSeals = errors.New("invalid committed seals")
+	// ErrUntrustedValidators is returned if a validator set change is not
+	// vouched for by the previously trusted validators.
+	ErrUntrustedValidators = errors.New("untrusted validator set change")
+	// ErrUnenforcedValidators is returned if the checkpoint precedes the block
+	// from which headers have to list the validators sealing them.
+	ErrUnenforcedValidators = errors.New("checkpoint precedes the validator set fork block")
+)
+
+// Checkpoint is a block trusted by the client, together with the validators that
+// seal its child (i.e. the validator set after applying the block's votes).
+type Checkpoint struct {
+	Number     uint64           `json:"number"`
+	Hash       common.Hash      `json:"hash"`
+	Validators []common.Address `json:"validators"`
+}
+
+// Proof proves that a block was committed since a trusted checkpoint. The
+// committed seals are part of the extra-data of the headers.
+type Proof struct {
+	Header           *types.Header   `json:"header"`           // Header of the proven block
+	ValidatorChanges []*types.Header `json:"validatorChanges"` // Headers after the checkpoint introducing a new validator set
+}
+
+// Verify checks that the proven block was committed by the validators, following
+// the validator set changes from the checkpoint on. The Ceil2Nby3Block and the
+// ValidatorSetBlock of the config have to match the chain configuration, as they
+// define the size of a quorum and whether the header validators can be trusted.
+func Verify(checkpoint *Checkpoint, proof *Proof, config *istanbul.Config) error {
+	if proof.Header == nil {
+		return ErrInvalidHeader
+	}
+	if !config.IsValidatorSetEnforced(new(big.Int).SetUint64(checkpoint.Number + 1)) {
+		return ErrUnenforcedValidators
+	}
+	trusted := validator.NewSet(checkpoint.Validators, istanbul.RoundRobin)
+	number := checkpoint.Number
+
+	for _, header := range append(proof.ValidatorChanges, proof.Header) {
+		if header == nil || header.Number == nil || header.Number.Uint64() <= number {
+			return ErrInvalidHeader
+		}
+		// The first header after the checkpoint has to extend it
+		if header.Number.Uint64() == checkpoint.Number+1 && header.ParentHash != checkpoint.Hash {
+			return ErrInvalidHeader
+		}
+		next, err := verifyHeader(trusted, header, config.Ceil2Nby3Block)
+		if err != nil {
+			return err
+		}
+		trusted, number = next, header.Number.Uint64()
+	}
+	return nil
+}
+
+// verifyHeader checks a header against the trusted validator set and returns
+// the validator set the header was sealed by.
+func verifyHeader(trusted istanbul.ValidatorSet, header *types.Header, ceil2Nby3Block *big.Int) (istanbul.ValidatorSet, error) {
+	if header.MixDigest != types.IstanbulDigest {
+		return nil, ErrInvalidHeader
+	}
+	extra, err := types.ExtractIstanbulExtra(header)
+	if err != nil {
+		return nil, ErrInvalidHeader
+	}
+	valSet := validator.NewSet(extra.Validators, istanbul.RoundRobin)
+
+	// The proposer has to be one of the header's validators
+	proposer, err := istanbul.GetSignatureAddress(sigHash(header).Bytes(), extra.Seal)
+	if err != nil {
+		return nil, ErrInvalidSigner
+	}
+	if _, v := valSet.GetByAddress(proposer); v == nil {
+		return nil, ErrInvalidSigner
+	}
+
+	// Every committed seal has to be signed by a different validator
+	signers := make([]common.Address, 0, len(extra.CommittedSeal))
+	validators := valSet.Copy()
+	proposalSeal := istanbulCore.PrepareCommittedSeal(header.Hash())
+	for _, seal := range extra.CommittedSeal {
+		addr, err := istanbul.GetSignatureAddress(proposalSeal, seal)
+		if err != nil || !validators.RemoveValidator(addr) {
+			return nil, ErrInvalidCommittedSeals
+		}
+		signers = append(signers, addr)
+	}
+	if len(signers) < quorumSize(valSet, header.Number, ceil2Nby3Block) {
+		return nil, ErrInvalidCommittedSeals
+	}
+
+	// A new validator set is only accepted if at least one honest validator of
+	// the trusted set committed to it
+	if !sameValidators(trusted, valSet) {
+		vouched := 0
+		for _, addr := range signers {
+			if _, v := trusted.GetByAddress(addr); v != nil {
+				vouched++
+			}
+		}
+		if vouched <= trusted.F() {
+			return nil, ErrUntrustedValidators
+		}
+	}
+	return valSet, nil
+}
+
+// quorumSize returns the number of committed seals required for the block,
+// following the same rules as the consensus engine.
+func quorumSize(valSet istanbul.ValidatorSet, number *big.Int, ceil2Nby3Block *big.Int) int {
+	if ceil2Nby3Block == nil || number.Cmp(ceil2Nby3Block) < 0 {
+		return 2*valSet.F() + 1
+	}
+	return int(math.Ceil(float64(2*valSet.Size()) / 3))
+}
+
+// sameValidators returns whether both validator sets hold the same validators.
+func sameValidators(a, b istanbul.ValidatorSet) bool {
+	if a.Size() != b.Size() {
+		return false
+	}
+	for _, v := range a.List() {
+		if _, found := b.GetByAddress(v.Address()); found == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// sigHash returns the hash signed by the proposer of the header.
+func sigHash(header *types.Header) common.Hash {
+	return istanbul.RLPHash(types.IstanbulFilteredHeader(header, false))
+}
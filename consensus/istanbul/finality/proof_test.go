@@ -0,0 +1,173 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package finality
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/consensus/istanbul"
+	istanbulCore "github.com/ethereum/quorum/consensus/istanbul/core"
+	"github.com/ethereum/quorum/core/types"
+	"github.com/ethereum/quorum/crypto"
+	"github.com/ethereum/quorum/rlp"
+)
+
+func newKeys(t *testing.T, n int) ([]*ecdsa.PrivateKey, []common.Address) {
+	keys := make([]*ecdsa.PrivateKey, n)
+	addrs := make([]common.Address, n)
+	for i := range keys {
+		key, err := crypto.GenerateKey()
+		if err != nil {
+			t.Fatalf("failed to generate key: %v", err)
+		}
+		keys[i], addrs[i] = key, crypto.PubkeyToAddress(key.PublicKey)
+	}
+	return keys, addrs
+}
+
+// newHeader creates a header sealed by the first signer and committed by all signers.
+func newHeader(t *testing.T, number uint64, parent common.Hash, validators []common.Address, signers []*ecdsa.PrivateKey) *types.Header {
+	header := &types.Header{
+		ParentHash: parent,
+		Number:     new(big.Int).SetUint64(number),
+		Difficulty: big.NewInt(1),
+		Time:       big.NewInt(1),
+		MixDigest:  types.IstanbulDigest,
+	}
+	setExtra := func(extra *types.IstanbulExtra) {
+		payload, err := rlp.EncodeToBytes(extra)
+		if err != nil {
+			t.Fatalf("failed to encode extra: %v", err)
+		}
+		header.Extra = append(bytes.Repeat([]byte{0x00}, types.IstanbulExtraVanity), payload...)
+	}
+	extra := &types.IstanbulExtra{
+		Validators:    validators,
+		Seal:          []byte{},
+		CommittedSeal: [][]byte{},
+	}
+	setExtra(extra)
+
+	seal, err := crypto.Sign(crypto.Keccak256(sigHash(header).Bytes()), signers[0])
+	if err != nil {
+		t.Fatalf("failed to seal header: %v", err)
+	}
+	extra.Seal = seal
+	setExtra(extra)
+
+	committed := istanbulCore.PrepareCommittedSeal(header.Hash())
+	for _, key := range signers {
+		seal, err := crypto.Sign(crypto.Keccak256(committed), key)
+		if err != nil {
+			t.Fatalf("failed to commit header: %v", err)
+		}
+		extra.CommittedSeal = append(extra.CommittedSeal, seal)
+	}
+	setExtra(extra)
+	return header
+}
+
+func TestVerify(t *testing.T) {
+	keys, addrs := newKeys(t, 5)
+	outsiders, outsiderAddrs := newKeys(t, 4)
+
+	checkpoint := &Checkpoint{
+		Number:     10,
+		Hash:       common.StringToHash("checkpoint"),
+		Validators: addrs[:4],
+	}
+	// The fifth validator joins at block 20
+	grown := append([]common.Address{}, addrs...)
+	change := newHeader(t, 20, common.StringToHash("19"), grown, keys[1:5])
+	// A validator set no trusted validator ever committed to
+	forged := newHeader(t, 20, common.StringToHash("19"), outsiderAddrs, outsiders)
+
+	testCases := []struct {
+		proof    *Proof
+		expected error
+	}{
+		{
+			// block committed by the checkpoint validators
+			&Proof{Header: newHeader(t, 11, checkpoint.Hash, addrs[:4], keys[:3])},
+			nil,
+		},
+		{
+			// block after a validator set change
+			&Proof{Header: newHeader(t, 30, common.StringToHash("29"), grown, keys[:4]), ValidatorChanges: []*types.Header{change}},
+			nil,
+		},
+		{
+			// the first block doesn't extend the checkpoint
+			&Proof{Header: newHeader(t, 11, common.StringToHash("other"), addrs[:4], keys[:3])},
+			ErrInvalidHeader,
+		},
+		{
+			// block not after the checkpoint
+			&Proof{Header: newHeader(t, 10, common.StringToHash("9"), addrs[:4], keys[:3])},
+			ErrInvalidHeader,
+		},
+		{
+			// not enough committed seals
+			&Proof{Header: newHeader(t, 12, common.StringToHash("11"), addrs[:4], keys[:2])},
+			ErrInvalidCommittedSeals,
+		},
+		{
+			// committed seals of outsiders
+			&Proof{Header: newHeader(t, 12, common.StringToHash("11"), addrs[:4], []*ecdsa.PrivateKey{keys[0], keys[1], outsiders[0]})},
+			ErrInvalidCommittedSeals,
+		},
+		{
+			// sealed by an outsider
+			&Proof{Header: newHeader(t, 12, common.StringToHash("11"), addrs[:4], append([]*ecdsa.PrivateKey{outsiders[0]}, keys[:3]...))},
+			ErrInvalidSigner,
+		},
+		{
+			// block committed by a validator set that was never introduced
+			&Proof{Header: newHeader(t, 30, common.StringToHash("29"), outsiderAddrs, outsiders)},
+			ErrUntrustedValidators,
+		},
+		{
+			// validator set change that was not vouched for
+			&Proof{Header: newHeader(t, 30, common.StringToHash("29"), outsiderAddrs, outsiders), ValidatorChanges: []*types.Header{forged}},
+			ErrUntrustedValidators,
+		},
+		{
+			// validator set changes out of order
+			&Proof{Header: newHeader(t, 15, common.StringToHash("14"), grown, keys[:4]), ValidatorChanges: []*types.Header{change}},
+			ErrInvalidHeader,
+		},
+	}
+	config := &istanbul.Config{Ceil2Nby3Block: big.NewInt(0), ValidatorSetBlock: big.NewInt(0)}
+	for i, test := range testCases {
+		if err := Verify(checkpoint, test.proof, config); err != test.expected {
+			t.Errorf("case %d: error mismatch: have %v, want %v", i, err, test.expected)
+		}
+	}
+
+	// Header validators can't be trusted before the validator set fork block
+	proof := &Proof{Header: newHeader(t, 11, checkpoint.Hash, addrs[:4], keys[:3])}
+	for _, block := range []*big.Int{nil, big.NewInt(12)} {
+		config := &istanbul.Config{Ceil2Nby3Block: big.NewInt(0), ValidatorSetBlock: block}
+		if err := Verify(checkpoint, proof, config); err != ErrUnenforcedValidators {
+			t.Errorf("fork block %v: error mismatch: have %v, want %v", block, err, ErrUnenforcedValidators)
+		}
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"math/big"
 
 	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/common/hexutil"
 	"github.com/ethereum/quorum/core/types"
 	"github.com/ethereum/quorum/rlp"
 )
@@ -145,3 +146,18 @@ func (b *Subject) DecodeRLP(s *rlp.Stream) error {
 func (b *Subject) String() string {
 	return fmt.Sprintf("{View: %v, Digest: %v}", b.View, b.Digest.String())
 }
+
+// Evidence is a proof that a validator signed two conflicting consensus messages
+// of the same kind for the same view. First and Second hold the complete signed
+// message payloads, so the evidence can be verified without trusting the reporter.
+type Evidence struct {
+	Validator common.Address `json:"validator"`
+	Code      uint64         `json:"code"`
+	View      *View          `json:"view"`
+	First     hexutil.Bytes  `json:"first"`
+	Second    hexutil.Bytes  `json:"second"`
+}
+
+func (e *Evidence) String() string {
+	return fmt.Sprintf("{Validator: %v, Code: %v, View: %v}", e.Validator.String(), e.Code, e.View)
+}
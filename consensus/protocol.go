@@ -20,6 +20,7 @@ package consensus
 import (
 	"github.com/ethereum/quorum/common"
 	"github.com/ethereum/quorum/core/types"
+	"github.com/ethereum/quorum/p2p/enode"
 )
 
 // Constants to match up protocol versions and messages
@@ -52,6 +53,18 @@ type Broadcaster interface {
 	Enqueue(id string, block *types.Block)
 	// FindPeers retrives peers by addresses
 	FindPeers(map[common.Address]bool) map[common.Address]Peer
+	// AllPeers retrieves all connected peers by address
+	AllPeers() map[common.Address]Peer
+}
+
+// ValidatorPool defines the interface to keep direct connections between validators
+type ValidatorPool interface {
+	// Self returns the local node's record
+	Self() *enode.Node
+	// AddValidatorPeer keeps a direct connection to the given validator node
+	AddValidatorPeer(node *enode.Node)
+	// RemoveValidatorPeer drops the given node from the validator pool
+	RemoveValidatorPeer(node *enode.Node)
 }
 
 // Peer defines the interface to communicate with peer
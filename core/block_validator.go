@@ -70,6 +70,40 @@ func (v *BlockValidator) ValidateBody(block *types.Block) error {
 		}
 		return consensus.ErrPrunedAncestor
 	}
+	return v.validateAccountAccess(block)
+}
+
+// validateAccountAccess rejects blocks with transactions the senders are not
+// permissioned for. Access is evaluated against the permission contracts in
+// the state of the parent block, as when the block was built, rather than
+// against the permission caches which follow the head of the chain. Invalid
+// signatures are left to the state processor.
+func (v *BlockValidator) validateAccountAccess(block *types.Block) error {
+	header := block.Header()
+	if !v.config.IsQuorum || (v.config.QIP714Block != nil && !v.config.IsQIP714(header.Number)) || len(block.Transactions()) == 0 {
+		return nil
+	}
+	parent := v.bc.GetHeader(block.ParentHash(), block.NumberU64()-1)
+	access, err := types.PermissionsAt(parent)
+	if err != nil {
+		return err
+	}
+	if access == nil {
+		return nil
+	}
+	signer := types.MakeSigner(v.config, header.Number)
+	for _, tx := range block.Transactions() {
+		from, err := types.Sender(signer, tx)
+		if err != nil {
+			continue
+		}
+		if err := CheckAccountAccess(v.config, access, header.Number, AccessTime(header), from, tx); err != nil {
+			if access.Err() != nil {
+				return access.Err()
+			}
+			return fmt.Errorf("unauthorized transaction %x from %x: %v", tx.Hash(), from, err)
+		}
+	}
 	return nil
 }
 
@@ -17,13 +17,17 @@
 package core
 
 import (
+	"math/big"
 	"runtime"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/ethereum/quorum/common"
 	"github.com/ethereum/quorum/consensus/ethash"
 	"github.com/ethereum/quorum/core/types"
 	"github.com/ethereum/quorum/core/vm"
+	"github.com/ethereum/quorum/crypto"
 	"github.com/ethereum/quorum/ethdb"
 	"github.com/ethereum/quorum/params"
 )
@@ -197,3 +201,64 @@ func testHeaderConcurrentAbortion(t *testing.T, threads int) {
 		t.Errorf("verification count too large: have %d, want below %d", verified, 2*threads)
 	}
 }
+
+// accessReader serves a single approved org whose roles can transact, to the
+// accounts it holds.
+type accessReader map[common.Address]bool
+
+func (r accessReader) ReadOrg(orgId string) (*types.OrgInfo, error) {
+	return &types.OrgInfo{OrgId: orgId, FullOrgId: orgId, UltimateParent: orgId, Level: big.NewInt(1), Status: types.OrgApproved}, nil
+}
+
+func (r accessReader) ReadRole(orgId, roleId string) (*types.RoleInfo, error) {
+	return &types.RoleInfo{OrgId: orgId, RoleId: roleId, Access: types.Transact, Active: true}, nil
+}
+
+func (r accessReader) ReadAccount(acct common.Address) (*types.AccountInfo, error) {
+	if !r[acct] {
+		return nil, nil
+	}
+	return &types.AccountInfo{OrgId: "ORG", RoleId: "ROLE", AcctId: acct, Status: types.AcctActive}, nil
+}
+
+// Tests that block bodies are checked against the permission contracts at the
+// parent block.
+func TestValidateBodyAccountAccess(t *testing.T) {
+	var (
+		key, _  = crypto.GenerateKey()
+		sender  = crypto.PubkeyToAddress(key.PublicKey)
+		config  = *params.TestChainConfig
+		testdb  = ethdb.NewMemDatabase()
+		gspec   = &Genesis{Config: &config, Alloc: GenesisAlloc{sender: {Balance: big.NewInt(1000000)}}}
+		genesis = gspec.MustCommit(testdb)
+	)
+	config.IsQuorum = true
+	blocks, _ := GenerateChain(&config, genesis, ethash.NewFaker(), testdb, 1, func(i int, gen *BlockGen) {
+		tx, _ := types.SignTx(types.NewTransaction(0, common.Address{1}, big.NewInt(0), 21000, big.NewInt(0), nil), types.MakeSigner(&config, gen.Number()), key)
+		gen.AddTx(tx)
+	})
+	chain, _ := NewBlockChain(testdb, nil, &config, ethash.NewFaker(), vm.Config{}, nil)
+	defer chain.Stop()
+
+	var read *types.Header
+	reader := accessReader{}
+	types.SetPermissionsAt(func(header *types.Header) (types.PermissionReader, error) {
+		read = header
+		return reader, nil
+	})
+	defer types.SetPermissionsAt(nil)
+
+	// The sender is unknown to the permission contracts
+	err := chain.Validator().ValidateBody(blocks[0])
+	if err == nil || !strings.Contains(err.Error(), ErrReadOnlyAccount.Error()) {
+		t.Errorf("error mismatch: have %v, want %v", err, ErrReadOnlyAccount)
+	}
+	if read == nil || read.Hash() != genesis.Hash() {
+		t.Errorf("permissions not read at the parent block: have %v, want %x", read, genesis.Hash())
+	}
+	// The sender can transact
+	reader[sender] = true
+	if err := chain.Validator().ValidateBody(blocks[0]); err != nil {
+		t.Errorf("error mismatch: have %v, want nil", err)
+	}
+}
@@ -72,6 +72,7 @@ type CacheConfig struct {
 	Disabled      bool          // Whether to disable trie write caching (archive node)
 	TrieNodeLimit int           // Memory limit (MB) at which to flush the current in-memory trie to disk
 	TrieTimeLimit time.Duration // Time limit after which to flush the current in-memory trie to disk
+	Snapshots     bool          // Whether to read the public and private states through flat snapshots
 }
 
 // BlockChain represents the canonical chain given a database with a genesis
@@ -154,12 +155,17 @@ func NewBlockChain(db ethdb.Database, cacheConfig *CacheConfig, chainConfig *par
 	futureBlocks, _ := lru.New(maxFutureBlocks)
 	badBlocks, _ := lru.New(badBlockLimit)
 
+	stateCache, privateStateCache := state.NewDatabase(db), state.NewDatabase(db)
+	if cacheConfig.Snapshots {
+		stateCache = state.NewDatabaseWithSnapshots(db, db)
+		privateStateCache = state.NewDatabaseWithSnapshots(db, ethdb.NewTable(db, PrivateSnapshotPrefix))
+	}
 	bc := &BlockChain{
 		chainConfig:       chainConfig,
 		cacheConfig:       cacheConfig,
 		db:                db,
 		triegc:            prque.New(nil),
-		stateCache:        state.NewDatabase(db),
+		stateCache:        stateCache,
 		quit:              make(chan struct{}),
 		shouldPreserve:    shouldPreserve,
 		bodyCache:         bodyCache,
@@ -170,7 +176,7 @@ func NewBlockChain(db ethdb.Database, cacheConfig *CacheConfig, chainConfig *par
 		engine:            engine,
 		vmConfig:          vmConfig,
 		badBlocks:         badBlocks,
-		privateStateCache: state.NewDatabase(db),
+		privateStateCache: privateStateCache,
 	}
 	bc.SetValidator(NewBlockValidator(chainConfig, bc, engine))
 	bc.SetProcessor(NewStateProcessor(chainConfig, bc, engine))
@@ -200,11 +206,31 @@ func NewBlockChain(db ethdb.Database, cacheConfig *CacheConfig, chainConfig *par
 			}
 		}
 	}
+	bc.loadSnapshots()
+
 	// Take ownership of this particular state
 	go bc.update()
 	return bc, nil
 }
 
+// loadSnapshots loads the snapshots of the public and private states of the
+// head block, generating them if the ones on disk are of other states. The
+// states are read from the tries if the snapshots can't be loaded.
+func (bc *BlockChain) loadSnapshots() {
+	head := bc.CurrentBlock()
+	if snaps := bc.stateCache.Snapshots(); snaps != nil {
+		if err := snaps.Load(head.Root()); err != nil {
+			log.Error("Failed to load state snapshot", "number", head.Number(), "root", head.Root(), "err", err)
+		}
+	}
+	if snaps := bc.privateStateCache.Snapshots(); snaps != nil {
+		root := GetPrivateStateRoot(bc.db, head.Root())
+		if err := snaps.Load(root); err != nil {
+			log.Error("Failed to load private state snapshot", "number", head.Number(), "root", root, "err", err)
+		}
+	}
+}
+
 func (bc *BlockChain) getProcInterrupt() bool {
 	return atomic.LoadInt32(&bc.procInterrupt) == 1
 }
@@ -293,6 +319,13 @@ func (bc *BlockChain) SetHead(head uint64) error {
 	bc.hc.SetHead(head, delFn)
 	currentHeader := bc.hc.CurrentHeader()
 
+	// Discard the rewound blocks from the ancient store too
+	if ancients, ok := bc.db.(rawdb.AncientStore); ok {
+		if err := ancients.TruncateAncients(currentHeader.Number.Uint64() + 1); err != nil {
+			log.Error("Failed to truncate ancient store", "number", currentHeader.Number, "err", err)
+		}
+	}
+
 	// Clear out any stale content from the caches
 	bc.bodyCache.Purge()
 	bc.bodyRLPCache.Purge()
@@ -402,6 +435,12 @@ func (bc *BlockChain) Processor() Processor {
 	return bc.processor
 }
 
+// Hooks returns the hooks receiving the EVM events of the blocks written, nil
+// if there are none.
+func (bc *BlockChain) Hooks() vm.Hooks {
+	return bc.vmConfig.Hooks
+}
+
 // State returns a new mutable state based on the current HEAD block.
 func (bc *BlockChain) State() (*state.StateDB, *state.StateDB, error) {
 	return bc.StateAt(bc.CurrentBlock().Root())
@@ -724,6 +763,20 @@ func (bc *BlockChain) Stop() {
 			log.Error("Dangling trie nodes after full cleanup")
 		}
 	}
+	// Merge the snapshots of the head states into the disk layers, the ones
+	// reloaded on restart.
+	head := bc.CurrentBlock()
+	if snaps := bc.stateCache.Snapshots(); snaps != nil {
+		if err := snaps.Cap(head.Root(), 0); err != nil {
+			log.Error("Failed to write state snapshot", "root", head.Root(), "err", err)
+		}
+	}
+	if snaps := bc.privateStateCache.Snapshots(); snaps != nil {
+		root := GetPrivateStateRoot(bc.db, head.Root())
+		if err := snaps.Cap(root, 0); err != nil {
+			log.Error("Failed to write private state snapshot", "root", root, "err", err)
+		}
+	}
 	log.Info("Blockchain manager stopped")
 }
 
@@ -1250,8 +1303,15 @@ func (bc *BlockChain) insertChain(chain types.Blocks) (int, []interface{}, []*ty
 		}
 		// /Quorum
 
-		// Process block using the parent state as reference point.
-		receipts, privateReceipts, logs, usedGas, err := bc.processor.Process(block, state, privateState, bc.vmConfig)
+		// Process block using the parent state as reference point. The events of
+		// the hooks are held back until the block is written.
+		vmConfig := bc.vmConfig
+		var hooks *vm.HookRecorder
+		if bc.vmConfig.Hooks != nil {
+			hooks = vm.NewHookRecorder(bc.vmConfig.Hooks)
+			vmConfig.Hooks = hooks
+		}
+		receipts, privateReceipts, logs, usedGas, err := bc.processor.Process(block, state, privateState, vmConfig)
 		if err != nil {
 			bc.reportBlock(block, receipts, err)
 			return i, events, coalescedLogs, err
@@ -1284,6 +1344,9 @@ func (bc *BlockChain) insertChain(chain types.Blocks) (int, []interface{}, []*ty
 		if err := WritePrivateBlockBloom(bc.db, block.NumberU64(), privateReceipts); err != nil {
 			return i, events, coalescedLogs, err
 		}
+		if hooks != nil {
+			hooks.BlockCommitted(block)
+		}
 		switch status {
 		case CanonStatTy:
 			log.Debug("Inserted new block", "number", block.Number(), "hash", block.Hash(), "uncles", len(block.Uncles()),
@@ -1544,9 +1607,12 @@ func (bc *BlockChain) addBadBlock(block *types.Block) {
 	bc.badBlocks.Add(block.Hash(), block)
 }
 
-// reportBlock logs a bad block error.
+// reportBlock logs a bad block error and reports it to the hooks.
 func (bc *BlockChain) reportBlock(block *types.Block, receipts types.Receipts, err error) {
 	bc.addBadBlock(block)
+	if hooks := bc.vmConfig.Hooks; hooks != nil {
+		hooks.BlockRejected(block, err)
+	}
 
 	var receiptString string
 	for _, receipt := range receipts {
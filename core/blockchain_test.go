@@ -29,6 +29,7 @@ import (
 	"github.com/ethereum/quorum/consensus/ethash"
 	"github.com/ethereum/quorum/core/rawdb"
 	"github.com/ethereum/quorum/core/state"
+	"github.com/ethereum/quorum/core/state/snapshot"
 	"github.com/ethereum/quorum/core/types"
 	"github.com/ethereum/quorum/core/vm"
 	"github.com/ethereum/quorum/crypto"
@@ -1375,6 +1376,144 @@ func TestLargeReorgTrieGC(t *testing.T) {
 	}
 }
 
+// Tests that the snapshots of the imported states are kept in memory for the
+// recent blocks, and written to disk for the head block on stop.
+func TestSnapshots(t *testing.T) {
+	engine := ethash.NewFaker()
+
+	db := ethdb.NewMemDatabase()
+	genesis := new(Genesis).MustCommit(db)
+	blocks, _ := GenerateChain(params.TestChainConfig, genesis, engine, db, triesInMemory+8, func(i int, b *BlockGen) {
+		b.SetCoinbase(common.Address{byte(i % 4)})
+	})
+	head := blocks[len(blocks)-1]
+
+	diskdb := ethdb.NewMemDatabase()
+	new(Genesis).MustCommit(diskdb)
+
+	cacheConfig := &CacheConfig{TrieNodeLimit: 256, TrieTimeLimit: 5 * time.Minute, Snapshots: true}
+	chain, err := NewBlockChain(diskdb, cacheConfig, params.TestChainConfig, engine, vm.Config{}, nil)
+	if err != nil {
+		t.Fatalf("failed to create tester chain: %v", err)
+	}
+	if _, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert chain: %v", err)
+	}
+	if chain.stateCache.Snapshots().Snapshot(head.Root()) == nil {
+		t.Fatalf("head state snapshot missing")
+	}
+	if root, want := snapshot.Root(diskdb), blocks[len(blocks)-1-triesInMemory].Root(); root != want {
+		t.Errorf("disk snapshot root mismatch: have %x, want %x", root, want)
+	}
+	chain.Stop()
+
+	if root := snapshot.Root(diskdb); root != head.Root() {
+		t.Fatalf("disk snapshot root mismatch after stop: have %x, want %x", root, head.Root())
+	}
+	if err := snapshot.Verify(diskdb); err != nil {
+		t.Fatalf("failed to verify snapshot: %v", err)
+	}
+	private := ethdb.NewTable(diskdb, PrivateSnapshotPrefix)
+	if root := snapshot.Root(private); root != types.EmptyRootHash {
+		t.Fatalf("private disk snapshot root mismatch: have %x, want %x", root, types.EmptyRootHash)
+	}
+	// Reopen the chain, the snapshots on disk are loaded
+	chain, err = NewBlockChain(diskdb, cacheConfig, params.TestChainConfig, engine, vm.Config{}, nil)
+	if err != nil {
+		t.Fatalf("failed to reopen tester chain: %v", err)
+	}
+	defer chain.Stop()
+
+	if chain.stateCache.Snapshots().Snapshot(head.Root()) == nil {
+		t.Fatalf("head state snapshot not loaded")
+	}
+	state, _, err := chain.State()
+	if err != nil {
+		t.Fatalf("failed to open head state: %v", err)
+	}
+	for i := byte(0); i < 4; i++ {
+		if state.GetBalance(common.Address{i}).Sign() == 0 {
+			t.Errorf("coinbase %d: balance missing", i)
+		}
+	}
+}
+
+// blockHooks records the calls and the block outcomes reported to the hooks.
+type blockHooks struct {
+	vm.NoopHooks
+	calls     []vm.HookScope
+	committed []common.Hash
+	rejected  []common.Hash
+}
+
+func (h *blockHooks) CallEnter(scope vm.HookScope, typ vm.OpCode, from, to common.Address, input []byte, gas uint64, value *big.Int) {
+	h.calls = append(h.calls, scope)
+}
+
+func (h *blockHooks) BlockCommitted(block *types.Block) {
+	h.committed = append(h.committed, block.Hash())
+}
+
+func (h *blockHooks) BlockRejected(block *types.Block, err error) {
+	h.rejected = append(h.rejected, block.Hash())
+}
+
+// Tests that the hooks of the chain receive the EVM events of the blocks once
+// they are written, and nothing but the rejection of the blocks failing
+// validation.
+func TestBlockchainHooks(t *testing.T) {
+	var (
+		key, _  = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		address = crypto.PubkeyToAddress(key.PublicKey)
+		db      = ethdb.NewMemDatabase()
+		gspec   = &Genesis{Config: params.TestChainConfig, Alloc: GenesisAlloc{address: {Balance: big.NewInt(1000000)}}}
+		genesis = gspec.MustCommit(db)
+		engine  = ethash.NewFaker()
+		hooks   = new(blockHooks)
+	)
+	blocks, _ := GenerateChain(gspec.Config, genesis, engine, db, 2, func(i int, b *BlockGen) {
+		tx, _ := types.SignTx(types.NewTransaction(b.TxNonce(address), common.Address{0xaa}, big.NewInt(1), params.TxGas, big.NewInt(0), nil), types.HomesteadSigner{}, key)
+		b.AddTx(tx)
+	})
+	diskdb := ethdb.NewMemDatabase()
+	gspec.MustCommit(diskdb)
+
+	chain, err := NewBlockChain(diskdb, nil, gspec.Config, engine, vm.Config{Hooks: hooks}, nil)
+	if err != nil {
+		t.Fatalf("failed to create tester chain: %v", err)
+	}
+	defer chain.Stop()
+
+	if _, err := chain.InsertChain(blocks[:1]); err != nil {
+		t.Fatalf("failed to insert block: %v", err)
+	}
+	// A block with a bad state root fails after its transactions are executed
+	header := blocks[1].Header()
+	header.Root = common.Hash{0x01}
+	bad := types.NewBlockWithHeader(header).WithBody(blocks[1].Transactions(), nil)
+	if _, err := chain.InsertChain(types.Blocks{bad}); err == nil {
+		t.Fatalf("block with a bad state root inserted")
+	}
+	if _, err := chain.InsertChain(blocks[1:]); err != nil {
+		t.Fatalf("failed to insert block: %v", err)
+	}
+	if want := []common.Hash{blocks[0].Hash(), blocks[1].Hash()}; fmt.Sprint(hooks.committed) != fmt.Sprint(want) {
+		t.Errorf("committed blocks mismatch: have %x, want %x", hooks.committed, want)
+	}
+	if want := []common.Hash{bad.Hash()}; fmt.Sprint(hooks.rejected) != fmt.Sprint(want) {
+		t.Errorf("rejected blocks mismatch: have %x, want %x", hooks.rejected, want)
+	}
+	if len(hooks.calls) != len(blocks) {
+		t.Fatalf("call count mismatch: have %d, want %d", len(hooks.calls), len(blocks))
+	}
+	for i, scope := range hooks.calls {
+		tx := blocks[i].Transactions()[0]
+		if scope.BlockHash != blocks[i].Hash() || scope.TxHash != tx.Hash() || scope.TxIndex != 0 {
+			t.Errorf("call %d: scope mismatch: have %+v", i, scope)
+		}
+	}
+}
+
 // Benchmarks large blocks with value transfers to non-existing accounts
 func benchmarkLargeNumberOfValueToNonexisting(b *testing.B, numTxs, numBlocks int, recipientFn func(uint64) common.Address, dataFn func(uint64) []byte) {
 	var (
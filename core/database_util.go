@@ -81,6 +81,9 @@ var (
 	quorumEIP155ActivatedPrefix = []byte("quorum155active")
 )
 
+// PrivateSnapshotPrefix is the table of the flat snapshot of the private state.
+const PrivateSnapshotPrefix = "private-snapshot-"
+
 // txLookupEntry is a positional metadata to help looking up the data content of
 // a transaction or receipt given only its hash.
 type txLookupEntry struct {
@@ -23,6 +23,7 @@ import (
 
 	"github.com/ethereum/quorum/common"
 	"github.com/ethereum/quorum/core/types"
+	"github.com/ethereum/quorum/ethdb"
 	"github.com/ethereum/quorum/log"
 	"github.com/ethereum/quorum/rlp"
 )
@@ -31,7 +32,10 @@ import (
 func ReadCanonicalHash(db DatabaseReader, number uint64) common.Hash {
 	data, _ := db.Get(headerHashKey(number))
 	if len(data) == 0 {
-		return common.Hash{}
+		data = readAncient(db, freezerHashTable, number)
+		if len(data) == 0 {
+			return common.Hash{}
+		}
 	}
 	return common.BytesToHash(data)
 }
@@ -50,6 +54,23 @@ func DeleteCanonicalHash(db DatabaseDeleter, number uint64) {
 	}
 }
 
+// ReadAllHashes retrieves all the hashes assigned to blocks at a certain height,
+// both canonical and reorged forks included. Frozen blocks are not included.
+func ReadAllHashes(db ethdb.Iteratee, number uint64) []common.Hash {
+	prefix := append(append([]byte{}, headerPrefix...), encodeBlockNumber(number)...)
+
+	it := db.NewIteratorWithPrefix(prefix)
+	defer it.Release()
+
+	hashes := make([]common.Hash, 0, 1)
+	for it.Next() {
+		if key := it.Key(); len(key) == len(prefix)+common.HashLength {
+			hashes = append(hashes, common.BytesToHash(key[len(key)-common.HashLength:]))
+		}
+	}
+	return hashes
+}
+
 // ReadHeaderNumber returns the header number assigned to a hash.
 func ReadHeaderNumber(db DatabaseReader, hash common.Hash) *uint64 {
 	data, _ := db.Get(headerNumberKey(hash))
@@ -129,15 +150,18 @@ func WriteFastTrieProgress(db DatabaseWriter, count uint64) {
 // ReadHeaderRLP retrieves a block header in its raw RLP database encoding.
 func ReadHeaderRLP(db DatabaseReader, hash common.Hash, number uint64) rlp.RawValue {
 	data, _ := db.Get(headerKey(number, hash))
+	if len(data) == 0 {
+		data = readAncientOf(db, freezerHeaderTable, hash, number)
+	}
 	return data
 }
 
 // HasHeader verifies the existence of a block header corresponding to the hash.
 func HasHeader(db DatabaseReader, hash common.Hash, number uint64) bool {
-	if has, err := db.Has(headerKey(number, hash)); !has || err != nil {
-		return false
+	if has, err := db.Has(headerKey(number, hash)); has && err == nil {
+		return true
 	}
-	return true
+	return hasAncientOf(db, hash, number)
 }
 
 // ReadHeader retrieves the block header corresponding to the hash.
@@ -180,17 +204,26 @@ func WriteHeader(db DatabaseWriter, header *types.Header) {
 
 // DeleteHeader removes all block header data associated with a hash.
 func DeleteHeader(db DatabaseDeleter, hash common.Hash, number uint64) {
-	if err := db.Delete(headerKey(number, hash)); err != nil {
-		log.Crit("Failed to delete header", "err", err)
-	}
+	deleteHeaderWithoutNumber(db, hash, number)
 	if err := db.Delete(headerNumberKey(hash)); err != nil {
 		log.Crit("Failed to delete hash to number mapping", "err", err)
 	}
 }
 
+// deleteHeaderWithoutNumber removes only the block header but does not remove
+// the hash to number mapping.
+func deleteHeaderWithoutNumber(db DatabaseDeleter, hash common.Hash, number uint64) {
+	if err := db.Delete(headerKey(number, hash)); err != nil {
+		log.Crit("Failed to delete header", "err", err)
+	}
+}
+
 // ReadBodyRLP retrieves the block body (transactions and uncles) in RLP encoding.
 func ReadBodyRLP(db DatabaseReader, hash common.Hash, number uint64) rlp.RawValue {
 	data, _ := db.Get(blockBodyKey(number, hash))
+	if len(data) == 0 {
+		data = readAncientOf(db, freezerBodiesTable, hash, number)
+	}
 	return data
 }
 
@@ -203,10 +236,10 @@ func WriteBodyRLP(db DatabaseWriter, hash common.Hash, number uint64, rlp rlp.Ra
 
 // HasBody verifies the existence of a block body corresponding to the hash.
 func HasBody(db DatabaseReader, hash common.Hash, number uint64) bool {
-	if has, err := db.Has(blockBodyKey(number, hash)); !has || err != nil {
-		return false
+	if has, err := db.Has(blockBodyKey(number, hash)); has && err == nil {
+		return true
 	}
-	return true
+	return hasAncientOf(db, hash, number)
 }
 
 // ReadBody retrieves the block body corresponding to the hash.
@@ -243,7 +276,10 @@ func DeleteBody(db DatabaseDeleter, hash common.Hash, number uint64) {
 func ReadTd(db DatabaseReader, hash common.Hash, number uint64) *big.Int {
 	data, _ := db.Get(headerTDKey(number, hash))
 	if len(data) == 0 {
-		return nil
+		data = readAncientOf(db, freezerDifficultyTable, hash, number)
+		if len(data) == 0 {
+			return nil
+		}
 	}
 	td := new(big.Int)
 	if err := rlp.Decode(bytes.NewReader(data), td); err != nil {
@@ -276,7 +312,10 @@ func ReadReceipts(db DatabaseReader, hash common.Hash, number uint64) types.Rece
 	// Retrieve the flattened receipt slice
 	data, _ := db.Get(blockReceiptsKey(number, hash))
 	if len(data) == 0 {
-		return nil
+		data = readAncientOf(db, freezerReceiptTable, hash, number)
+		if len(data) == 0 {
+			return nil
+		}
 	}
 	// Convert the receipts from their storage form to their internal representation
 	storageReceipts := []*types.ReceiptForStorage{}
@@ -347,6 +386,40 @@ func DeleteBlock(db DatabaseDeleter, hash common.Hash, number uint64) {
 	DeleteTd(db, hash, number)
 }
 
+// DeleteBlockWithoutNumber removes all block data associated with a hash, except
+// the hash to number mapping.
+func DeleteBlockWithoutNumber(db DatabaseDeleter, hash common.Hash, number uint64) {
+	DeleteReceipts(db, hash, number)
+	deleteHeaderWithoutNumber(db, hash, number)
+	DeleteBody(db, hash, number)
+	DeleteTd(db, hash, number)
+}
+
+// readAncient retrieves the item of the kind of a frozen block from the ancient
+// store of the database, nil if it has none or the block isn't frozen.
+func readAncient(db DatabaseReader, kind string, number uint64) []byte {
+	ancients, ok := db.(AncientReader)
+	if !ok {
+		return nil
+	}
+	data, _ := ancients.Ancient(kind, number)
+	return data
+}
+
+// readAncientOf retrieves the item of the kind of a frozen block, provided it is
+// the block of the hash. Only the canonical blocks are frozen.
+func readAncientOf(db DatabaseReader, kind string, hash common.Hash, number uint64) []byte {
+	if !hasAncientOf(db, hash, number) {
+		return nil
+	}
+	return readAncient(db, kind, number)
+}
+
+// hasAncientOf reports whether the block of the hash is frozen.
+func hasAncientOf(db DatabaseReader, hash common.Hash, number uint64) bool {
+	return bytes.Equal(readAncient(db, freezerHashTable, number), hash.Bytes())
+}
+
 // FindCommonAncestor returns the last common ancestor of two block headers
 func FindCommonAncestor(db DatabaseReader, a, b *types.Header) *types.Header {
 	for bn := b.Number.Uint64(); a.Number.Uint64() > bn; {
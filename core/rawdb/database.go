@@ -0,0 +1,81 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rawdb
+
+import (
+	"github.com/ethereum/quorum/ethdb"
+	"github.com/ethereum/quorum/log"
+)
+
+// freezerdb is a database wrapper that enables freezer data retrievals.
+type freezerdb struct {
+	ethdb.Database
+	*freezer
+}
+
+// Close implements ethdb.Database, closing both the freezer and the key-value
+// store.
+func (db *freezerdb) Close() {
+	if err := db.freezer.Close(); err != nil {
+		log.Error("Failed to close ancient database", "err", err)
+	}
+	db.Database.Close()
+}
+
+// NewDatabaseWithFreezer wraps the key-value store with an ancient store in
+// the freezer directory, and starts moving the canonical blocks older than the
+// immutability threshold into it. The chain data accessors of the package read
+// the frozen blocks transparently through the returned database.
+func NewDatabaseWithFreezer(db ethdb.Database, freezer string) (ethdb.Database, error) {
+	frdb, err := newFreezer(freezer)
+	if err != nil {
+		return nil, err
+	}
+	frdb.wg.Add(1)
+	go frdb.freeze(db)
+
+	return &freezerdb{
+		Database: db,
+		freezer:  frdb,
+	}, nil
+}
+
+// NewDatabaseWithReadonlyFreezer wraps the key-value store with the ancient
+// store in the freezer directory for reading only. No blocks are moved into it
+// and its appends and truncations fail, which suits the databases a command
+// only reads from.
+func NewDatabaseWithReadonlyFreezer(db ethdb.Database, freezer string) (ethdb.Database, error) {
+	frdb, err := newFreezer(freezer)
+	if err != nil {
+		return nil, err
+	}
+	frdb.readonly = true
+
+	return &freezerdb{
+		Database: db,
+		freezer:  frdb,
+	}, nil
+}
+
+// KeyValueStore returns the key-value store of the database, unwrapping it from
+// its ancient store if there is one.
+func KeyValueStore(db ethdb.Database) ethdb.Database {
+	if frdb, ok := db.(*freezerdb); ok {
+		return frdb.Database
+	}
+	return db
+}
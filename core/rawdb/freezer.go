@@ -0,0 +1,365 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rawdb
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/ethdb"
+	"github.com/ethereum/quorum/log"
+	"github.com/ethereum/quorum/params"
+)
+
+var (
+	// errUnknownTable is returned if the user attempts to read from a table that is
+	// not tracked by the freezer.
+	errUnknownTable = errors.New("unknown table")
+
+	// errMissingChainData is returned if the chain data of a block to freeze is
+	// not in the key-value store.
+	errMissingChainData = errors.New("missing chain data")
+
+	// errReadOnly is returned if the user attempts to modify a freezer opened
+	// for reading only.
+	errReadOnly = errors.New("read only")
+)
+
+const (
+	// freezerRecheckInterval is the frequency to check the key-value database for
+	// chain progression that might permit new blocks to be frozen into immutable
+	// storage.
+	freezerRecheckInterval = time.Minute
+
+	// freezerBatchLimit is the maximum number of blocks to freeze in one batch
+	// before doing an fsync and deleting it from the key-value store.
+	freezerBatchLimit = 30000
+)
+
+// freezer is an append-only database to store immutable chain data into flat
+// files:
+//
+// - The append only nature ensures that disk writes are minimized.
+// - The data is snappy compressed, most of it being RLP with lots of zeros.
+// - Only the canonical blocks are frozen, the side chains are deleted.
+type freezer struct {
+	frozen    uint64 // Number of blocks already frozen (atomic access)
+	threshold uint64 // Number of recent blocks kept in the key-value store
+	readonly  bool   // Whether appends and truncations are rejected
+
+	tables map[string]*freezerTable // Data tables for storing everything
+	lock   sync.Mutex               // Lock serializing appends and truncations
+
+	quit      chan struct{}
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+}
+
+// newFreezer opens the tables of the ancient store in the directory, creating
+// them if they don't exist yet.
+func newFreezer(datadir string) (*freezer, error) {
+	f := &freezer{
+		threshold: params.ImmutabilityThreshold,
+		tables:    make(map[string]*freezerTable),
+		quit:      make(chan struct{}),
+	}
+	for _, name := range freezerTables {
+		table, err := newFreezerTable(datadir, name)
+		if err != nil {
+			for _, table := range f.tables {
+				table.Close()
+			}
+			return nil, err
+		}
+		f.tables[name] = table
+	}
+	if err := f.repair(); err != nil {
+		for _, table := range f.tables {
+			table.Close()
+		}
+		return nil, err
+	}
+	log.Info("Opened ancient database", "database", datadir, "blocks", f.frozen)
+	return f, nil
+}
+
+// repair truncates all the tables to the number of items of the shortest one,
+// dropping the blocks partially frozen by a crash.
+func (f *freezer) repair() error {
+	min := uint64(0)
+	for i, name := range freezerTables {
+		if items := f.tables[name].Items(); i == 0 || items < min {
+			min = items
+		}
+	}
+	for _, table := range f.tables {
+		if err := table.Truncate(min); err != nil {
+			return err
+		}
+	}
+	atomic.StoreUint64(&f.frozen, min)
+	return nil
+}
+
+// HasAncient returns an indicator whether the item of the kind is frozen.
+func (f *freezer) HasAncient(kind string, number uint64) (bool, error) {
+	if _, ok := f.tables[kind]; !ok {
+		return false, errUnknownTable
+	}
+	return number < atomic.LoadUint64(&f.frozen), nil
+}
+
+// Ancient retrieves the item of the kind.
+func (f *freezer) Ancient(kind string, number uint64) ([]byte, error) {
+	table, ok := f.tables[kind]
+	if !ok {
+		return nil, errUnknownTable
+	}
+	if number >= atomic.LoadUint64(&f.frozen) {
+		return nil, errOutOfBounds
+	}
+	return table.Retrieve(number)
+}
+
+// Ancients returns the number of frozen blocks.
+func (f *freezer) Ancients() (uint64, error) {
+	return atomic.LoadUint64(&f.frozen), nil
+}
+
+// AppendAncient freezes the encoded data of the next block. Either all of the
+// data is appended or none of it.
+func (f *freezer) AppendAncient(number uint64, hash, header, body, receipts, td []byte) error {
+	if f.readonly {
+		return errReadOnly
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if frozen := atomic.LoadUint64(&f.frozen); number != frozen {
+		return fmt.Errorf("%v: have %d, want %d", errOutOrder, number, frozen)
+	}
+	for _, item := range []struct {
+		name string
+		blob []byte
+	}{
+		{freezerHashTable, hash},
+		{freezerHeaderTable, header},
+		{freezerBodiesTable, body},
+		{freezerReceiptTable, receipts},
+		{freezerDifficultyTable, td},
+	} {
+		if err := f.tables[item.name].Append(number, item.blob); err != nil {
+			// Roll back the tables already appended to
+			for _, table := range f.tables {
+				if err := table.Truncate(number); err != nil {
+					log.Error("Failed to roll back ancient block", "number", number, "table", table.name, "err", err)
+				}
+			}
+			log.Error("Failed to append ancient block", "number", number, "table", item.name, "err", err)
+			return err
+		}
+	}
+	atomic.AddUint64(&f.frozen, 1)
+	return nil
+}
+
+// TruncateAncients discards the frozen blocks from the given number on.
+func (f *freezer) TruncateAncients(items uint64) error {
+	if f.readonly {
+		return errReadOnly
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if atomic.LoadUint64(&f.frozen) <= items {
+		return nil
+	}
+	// Hide the blocks first, then drop them from the tables
+	atomic.StoreUint64(&f.frozen, items)
+	for _, table := range f.tables {
+		if err := table.Truncate(items); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Sync flushes the frozen blocks to disk.
+func (f *freezer) Sync() error {
+	var errs []error
+	for _, table := range f.tables {
+		if err := table.Sync(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if errs != nil {
+		return fmt.Errorf("%v", errs)
+	}
+	return nil
+}
+
+// Close terminates the background freezer, if it runs, and closes the tables.
+func (f *freezer) Close() error {
+	var errs []error
+	f.closeOnce.Do(func() {
+		close(f.quit)
+		f.wg.Wait()
+
+		for _, table := range f.tables {
+			if err := table.Close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	})
+	if errs != nil {
+		return fmt.Errorf("%v", errs)
+	}
+	return nil
+}
+
+// freeze is the background loop moving the canonical blocks older than the
+// immutability threshold from the key-value store into the ancient store. It
+// runs until the freezer is closed.
+func (f *freezer) freeze(db ethdb.Database) {
+	defer f.wg.Done()
+
+	for {
+		select {
+		case <-f.quit:
+			return
+		default:
+		}
+		// Sleep unless a full batch was frozen, there may be more to freeze
+		if n, err := f.freezeBatch(db); err != nil || n < freezerBatchLimit {
+			if err != nil {
+				log.Error("Failed to freeze chain segment", "err", err)
+			}
+			select {
+			case <-time.After(freezerRecheckInterval):
+			case <-f.quit:
+				return
+			}
+		}
+	}
+}
+
+// freezeBatch moves the next batch of canonical blocks older than the
+// immutability threshold from the key-value store into the ancient store, and
+// deletes the side chain blocks of their numbers. It returns the number of
+// blocks frozen.
+func (f *freezer) freezeBatch(db ethdb.Database) (uint64, error) {
+	// Retrieve the freezing threshold, the key-value store is read directly
+	hash := ReadHeadBlockHash(db)
+	if hash == (common.Hash{}) {
+		return 0, nil
+	}
+	number := ReadHeaderNumber(db, hash)
+	if number == nil || *number < f.threshold {
+		return 0, nil
+	}
+	var (
+		first = atomic.LoadUint64(&f.frozen)
+		limit = *number - f.threshold
+	)
+	if limit < first {
+		return 0, nil
+	}
+	if limit-first >= freezerBatchLimit {
+		limit = first + freezerBatchLimit - 1
+	}
+	// Append the canonical blocks to the ancient store
+	var (
+		start    = time.Now()
+		ancients []common.Hash
+		err      error
+	)
+	for number := first; number <= limit; number++ {
+		hash := ReadCanonicalHash(db, number)
+		if hash == (common.Hash{}) {
+			err = fmt.Errorf("%v: canonical hash of block %d", errMissingChainData, number)
+			break
+		}
+		header := ReadHeaderRLP(db, hash, number)
+		body := ReadBodyRLP(db, hash, number)
+		receipts, _ := db.Get(blockReceiptsKey(number, hash))
+		td, _ := db.Get(headerTDKey(number, hash))
+		if len(header) == 0 || len(body) == 0 || len(receipts) == 0 || len(td) == 0 {
+			err = fmt.Errorf("%v: block %d [%x]", errMissingChainData, number, hash)
+			break
+		}
+		if err = f.AppendAncient(number, hash.Bytes(), header, body, receipts, td); err != nil {
+			break
+		}
+		ancients = append(ancients, hash)
+	}
+	if len(ancients) == 0 {
+		return 0, err
+	}
+	// Flush the ancient store before deleting the blocks from the key-value one
+	if err := f.Sync(); err != nil {
+		log.Crit("Failed to flush frozen tables", "err", err)
+	}
+	batch := db.NewBatch()
+	for i, hash := range ancients {
+		number := first + uint64(i)
+
+		DeleteCanonicalHash(batch, number)
+		DeleteBlockWithoutNumber(batch, hash, number)
+		if batch.ValueSize() >= ethdb.IdealBatchSize {
+			if err := batch.Write(); err != nil {
+				log.Crit("Failed to delete frozen canonical blocks", "err", err)
+			}
+			batch.Reset()
+		}
+	}
+	if err := batch.Write(); err != nil {
+		log.Crit("Failed to delete frozen canonical blocks", "err", err)
+	}
+	batch.Reset()
+
+	// The canonical headers are gone, all the remaining ones are side chains
+	dangling := 0
+	for i := range ancients {
+		number := first + uint64(i)
+		for _, hash := range ReadAllHashes(db, number) {
+			DeleteBlock(batch, hash, number)
+			dangling++
+		}
+		if batch.ValueSize() >= ethdb.IdealBatchSize {
+			if err := batch.Write(); err != nil {
+				log.Crit("Failed to delete frozen side chain blocks", "err", err)
+			}
+			batch.Reset()
+		}
+	}
+	if err := batch.Write(); err != nil {
+		log.Crit("Failed to delete frozen side chain blocks", "err", err)
+	}
+	last := first + uint64(len(ancients)) - 1
+	context := []interface{}{
+		"blocks", len(ancients), "elapsed", common.PrettyDuration(time.Since(start)), "number", last, "hash", ancients[len(ancients)-1],
+	}
+	if dangling > 0 {
+		context = append(context, []interface{}{"dangling", dangling}...)
+	}
+	log.Info("Deep froze chain segment", context...)
+	return uint64(len(ancients)), err
+}
@@ -0,0 +1,266 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rawdb
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/ethereum/quorum/log"
+	"github.com/golang/snappy"
+)
+
+// indexEntrySize is the size of an index entry, the end offset of an item in
+// the data file.
+const indexEntrySize = 8
+
+var (
+	// errOutOfBounds is returned if the item requested is not in the table.
+	errOutOfBounds = errors.New("out of bounds")
+
+	// errOutOrder is returned if the item appended is not the next one.
+	errOutOrder = errors.New("the append operation is out-order")
+
+	// errClosed is returned if an operation attempts to use a closed table.
+	errClosed = errors.New("closed")
+)
+
+// freezerTable is an append-only table of items numbered from zero, stored
+// snappy compressed in a data file, with an index file holding the end offset
+// of each item in the data file.
+type freezerTable struct {
+	name  string
+	index *os.File // File of the item end offsets, 8 bytes big endian each
+	data  *os.File // File of the compressed items
+	items uint64   // Number of items in the table
+	size  uint64   // Size of the data file
+
+	logger log.Logger
+	lock   sync.RWMutex
+}
+
+// newFreezerTable opens the table of the name in the directory, creating it if
+// it doesn't exist yet. Items partially written by a crash are discarded.
+func newFreezerTable(dir string, name string) (*freezerTable, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	index, err := os.OpenFile(filepath.Join(dir, name+".idx"), os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.OpenFile(filepath.Join(dir, name+".dat"), os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		index.Close()
+		return nil, err
+	}
+	t := &freezerTable{
+		name:   name,
+		index:  index,
+		data:   data,
+		logger: log.New("table", name),
+	}
+	if err := t.repair(); err != nil {
+		t.Close()
+		return nil, err
+	}
+	return t, nil
+}
+
+// repair truncates the index and data files to the last item fully written to
+// both of them.
+func (t *freezerTable) repair() error {
+	stat, err := t.index.Stat()
+	if err != nil {
+		return err
+	}
+	items := uint64(stat.Size()) / indexEntrySize
+	if stat, err = t.data.Stat(); err != nil {
+		return err
+	}
+	dataSize := uint64(stat.Size())
+
+	// The data is written before the index, drop the items missing data
+	end := uint64(0)
+	for ; items > 0; items-- {
+		if end, err = t.offset(items); err != nil {
+			return err
+		}
+		if end <= dataSize {
+			break
+		}
+	}
+	if items == 0 {
+		end = 0
+	}
+	if err := t.index.Truncate(int64(items * indexEntrySize)); err != nil {
+		return err
+	}
+	if err := t.data.Truncate(int64(end)); err != nil {
+		return err
+	}
+	if dataSize != end || uint64(stat.Size()) != items*indexEntrySize {
+		t.logger.Warn("Repaired ancient table", "items", items, "size", end)
+	}
+	t.items, t.size = items, end
+	return nil
+}
+
+// offset returns the end offset in the data file of the item before the given
+// one, zero for the first item.
+func (t *freezerTable) offset(item uint64) (uint64, error) {
+	if item == 0 {
+		return 0, nil
+	}
+	var buf [indexEntrySize]byte
+	if _, err := t.index.ReadAt(buf[:], int64((item-1)*indexEntrySize)); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(buf[:]), nil
+}
+
+// Items returns the number of items in the table.
+func (t *freezerTable) Items() uint64 {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	return t.items
+}
+
+// Size returns the size of the data of the table.
+func (t *freezerTable) Size() uint64 {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	return t.size
+}
+
+// Append compresses and appends the item, which must be the next one.
+func (t *freezerTable) Append(item uint64, blob []byte) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.index == nil {
+		return errClosed
+	}
+	if item != t.items {
+		return fmt.Errorf("%v: have %d, want %d", errOutOrder, item, t.items)
+	}
+	blob = snappy.Encode(nil, blob)
+	if _, err := t.data.WriteAt(blob, int64(t.size)); err != nil {
+		return err
+	}
+	var entry [indexEntrySize]byte
+	binary.BigEndian.PutUint64(entry[:], t.size+uint64(len(blob)))
+	if _, err := t.index.WriteAt(entry[:], int64(t.items*indexEntrySize)); err != nil {
+		return err
+	}
+	t.items++
+	t.size += uint64(len(blob))
+	return nil
+}
+
+// Retrieve returns the decompressed item.
+func (t *freezerTable) Retrieve(item uint64) ([]byte, error) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	if t.index == nil {
+		return nil, errClosed
+	}
+	if item >= t.items {
+		return nil, errOutOfBounds
+	}
+	start, err := t.offset(item)
+	if err != nil {
+		return nil, err
+	}
+	end, err := t.offset(item + 1)
+	if err != nil {
+		return nil, err
+	}
+	blob := make([]byte, end-start)
+	if _, err := t.data.ReadAt(blob, int64(start)); err != nil {
+		return nil, err
+	}
+	return snappy.Decode(nil, blob)
+}
+
+// Truncate discards the items from the given one on.
+func (t *freezerTable) Truncate(items uint64) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.index == nil {
+		return errClosed
+	}
+	if items >= t.items {
+		return nil
+	}
+	end, err := t.offset(items)
+	if err != nil {
+		return err
+	}
+	// Truncate the index first, an interrupted truncation is repaired on open
+	if err := t.index.Truncate(int64(items * indexEntrySize)); err != nil {
+		return err
+	}
+	if err := t.data.Truncate(int64(end)); err != nil {
+		return err
+	}
+	t.items, t.size = items, end
+	return nil
+}
+
+// Sync flushes the table files to disk.
+func (t *freezerTable) Sync() error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.index == nil {
+		return errClosed
+	}
+	if err := t.data.Sync(); err != nil {
+		return err
+	}
+	return t.index.Sync()
+}
+
+// Close closes the table files.
+func (t *freezerTable) Close() error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.index == nil {
+		return nil
+	}
+	var errs []error
+	for _, f := range []*os.File{t.index, t.data} {
+		if err := f.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	t.index, t.data = nil, nil
+	if len(errs) > 0 {
+		return fmt.Errorf("%v", errs)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rawdb
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// getChunk returns a chunk of data of the size filled with the byte.
+func getChunk(size int, b int) []byte {
+	return bytes.Repeat([]byte{byte(b)}, size)
+}
+
+// checkRetrieve checks the items in [from, to) of the table are the chunks
+// appended by the tests.
+func checkRetrieve(t *testing.T, table *freezerTable, from, to uint64) {
+	t.Helper()
+	for i := from; i < to; i++ {
+		blob, err := table.Retrieve(i)
+		if err != nil {
+			t.Fatalf("item %d: retrieve failed: %v", i, err)
+		}
+		if want := getChunk(15, int(i)); !bytes.Equal(blob, want) {
+			t.Fatalf("item %d: value mismatch: have %x, want %x", i, blob, want)
+		}
+	}
+}
+
+// Tests that items can be appended to a table and retrieved, also after it is
+// reopened.
+func TestFreezerTableBasics(t *testing.T) {
+	dir, err := ioutil.TempDir("", "freezer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	table, err := newFreezerTable(dir, "test")
+	if err != nil {
+		t.Fatalf("failed to open table: %v", err)
+	}
+	for i := 0; i < 255; i++ {
+		if err := table.Append(uint64(i), getChunk(15, i)); err != nil {
+			t.Fatalf("item %d: append failed: %v", i, err)
+		}
+	}
+	if err := table.Append(300, getChunk(15, 0)); err == nil {
+		t.Fatalf("out of order item appended")
+	}
+	if _, err := table.Retrieve(255); err != errOutOfBounds {
+		t.Fatalf("out of bounds retrieve error mismatch: have %v, want %v", err, errOutOfBounds)
+	}
+	checkRetrieve(t, table, 0, 255)
+	table.Close()
+
+	if table, err = newFreezerTable(dir, "test"); err != nil {
+		t.Fatalf("failed to reopen table: %v", err)
+	}
+	defer table.Close()
+
+	if items := table.Items(); items != 255 {
+		t.Fatalf("item count mismatch: have %d, want %d", items, 255)
+	}
+	checkRetrieve(t, table, 0, 255)
+}
+
+// Tests that the items partially written by a crash are dropped when the table
+// is reopened, and that truncated items can be appended again.
+func TestFreezerTableRepairTruncate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "freezer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	table, err := newFreezerTable(dir, "test")
+	if err != nil {
+		t.Fatalf("failed to open table: %v", err)
+	}
+	for i := 0; i < 10; i++ {
+		if err := table.Append(uint64(i), getChunk(15, i)); err != nil {
+			t.Fatalf("item %d: append failed: %v", i, err)
+		}
+	}
+	table.Close()
+
+	// Cut the data of the last item and leave half an index entry behind
+	data := filepath.Join(dir, "test.dat")
+	stat, err := os.Stat(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Truncate(data, stat.Size()-1); err != nil {
+		t.Fatal(err)
+	}
+	index, err := os.OpenFile(filepath.Join(dir, "test.idx"), os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	index.Write([]byte{0x01, 0x02, 0x03})
+	index.Close()
+
+	if table, err = newFreezerTable(dir, "test"); err != nil {
+		t.Fatalf("failed to reopen table: %v", err)
+	}
+	defer table.Close()
+
+	if items := table.Items(); items != 9 {
+		t.Fatalf("item count mismatch: have %d, want %d", items, 9)
+	}
+	checkRetrieve(t, table, 0, 9)
+
+	// Truncate a few items and append them again
+	if err := table.Truncate(5); err != nil {
+		t.Fatalf("failed to truncate table: %v", err)
+	}
+	if _, err := table.Retrieve(5); err != errOutOfBounds {
+		t.Fatalf("truncated item retrieve error mismatch: have %v, want %v", err, errOutOfBounds)
+	}
+	for i := 5; i < 10; i++ {
+		if err := table.Append(uint64(i), getChunk(15, i)); err != nil {
+			t.Fatalf("item %d: append failed: %v", i, err)
+		}
+	}
+	checkRetrieve(t, table, 0, 10)
+}
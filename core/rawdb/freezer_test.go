@@ -0,0 +1,189 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rawdb
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/core/types"
+	"github.com/ethereum/quorum/ethdb"
+)
+
+// writeTestChain writes a canonical chain of the length, and a side chain block
+// at height 2, to the database, returning the canonical blocks.
+func writeTestChain(db ethdb.Database, length int) ([]*types.Block, *types.Block) {
+	var (
+		blocks []*types.Block
+		parent common.Hash
+	)
+	for i := 0; i < length; i++ {
+		block := types.NewBlockWithHeader(&types.Header{
+			Number:     big.NewInt(int64(i)),
+			ParentHash: parent,
+			Extra:      []byte("test block"),
+		})
+		WriteBlock(db, block)
+		WriteCanonicalHash(db, block.Hash(), block.NumberU64())
+		WriteTd(db, block.Hash(), block.NumberU64(), big.NewInt(int64(i+1)))
+		WriteReceipts(db, block.Hash(), block.NumberU64(), types.Receipts{{CumulativeGasUsed: uint64(i), Logs: []*types.Log{}}})
+
+		blocks = append(blocks, block)
+		parent = block.Hash()
+	}
+	WriteHeadBlockHash(db, parent)
+
+	side := types.NewBlockWithHeader(&types.Header{
+		Number:     big.NewInt(2),
+		ParentHash: blocks[1].Hash(),
+		Extra:      []byte("side block"),
+	})
+	WriteBlock(db, side)
+	WriteTd(db, side.Hash(), 2, big.NewInt(3))
+
+	return blocks, side
+}
+
+// Tests that the canonical blocks older than the threshold are moved to the
+// ancient store and read through the database, and that the side chain blocks
+// of their heights are deleted.
+func TestFreezeChain(t *testing.T) {
+	dir, err := ioutil.TempDir("", "freezer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	kvdb := ethdb.NewMemDatabase()
+	blocks, side := writeTestChain(kvdb, 10)
+
+	frdb, err := newFreezer(dir)
+	if err != nil {
+		t.Fatalf("failed to open freezer: %v", err)
+	}
+	frdb.threshold = 3
+	db := &freezerdb{Database: kvdb, freezer: frdb}
+
+	if n, err := frdb.freezeBatch(kvdb); err != nil || n != 7 {
+		t.Fatalf("freeze mismatch: have %d blocks, %v, want %d blocks", n, err, 7)
+	}
+	if n, err := frdb.freezeBatch(kvdb); err != nil || n != 0 {
+		t.Fatalf("refreeze mismatch: have %d blocks, %v, want none", n, err)
+	}
+	for _, block := range blocks {
+		hash, number := block.Hash(), block.NumberU64()
+
+		if has, _ := kvdb.Has(headerKey(number, hash)); has != (number >= 7) {
+			t.Errorf("block %d: header in key-value store: have %v, want %v", number, has, number >= 7)
+		}
+		if have := ReadCanonicalHash(db, number); have != hash {
+			t.Errorf("block %d: canonical hash mismatch: have %x, want %x", number, have, hash)
+		}
+		if have := ReadHeaderNumber(db, hash); have == nil || *have != number {
+			t.Errorf("block %d: number mismatch: have %v, want %d", number, have, number)
+		}
+		if have := ReadBlock(db, hash, number); have == nil || have.Hash() != hash {
+			t.Errorf("block %d: block mismatch: have %v", number, have)
+		}
+		if !HasHeader(db, hash, number) || !HasBody(db, hash, number) {
+			t.Errorf("block %d: header or body missing", number)
+		}
+		if td := ReadTd(db, hash, number); td == nil || td.Uint64() != number+1 {
+			t.Errorf("block %d: td mismatch: have %v, want %d", number, td, number+1)
+		}
+		if receipts := ReadReceipts(db, hash, number); len(receipts) != 1 || receipts[0].CumulativeGasUsed != number {
+			t.Errorf("block %d: receipts mismatch: have %v", number, receipts)
+		}
+	}
+	// The frozen blocks are only readable by their canonical hash
+	if HasHeader(db, side.Hash(), 2) || ReadTd(db, side.Hash(), 2) != nil || ReadHeaderNumber(db, side.Hash()) != nil {
+		t.Errorf("side chain block not deleted")
+	}
+	if HasHeader(db, blocks[3].Hash(), 2) {
+		t.Errorf("frozen header found at the wrong height")
+	}
+	// Rewind the ancient store and reopen it
+	if err := db.TruncateAncients(4); err != nil {
+		t.Fatalf("failed to truncate ancients: %v", err)
+	}
+	if hash := ReadCanonicalHash(db, 5); hash != (common.Hash{}) {
+		t.Errorf("truncated canonical hash found: %x", hash)
+	}
+	frdb.Close()
+
+	if frdb, err = newFreezer(dir); err != nil {
+		t.Fatalf("failed to reopen freezer: %v", err)
+	}
+	defer frdb.Close()
+
+	if frozen, _ := frdb.Ancients(); frozen != 4 {
+		t.Fatalf("frozen block count mismatch: have %d, want %d", frozen, 4)
+	}
+	db = &freezerdb{Database: kvdb, freezer: frdb}
+	if header := ReadHeader(db, blocks[3].Hash(), 3); header == nil || header.Hash() != blocks[3].Hash() {
+		t.Errorf("frozen header mismatch after reopen: have %v", header)
+	}
+}
+
+// Tests that the ancient store opened for reading only serves the frozen blocks
+// but neither freezes more of them nor changes them.
+func TestReadonlyFreezer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "freezer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	kvdb := ethdb.NewMemDatabase()
+	blocks, _ := writeTestChain(kvdb, 10)
+
+	frdb, err := newFreezer(dir)
+	if err != nil {
+		t.Fatalf("failed to open freezer: %v", err)
+	}
+	frdb.threshold = 7
+	if n, err := frdb.freezeBatch(kvdb); err != nil || n != 3 {
+		t.Fatalf("freeze mismatch: have %d blocks, %v, want %d blocks", n, err, 3)
+	}
+	frdb.Close()
+
+	rodb, err := NewDatabaseWithReadonlyFreezer(kvdb, dir)
+	if err != nil {
+		t.Fatalf("failed to open read only freezer: %v", err)
+	}
+	defer rodb.Close()
+	db := rodb.(*freezerdb)
+
+	if frozen, _ := db.Ancients(); frozen != 3 {
+		t.Fatalf("frozen block count mismatch: have %d, want %d", frozen, 3)
+	}
+	if header := ReadHeader(db, blocks[1].Hash(), 1); header == nil || header.Hash() != blocks[1].Hash() {
+		t.Errorf("frozen header mismatch: have %v", header)
+	}
+	if err := db.TruncateAncients(1); err != errReadOnly {
+		t.Errorf("truncation mismatch: have %v, want %v", err, errReadOnly)
+	}
+	if err := db.AppendAncient(3, nil, nil, nil, nil, nil); err != errReadOnly {
+		t.Errorf("append mismatch: have %v, want %v", err, errReadOnly)
+	}
+	if frozen, _ := db.Ancients(); frozen != 3 {
+		t.Fatalf("frozen block count changed: have %d, want %d", frozen, 3)
+	}
+}
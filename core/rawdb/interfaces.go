@@ -31,3 +31,34 @@ type DatabaseWriter interface {
 type DatabaseDeleter interface {
 	Delete(key []byte) error
 }
+
+// AncientReader wraps the read methods of an ancient store, holding the frozen
+// chain data of the canonical blocks numbered from zero.
+type AncientReader interface {
+	// HasAncient returns an indicator whether the item of the kind is frozen.
+	HasAncient(kind string, number uint64) (bool, error)
+
+	// Ancient retrieves the item of the kind.
+	Ancient(kind string, number uint64) ([]byte, error)
+
+	// Ancients returns the number of frozen blocks.
+	Ancients() (uint64, error)
+}
+
+// AncientWriter wraps the write methods of an ancient store.
+type AncientWriter interface {
+	// AppendAncient freezes the encoded data of the next block.
+	AppendAncient(number uint64, hash, header, body, receipts, td []byte) error
+
+	// TruncateAncients discards the frozen blocks from the given number on.
+	TruncateAncients(items uint64) error
+
+	// Sync flushes the frozen blocks to disk.
+	Sync() error
+}
+
+// AncientStore wraps the read and write methods of an ancient store.
+type AncientStore interface {
+	AncientReader
+	AncientWriter
+}
@@ -21,6 +21,7 @@ import (
 	"sync"
 
 	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/core/state/snapshot"
 	"github.com/ethereum/quorum/ethdb"
 	"github.com/ethereum/quorum/trie"
 	lru "github.com/hashicorp/golang-lru"
@@ -57,6 +58,10 @@ type Database interface {
 
 	// TrieDB retrieves the low level trie database used for data storage.
 	TrieDB() *trie.Database
+
+	// Snapshots retrieves the flat state snapshots read before the tries, nil
+	// if there are none.
+	Snapshots() *snapshot.Tree
 }
 
 // Trie is a Ethereum Merkle Trie.
@@ -83,8 +88,18 @@ func NewDatabase(db ethdb.Database) Database {
 	}
 }
 
+// NewDatabaseWithSnapshots creates a backing store for state like NewDatabase,
+// with the states read through flat snapshots stored in snapdb before the tries.
+// The snapshots must be loaded before they are used.
+func NewDatabaseWithSnapshots(db ethdb.Database, snapdb ethdb.Database) Database {
+	sdb := NewDatabase(db).(*cachingDB)
+	sdb.snaps = snapshot.New(snapdb, sdb.db)
+	return sdb
+}
+
 type cachingDB struct {
 	db            *trie.Database
+	snaps         *snapshot.Tree
 	mu            sync.Mutex
 	pastTries     []*trie.SecureTrie
 	codeSizeCache *lru.Cache
@@ -159,6 +174,11 @@ func (db *cachingDB) TrieDB() *trie.Database {
 	return db.db
 }
 
+// Snapshots retrieves the flat state snapshots read before the tries.
+func (db *cachingDB) Snapshots() *snapshot.Tree {
+	return db.snaps
+}
+
 // cachedTrie inserts its trie into a cachingDB on commit.
 type cachedTrie struct {
 	*trie.SecureTrie
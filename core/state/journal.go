@@ -90,7 +90,9 @@ type (
 		account *common.Address
 	}
 	resetObjectChange struct {
-		prev *stateObject
+		prev         *stateObject
+		prevdestruct bool                   // whether the snapshot storage was wiped already
+		prevstorage  map[common.Hash][]byte // snapshot storage changes of prev
 	}
 	suicideChange struct {
 		account     *common.Address
@@ -144,6 +146,14 @@ func (ch createObjectChange) dirtied() *common.Address {
 
 func (ch resetObjectChange) revert(s *StateDB) {
 	s.setStateObject(ch.prev)
+	if s.snap != nil {
+		if !ch.prevdestruct {
+			delete(s.snapDestructs, ch.prev.addrHash)
+		}
+		if ch.prevstorage != nil {
+			s.snapStorage[ch.prev.addrHash] = ch.prevstorage
+		}
+	}
 }
 
 func (ch resetObjectChange) dirtied() *common.Address {
@@ -0,0 +1,62 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pruner
+
+import (
+	"encoding/binary"
+
+	"github.com/ethereum/quorum/common"
+)
+
+// stateBloomHashes is the number of bits set per entry in the state bloom.
+const stateBloomHashes = 4
+
+// stateBloom is a bloom filter of the hashes of the reachable trie nodes and
+// contract codes. A false positive only keeps an unreachable entry on disk.
+//
+// The keys are Keccak256 hashes, uniformly distributed already, so the bit
+// indexes are taken from the key itself instead of hashing it again.
+type stateBloom struct {
+	bits []uint64
+}
+
+// newStateBloom creates a state bloom of the size in bytes.
+func newStateBloom(size uint64) *stateBloom {
+	if size < 8 {
+		size = 8
+	}
+	return &stateBloom{bits: make([]uint64, size/8)}
+}
+
+// add marks the hash as reachable.
+func (b *stateBloom) add(hash common.Hash) {
+	for i := 0; i < stateBloomHashes; i++ {
+		bit := binary.BigEndian.Uint64(hash[i*8:]) % uint64(len(b.bits)*64)
+		b.bits[bit/64] |= 1 << (bit % 64)
+	}
+}
+
+// contains reports whether the hash may have been marked as reachable.
+func (b *stateBloom) contains(hash []byte) bool {
+	for i := 0; i < stateBloomHashes; i++ {
+		bit := binary.BigEndian.Uint64(hash[i*8:]) % uint64(len(b.bits)*64)
+		if b.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,290 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package pruner deletes the old public and private state of a chain database.
+package pruner
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/core"
+	"github.com/ethereum/quorum/core/rawdb"
+	"github.com/ethereum/quorum/core/state"
+	"github.com/ethereum/quorum/crypto"
+	"github.com/ethereum/quorum/ethdb"
+	"github.com/ethereum/quorum/log"
+	"github.com/ethereum/quorum/rlp"
+	"github.com/ethereum/quorum/trie"
+)
+
+// logInterval is the interval between the progress logs.
+const logInterval = 8 * time.Second
+
+var (
+	// emptyRoot is the known root hash of an empty trie.
+	emptyRoot = common.HexToHash("56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421")
+
+	// emptyCode is the known hash of the empty EVM bytecode.
+	emptyCode = crypto.Keccak256Hash(nil)
+)
+
+// Pruner deletes the public and private state of all but the most recent
+// blocks from a chain database which no node is using.
+//
+// The trie nodes and contract codes reachable from the kept public roots and
+// from the private roots they map to are marked in a bloom filter first, then
+// every unmarked one is deleted. False positives of the filter keep a few
+// unreachable entries, never delete reachable ones.
+type Pruner struct {
+	db     ethdb.Database
+	triedb *trie.Database
+	bloom  *stateBloom
+
+	marked  uint64    // Number of entries marked as reachable
+	lastLog time.Time // Time of the last progress log
+}
+
+// NewPruner creates a pruner of the chain database, with a bloom filter of the
+// reachable state of bloomSize bytes.
+func NewPruner(db ethdb.Database, bloomSize uint64) *Pruner {
+	return &Pruner{
+		db:     db,
+		triedb: trie.NewDatabase(db),
+		bloom:  newStateBloom(bloomSize),
+	}
+}
+
+// Prune keeps the public and private state of the last keep blocks of the
+// canonical chain and of the genesis block, and deletes all the other state.
+// Nothing is deleted if any kept state can't be marked.
+func (p *Pruner) Prune(keep uint64) error {
+	if keep == 0 {
+		return errors.New("the state of at least one block must be kept")
+	}
+	headHash := rawdb.ReadHeadBlockHash(p.db)
+	if headHash == (common.Hash{}) {
+		return errors.New("head block not found")
+	}
+	head := rawdb.ReadHeaderNumber(p.db, headHash)
+	if head == nil {
+		return fmt.Errorf("head block %x not found", headHash)
+	}
+	first := uint64(0)
+	if *head+1 > keep {
+		first = *head + 1 - keep
+	}
+	// Mark the kept states, newest first. A state is only walked where it
+	// differs from the newer one, whose nodes are all marked already.
+	start := time.Now()
+	var public, private common.Hash // Roots of the last marked states
+	for number := *head; ; number-- {
+		if err := p.markBlock(number, number == *head, &public, &private); err != nil {
+			return err
+		}
+		if number == first {
+			break
+		}
+	}
+	if first > 0 {
+		// Keep the genesis state, to be able to rewind the chain to it
+		if err := p.markBlock(0, false, &public, &private); err != nil {
+			return err
+		}
+	}
+	log.Info("Marked the reachable state", "blocks", *head+1-first, "entries", p.marked, "elapsed", common.PrettyDuration(time.Since(start)))
+
+	if err := p.sweep(); err != nil {
+		return err
+	}
+	// Compact the database in ranges, to report the progress of long compactions
+	start = time.Now()
+	for b := 0x00; b < 0x100; b += 0x10 {
+		var (
+			rangeStart = []byte{byte(b)}
+			rangeLimit = []byte{byte(b + 0x10)}
+		)
+		if b+0x10 == 0x100 {
+			rangeLimit = nil
+		}
+		log.Info("Compacting the database", "range", fmt.Sprintf("%#x-%#x", rangeStart, rangeLimit), "elapsed", common.PrettyDuration(time.Since(start)))
+		if err := p.db.Compact(rangeStart, rangeLimit); err != nil {
+			return err
+		}
+	}
+	log.Info("Compacted the database", "elapsed", common.PrettyDuration(time.Since(start)))
+	return nil
+}
+
+// markBlock marks the public and the private state of the canonical block,
+// diffing them against the newer marked states. The state of required blocks
+// must be present, the state of the others is skipped if it's missing.
+func (p *Pruner) markBlock(number uint64, required bool, public, private *common.Hash) error {
+	hash := rawdb.ReadCanonicalHash(p.db, number)
+	header := rawdb.ReadHeader(p.db, hash, number)
+	if header == nil {
+		return fmt.Errorf("header #%d [%x] not found", number, hash)
+	}
+	if err := p.markState(public, header.Root, required); err != nil {
+		return fmt.Errorf("public state of block #%d: %v", number, err)
+	}
+	if err := p.markState(private, core.GetPrivateStateRoot(p.db, header.Root), required); err != nil {
+		return fmt.Errorf("private state of block #%d: %v", number, err)
+	}
+	return nil
+}
+
+// markState marks the state of the root, diffing it against the newer marked
+// state, which the root replaces once marked.
+func (p *Pruner) markState(newer *common.Hash, root common.Hash, required bool) error {
+	if root == (common.Hash{}) || root == emptyRoot || root == *newer {
+		return nil
+	}
+	if has, _ := p.db.Has(root[:]); !has {
+		if required {
+			return fmt.Errorf("state %x not found", root)
+		}
+		log.Warn("Skipping missing state", "root", root)
+		return nil
+	}
+	if err := p.markTrie(*newer, root, true); err != nil {
+		return err
+	}
+	*newer = root
+	return nil
+}
+
+// markTrie marks the nodes of the trie of the root missing from the marked trie
+// of the newer root, and for account tries the storage tries and the codes of
+// the accounts.
+func (p *Pruner) markTrie(newer common.Hash, root common.Hash, accounts bool) error {
+	t, err := trie.New(root, p.triedb)
+	if err != nil {
+		return err
+	}
+	it := t.NodeIterator(nil)
+
+	var newerTrie *trie.Trie
+	if newer != (common.Hash{}) && newer != emptyRoot {
+		if newerTrie, err = trie.New(newer, p.triedb); err != nil {
+			return err
+		}
+		it, _ = trie.NewDifferenceIterator(newerTrie.NodeIterator(nil), it)
+	}
+	for it.Next(true) {
+		if hash := it.Hash(); hash != (common.Hash{}) {
+			p.mark(hash)
+		}
+		if !accounts || !it.Leaf() {
+			continue
+		}
+		var account state.Account
+		if err := rlp.DecodeBytes(it.LeafBlob(), &account); err != nil {
+			return err
+		}
+		if codeHash := common.BytesToHash(account.CodeHash); codeHash != emptyCode {
+			p.mark(codeHash)
+		}
+		if account.Root == emptyRoot {
+			continue
+		}
+		// The storage the account had in the newer state is marked already
+		var newerStorage common.Hash
+		if newerTrie != nil {
+			blob, err := newerTrie.TryGet(it.LeafKey())
+			if err != nil {
+				return err
+			}
+			if blob != nil {
+				var newerAccount state.Account
+				if err := rlp.DecodeBytes(blob, &newerAccount); err != nil {
+					return err
+				}
+				newerStorage = newerAccount.Root
+			}
+		}
+		if account.Root != newerStorage {
+			if err := p.markTrie(newerStorage, account.Root, false); err != nil {
+				return err
+			}
+		}
+	}
+	return it.Error()
+}
+
+// mark marks the trie node or contract code of the hash as reachable.
+func (p *Pruner) mark(hash common.Hash) {
+	p.bloom.add(hash)
+	p.marked++
+
+	if time.Since(p.lastLog) > logInterval {
+		log.Info("Marking the reachable state", "entries", p.marked)
+		p.lastLog = time.Now()
+	}
+}
+
+// sweep deletes the trie nodes and contract codes which weren't marked. They
+// are the entries stored under the hash of their value.
+func (p *Pruner) sweep() error {
+	var (
+		start         = time.Now()
+		it            = p.db.NewIterator()
+		batch         = p.db.NewBatch()
+		deleted, kept uint64
+		size          common.StorageSize
+	)
+	defer it.Release()
+
+	for it.Next() {
+		key := it.Key()
+		if len(key) != common.HashLength {
+			continue
+		}
+		if p.bloom.contains(key) {
+			kept++
+			continue
+		}
+		if crypto.Keccak256Hash(it.Value()) != common.BytesToHash(key) {
+			continue
+		}
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+		deleted++
+		size += common.StorageSize(len(key) + len(it.Value()))
+
+		if batch.ValueSize() >= ethdb.IdealBatchSize {
+			if err := batch.Write(); err != nil {
+				return err
+			}
+			batch.Reset()
+		}
+		if time.Since(p.lastLog) > logInterval {
+			log.Info("Deleting the unreachable state", "deleted", deleted, "size", size, "elapsed", common.PrettyDuration(time.Since(start)))
+			p.lastLog = time.Now()
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	log.Info("Deleted the unreachable state", "deleted", deleted, "kept", kept, "size", size, "elapsed", common.PrettyDuration(time.Since(start)))
+	return nil
+}
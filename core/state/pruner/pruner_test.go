@@ -0,0 +1,187 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pruner
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/core"
+	"github.com/ethereum/quorum/core/rawdb"
+	"github.com/ethereum/quorum/core/state"
+	"github.com/ethereum/quorum/core/types"
+	"github.com/ethereum/quorum/ethdb"
+	"github.com/ethereum/quorum/rlp"
+	"github.com/ethereum/quorum/trie"
+)
+
+var (
+	testAccount  = common.HexToAddress("0x01")
+	testContract = common.HexToAddress("0x02")
+)
+
+// testChain writes the headers of a canonical chain of the blocks, each with a
+// public and a private state changed by the block, and returns the public and
+// private roots of the blocks.
+func testChain(t *testing.T, db ethdb.Database, blocks int) (public, private []common.Hash) {
+	var (
+		statedb    = state.NewDatabase(db)
+		publicRoot common.Hash
+		privRoot   common.Hash
+		parent     common.Hash
+	)
+	for i := 0; i < blocks; i++ {
+		pub, _ := state.New(publicRoot, statedb)
+		pub.AddBalance(testAccount, big.NewInt(1))
+		pub.SetCode(testContract, []byte{0x60, 0x00, byte(i % 3)})
+		pub.SetState(testContract, common.BigToHash(big.NewInt(int64(i))), common.BigToHash(big.NewInt(int64(i+1))))
+
+		priv, _ := state.New(privRoot, statedb)
+		priv.SetCode(testContract, []byte{0x60, 0x01})
+		priv.SetState(testContract, common.Hash{}, common.BigToHash(big.NewInt(int64(i+1))))
+
+		var err error
+		if publicRoot, err = pub.Commit(false); err != nil {
+			t.Fatalf("block %d: failed to commit public state: %v", i, err)
+		}
+		if privRoot, err = priv.Commit(false); err != nil {
+			t.Fatalf("block %d: failed to commit private state: %v", i, err)
+		}
+		if err := statedb.TrieDB().Commit(publicRoot, false); err != nil {
+			t.Fatalf("block %d: failed to flush public state: %v", i, err)
+		}
+		if err := statedb.TrieDB().Commit(privRoot, false); err != nil {
+			t.Fatalf("block %d: failed to flush private state: %v", i, err)
+		}
+		if err := core.WritePrivateStateRoot(db, publicRoot, privRoot); err != nil {
+			t.Fatalf("block %d: failed to write private state root: %v", i, err)
+		}
+		header := &types.Header{
+			ParentHash: parent,
+			Number:     big.NewInt(int64(i)),
+			Root:       publicRoot,
+			Difficulty: big.NewInt(1),
+		}
+		rawdb.WriteHeader(db, header)
+		rawdb.WriteCanonicalHash(db, header.Hash(), header.Number.Uint64())
+		rawdb.WriteHeadBlockHash(db, header.Hash())
+		parent = header.Hash()
+
+		public = append(public, publicRoot)
+		private = append(private, privRoot)
+	}
+	return public, private
+}
+
+// checkState walks the whole state of the root, including the storage tries and
+// the contract codes.
+func checkState(db ethdb.Database, root common.Hash) error {
+	triedb := trie.NewDatabase(db)
+	t, err := trie.New(root, triedb)
+	if err != nil {
+		return err
+	}
+	it := t.NodeIterator(nil)
+	for it.Next(true) {
+		if !it.Leaf() {
+			continue
+		}
+		var account state.Account
+		if err := rlp.DecodeBytes(it.LeafBlob(), &account); err != nil {
+			return err
+		}
+		if codeHash := common.BytesToHash(account.CodeHash); codeHash != emptyCode {
+			if _, err := db.Get(codeHash[:]); err != nil {
+				return fmt.Errorf("code %x: %v", codeHash, err)
+			}
+		}
+		storage, err := trie.New(account.Root, triedb)
+		if err != nil {
+			return err
+		}
+		sit := storage.NodeIterator(nil)
+		for sit.Next(true) {
+		}
+		if err := sit.Error(); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+func TestPrune(t *testing.T) {
+	db := ethdb.NewMemDatabase()
+	public, private := testChain(t, db, 10)
+
+	if err := NewPruner(db, 1024*1024).Prune(3); err != nil {
+		t.Fatalf("failed to prune: %v", err)
+	}
+	for i := range public {
+		kept := i == 0 || i >= 7
+		for _, root := range []common.Hash{public[i], private[i]} {
+			err := checkState(db, root)
+			if kept && err != nil {
+				t.Errorf("block %d: state %x not kept: %v", i, root, err)
+			}
+			if has, _ := db.Has(root[:]); !kept && has {
+				t.Errorf("block %d: state %x not pruned", i, root)
+			}
+		}
+		if core.GetPrivateStateRoot(db, public[i]) != private[i] {
+			t.Errorf("block %d: private state root mapping deleted", i)
+		}
+	}
+	if head := rawdb.ReadHeadBlockHash(db); rawdb.ReadHeaderNumber(db, head) == nil {
+		t.Errorf("head header deleted")
+	}
+}
+
+func TestPruneKeepAll(t *testing.T) {
+	db := ethdb.NewMemDatabase()
+	public, private := testChain(t, db, 4)
+	before := len(db.Keys())
+
+	if err := NewPruner(db, 1024*1024).Prune(10); err != nil {
+		t.Fatalf("failed to prune: %v", err)
+	}
+	if after := len(db.Keys()); after != before {
+		t.Errorf("entries mismatch: have %d, want %d", after, before)
+	}
+	for i := range public {
+		for _, root := range []common.Hash{public[i], private[i]} {
+			if err := checkState(db, root); err != nil {
+				t.Errorf("block %d: state %x not kept: %v", i, root, err)
+			}
+		}
+	}
+}
+
+func TestPruneMissingHeadState(t *testing.T) {
+	db := ethdb.NewMemDatabase()
+	public, _ := testChain(t, db, 4)
+	db.Delete(public[3][:])
+	before := len(db.Keys())
+
+	if err := NewPruner(db, 1024*1024).Prune(2); err == nil {
+		t.Fatalf("pruning succeeded without the head state")
+	}
+	if after := len(db.Keys()); after != before {
+		t.Errorf("entries deleted: have %d, want %d", after, before)
+	}
+}
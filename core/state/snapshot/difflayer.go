@@ -0,0 +1,120 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package snapshot
+
+import (
+	"sync"
+
+	"github.com/ethereum/quorum/common"
+)
+
+// diffLayer is the snapshot of a state held in memory, as the changes of the
+// accounts and storage over the snapshot of its parent state.
+type diffLayer struct {
+	root  common.Hash
+	stale bool // Whether the layer was merged into the disk layer
+
+	destructs map[common.Hash]struct{}               // Accounts whose storage was wiped
+	accounts  map[common.Hash][]byte                 // Changed accounts
+	storage   map[common.Hash]map[common.Hash][]byte // Changed storage slots, nil if deleted
+
+	parent snapshot // Parent layer, replaced by the disk layer it is merged into
+	lock   sync.RWMutex
+}
+
+// newDiffLayer creates a diff layer over the parent layer.
+func newDiffLayer(parent snapshot, root common.Hash, destructs map[common.Hash]struct{}, accounts map[common.Hash][]byte, storage map[common.Hash]map[common.Hash][]byte) *diffLayer {
+	return &diffLayer{
+		root:      root,
+		destructs: destructs,
+		accounts:  accounts,
+		storage:   storage,
+		parent:    parent,
+	}
+}
+
+// Root returns the root of the state of the snapshot.
+func (dl *diffLayer) Root() common.Hash {
+	return dl.root
+}
+
+// Account returns the account trie value of the account hash.
+func (dl *diffLayer) Account(hash common.Hash) ([]byte, error) {
+	dl.lock.RLock()
+	if dl.stale {
+		dl.lock.RUnlock()
+		return nil, ErrSnapshotStale
+	}
+	if data, ok := dl.accounts[hash]; ok {
+		dl.lock.RUnlock()
+		return data, nil
+	}
+	if _, ok := dl.destructs[hash]; ok {
+		dl.lock.RUnlock()
+		return nil, nil
+	}
+	parent := dl.parent
+	dl.lock.RUnlock()
+
+	return parent.Account(hash)
+}
+
+// Storage returns the storage trie value of the storage hash of the account
+// hash.
+func (dl *diffLayer) Storage(accountHash, storageHash common.Hash) ([]byte, error) {
+	dl.lock.RLock()
+	if dl.stale {
+		dl.lock.RUnlock()
+		return nil, ErrSnapshotStale
+	}
+	if data, ok := dl.storage[accountHash][storageHash]; ok {
+		dl.lock.RUnlock()
+		return data, nil
+	}
+	if _, ok := dl.destructs[accountHash]; ok {
+		dl.lock.RUnlock()
+		return nil, nil
+	}
+	parent := dl.parent
+	dl.lock.RUnlock()
+
+	return parent.Storage(accountHash, storageHash)
+}
+
+// parentLayer returns the layer the snapshot is a diff of.
+func (dl *diffLayer) parentLayer() snapshot {
+	dl.lock.RLock()
+	defer dl.lock.RUnlock()
+
+	return dl.parent
+}
+
+// setParent replaces the parent layer by the disk layer it was merged into.
+func (dl *diffLayer) setParent(parent snapshot) {
+	dl.lock.Lock()
+	defer dl.lock.Unlock()
+
+	dl.parent = parent
+}
+
+// markStale marks the layer as merged into the disk layer.
+func (dl *diffLayer) markStale() {
+	dl.lock.Lock()
+	defer dl.lock.Unlock()
+
+	dl.stale = true
+}
@@ -0,0 +1,145 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package snapshot
+
+import (
+	"sync"
+
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/core/rawdb"
+	"github.com/ethereum/quorum/ethdb"
+)
+
+// diskLayer is the snapshot of the oldest state of a tree, stored in the
+// database.
+type diskLayer struct {
+	diskdb ethdb.Database
+	root   common.Hash
+	stale  bool // Whether a newer state was merged into the database
+
+	lock sync.RWMutex
+}
+
+// Root returns the root of the state of the snapshot.
+func (dl *diskLayer) Root() common.Hash {
+	return dl.root
+}
+
+// Account returns the account trie value of the account hash.
+func (dl *diskLayer) Account(hash common.Hash) ([]byte, error) {
+	dl.lock.RLock()
+	defer dl.lock.RUnlock()
+
+	if dl.stale {
+		return nil, ErrSnapshotStale
+	}
+	return rawdb.ReadAccountSnapshot(dl.diskdb, hash), nil
+}
+
+// Storage returns the storage trie value of the storage hash of the account
+// hash.
+func (dl *diskLayer) Storage(accountHash, storageHash common.Hash) ([]byte, error) {
+	dl.lock.RLock()
+	defer dl.lock.RUnlock()
+
+	if dl.stale {
+		return nil, ErrSnapshotStale
+	}
+	return rawdb.ReadStorageSnapshot(dl.diskdb, accountHash, storageHash), nil
+}
+
+// parentLayer returns nil, the disk layer has no parent.
+func (dl *diskLayer) parentLayer() snapshot {
+	return nil
+}
+
+// merge writes the changes of the diff layer over the disk layer to the
+// database and returns the disk layer of the state of the diff layer. Both
+// layers are stale afterwards.
+func (dl *diskLayer) merge(diff *diffLayer) (*diskLayer, error) {
+	// Mark the layers stale first, their reads would mix both states
+	dl.lock.Lock()
+	dl.stale = true
+	dl.lock.Unlock()
+	diff.markStale()
+
+	// The snapshot is incomplete until the new root is written
+	batch := dl.diskdb.NewBatch()
+	rawdb.DeleteSnapshotRoot(batch)
+
+	for hash := range diff.destructs {
+		rawdb.DeleteAccountSnapshot(batch, hash)
+		if err := wipeStorage(dl.diskdb, batch, hash); err != nil {
+			return nil, err
+		}
+	}
+	for hash, data := range diff.accounts {
+		rawdb.WriteAccountSnapshot(batch, hash, data)
+		if err := flushBatch(batch, false); err != nil {
+			return nil, err
+		}
+	}
+	for accountHash, slots := range diff.storage {
+		for storageHash, data := range slots {
+			if len(data) == 0 {
+				rawdb.DeleteStorageSnapshot(batch, accountHash, storageHash)
+			} else {
+				rawdb.WriteStorageSnapshot(batch, accountHash, storageHash, data)
+			}
+			if err := flushBatch(batch, false); err != nil {
+				return nil, err
+			}
+		}
+	}
+	rawdb.WriteSnapshotRoot(batch, diff.root)
+	if err := flushBatch(batch, true); err != nil {
+		return nil, err
+	}
+	return &diskLayer{diskdb: dl.diskdb, root: diff.root}, nil
+}
+
+// wipeStorage deletes the storage snapshot of the account hash.
+func wipeStorage(diskdb ethdb.Database, batch ethdb.Batch, accountHash common.Hash) error {
+	it := diskdb.NewIteratorWithPrefix(rawdb.StorageSnapshotsKey(accountHash))
+	defer it.Release()
+
+	for it.Next() {
+		key := it.Key()
+		if len(key) != len(rawdb.SnapshotStoragePrefix)+2*common.HashLength {
+			continue
+		}
+		if err := batch.Delete(common.CopyBytes(key)); err != nil {
+			return err
+		}
+		if err := flushBatch(batch, false); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+// flushBatch writes the batch once it's large enough, or if forced.
+func flushBatch(batch ethdb.Batch, force bool) error {
+	if !force && batch.ValueSize() < ethdb.IdealBatchSize {
+		return nil
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	batch.Reset()
+	return nil
+}
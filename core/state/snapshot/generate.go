@@ -0,0 +1,135 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package snapshot
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/core/rawdb"
+	"github.com/ethereum/quorum/ethdb"
+	"github.com/ethereum/quorum/log"
+	"github.com/ethereum/quorum/rlp"
+	"github.com/ethereum/quorum/trie"
+)
+
+// logInterval is the interval between the progress logs of long operations.
+const logInterval = 8 * time.Second
+
+// generate replaces the snapshot on disk by the snapshot of the state of the
+// root, read from its tries.
+func generate(diskdb ethdb.Database, triedb *trie.Database, root common.Hash) error {
+	accTrie, err := trie.New(root, triedb)
+	if err != nil {
+		return err
+	}
+	log.Info("Generating state snapshot", "root", root)
+
+	var (
+		start    = time.Now()
+		logged   = time.Now()
+		accounts uint64
+		slots    uint64
+		batch    = diskdb.NewBatch()
+	)
+	rawdb.DeleteSnapshotRoot(diskdb)
+	if err := wipe(diskdb); err != nil {
+		return err
+	}
+	it := trie.NewIterator(accTrie.NodeIterator(nil))
+	for it.Next() {
+		accountHash := common.BytesToHash(it.Key)
+		rawdb.WriteAccountSnapshot(batch, accountHash, it.Value)
+		accounts++
+
+		var acc account
+		if err := rlp.DecodeBytes(it.Value, &acc); err != nil {
+			return fmt.Errorf("invalid account %x: %v", accountHash, err)
+		}
+		if acc.Root != emptyRoot {
+			storageTrie, err := trie.New(acc.Root, triedb)
+			if err != nil {
+				return err
+			}
+			sit := trie.NewIterator(storageTrie.NodeIterator(nil))
+			for sit.Next() {
+				rawdb.WriteStorageSnapshot(batch, accountHash, common.BytesToHash(sit.Key), sit.Value)
+				slots++
+
+				if err := flushBatch(batch, false); err != nil {
+					return err
+				}
+			}
+			if sit.Err != nil {
+				return sit.Err
+			}
+		}
+		if err := flushBatch(batch, false); err != nil {
+			return err
+		}
+		if time.Since(logged) > logInterval {
+			log.Info("Generating state snapshot", "root", root, "accounts", accounts, "slots", slots, "elapsed", common.PrettyDuration(time.Since(start)))
+			logged = time.Now()
+		}
+	}
+	if it.Err != nil {
+		return it.Err
+	}
+	rawdb.WriteSnapshotRoot(batch, root)
+	if err := flushBatch(batch, true); err != nil {
+		return err
+	}
+	log.Info("Generated state snapshot", "root", root, "accounts", accounts, "slots", slots, "elapsed", common.PrettyDuration(time.Since(start)))
+	return nil
+}
+
+// wipe deletes the snapshot on disk.
+func wipe(diskdb ethdb.Database) error {
+	for _, prefix := range []struct {
+		prefix []byte
+		keylen int
+	}{
+		{rawdb.SnapshotAccountPrefix, len(rawdb.SnapshotAccountPrefix) + common.HashLength},
+		{rawdb.SnapshotStoragePrefix, len(rawdb.SnapshotStoragePrefix) + 2*common.HashLength},
+	} {
+		// The prefixes are shared with trie nodes, only delete the snapshot keys
+		it := diskdb.NewIteratorWithPrefix(prefix.prefix)
+		batch := diskdb.NewBatch()
+		for it.Next() {
+			if len(it.Key()) != prefix.keylen {
+				continue
+			}
+			if err := batch.Delete(common.CopyBytes(it.Key())); err != nil {
+				it.Release()
+				return err
+			}
+			if err := flushBatch(batch, false); err != nil {
+				it.Release()
+				return err
+			}
+		}
+		it.Release()
+		if err := it.Error(); err != nil {
+			return err
+		}
+		if err := flushBatch(batch, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,213 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package snapshot implements a flat key-value view of the accounts and storage
+// of recent states, which is read without walking the tries.
+package snapshot
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/core/rawdb"
+	"github.com/ethereum/quorum/ethdb"
+	"github.com/ethereum/quorum/log"
+	"github.com/ethereum/quorum/trie"
+)
+
+var (
+	// emptyRoot is the known root hash of an empty trie.
+	emptyRoot = common.HexToHash("56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421")
+
+	// ErrSnapshotStale is returned by the reads of a snapshot which was merged
+	// into the disk layer. The state must be read from the tries instead.
+	ErrSnapshotStale = errors.New("snapshot stale")
+)
+
+// Snapshot is a flat view of the accounts and storage of a state. The values
+// are the ones stored in the account and storage tries, nil if missing.
+type Snapshot interface {
+	// Root returns the root of the state of the snapshot.
+	Root() common.Hash
+
+	// Account returns the account trie value of the account hash.
+	Account(hash common.Hash) ([]byte, error)
+
+	// Storage returns the storage trie value of the storage hash of the
+	// account hash.
+	Storage(accountHash, storageHash common.Hash) ([]byte, error)
+}
+
+// snapshot is a layer of the snapshot tree.
+type snapshot interface {
+	Snapshot
+
+	// parentLayer returns the layer the snapshot is a diff of, nil for the
+	// disk layer.
+	parentLayer() snapshot
+}
+
+// account is the consensus representation of accounts, as in the state package.
+type account struct {
+	Nonce    uint64
+	Balance  *big.Int
+	Root     common.Hash
+	CodeHash []byte
+}
+
+// Tree is the tree of the snapshots of the recent states: a disk layer with
+// the oldest state, and in memory diff layers with the changes of the newer
+// states over their parent layer. The diff layers too old to be reorged are
+// merged into the disk layer.
+//
+// A tree only holds snapshots of the states derived from its disk layer, the
+// public and the private states have separate trees in separate tables.
+type Tree struct {
+	diskdb ethdb.Database // Database holding the disk layer
+	triedb *trie.Database // Trie database the disk layer is generated from
+
+	layers map[common.Hash]snapshot // Layers by the root of their state
+	lock   sync.RWMutex
+}
+
+// New creates a snapshot tree stored in the database, which has no snapshots
+// until it's loaded.
+func New(diskdb ethdb.Database, triedb *trie.Database) *Tree {
+	return &Tree{
+		diskdb: diskdb,
+		triedb: triedb,
+		layers: make(map[common.Hash]snapshot),
+	}
+}
+
+// stateRoot returns the root of the state, with the zero hash of the missing
+// states being the empty state.
+func stateRoot(root common.Hash) common.Hash {
+	if root == (common.Hash{}) {
+		return emptyRoot
+	}
+	return root
+}
+
+// Load resets the tree to the snapshot on disk of the state of the root. The
+// snapshot is generated from the trie if the disk holds another state.
+func (t *Tree) Load(root common.Hash) error {
+	root = stateRoot(root)
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.layers = make(map[common.Hash]snapshot)
+	if rawdb.ReadSnapshotRoot(t.diskdb) != root {
+		if err := generate(t.diskdb, t.triedb, root); err != nil {
+			return err
+		}
+	}
+	t.layers[root] = &diskLayer{diskdb: t.diskdb, root: root}
+	return nil
+}
+
+// Snapshot returns the snapshot of the state of the root, nil if the tree has
+// none.
+func (t *Tree) Snapshot(root common.Hash) Snapshot {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	if snap := t.layers[stateRoot(root)]; snap != nil {
+		return snap
+	}
+	return nil
+}
+
+// Update adds the snapshot of the state of the root, a diff over the snapshot
+// of its parent state. The destructed accounts have their storage wiped before
+// the changed accounts and storage are applied. Deleted storage slots have nil
+// values.
+func (t *Tree) Update(root common.Hash, parent common.Hash, destructs map[common.Hash]struct{}, accounts map[common.Hash][]byte, storage map[common.Hash]map[common.Hash][]byte) error {
+	root, parent = stateRoot(root), stateRoot(parent)
+	if root == parent {
+		return nil
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.layers[root] != nil {
+		// The same state was reached from another parent
+		return nil
+	}
+	base := t.layers[parent]
+	if base == nil {
+		return fmt.Errorf("parent snapshot [%#x] missing", parent)
+	}
+	t.layers[root] = newDiffLayer(base, root, destructs, accounts, storage)
+	return nil
+}
+
+// Cap keeps at most layers diff layers below the snapshot of the root,
+// included, merging the older ones into the disk layer. The snapshots of the
+// states not derived from the new disk layer are dropped.
+func (t *Tree) Cap(root common.Hash, layers int) error {
+	root = stateRoot(root)
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	snap := t.layers[root]
+	if snap == nil {
+		return fmt.Errorf("snapshot [%#x] missing", root)
+	}
+	var diffs []*diffLayer // Diff layers from the root down to the disk layer
+	for {
+		diff, ok := snap.(*diffLayer)
+		if !ok {
+			break
+		}
+		diffs = append(diffs, diff)
+		snap = diff.parentLayer()
+	}
+	if len(diffs) <= layers {
+		return nil
+	}
+	base := snap.(*diskLayer)
+	for i := len(diffs) - 1; i >= layers; i-- {
+		var err error
+		if base, err = base.merge(diffs[i]); err != nil {
+			// The disk layer is unusable, drop every snapshot
+			t.layers = make(map[common.Hash]snapshot)
+			return err
+		}
+	}
+	if layers > 0 {
+		diffs[layers-1].setParent(base)
+	}
+	// Drop the snapshots merged into the disk layer and the ones they were
+	// the parent of.
+	kept := map[common.Hash]snapshot{base.root: base}
+	for root, snap := range t.layers {
+		for s := snap; s != nil; s = s.parentLayer() {
+			if s == snapshot(base) {
+				kept[root] = snap
+				break
+			}
+		}
+	}
+	log.Debug("Merged snapshot diff layers", "merged", len(diffs)-layers, "root", base.root, "layers", len(kept))
+	t.layers = kept
+	return nil
+}
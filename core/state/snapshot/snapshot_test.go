@@ -0,0 +1,228 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package snapshot
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/core/rawdb"
+	"github.com/ethereum/quorum/crypto"
+	"github.com/ethereum/quorum/ethdb"
+	"github.com/ethereum/quorum/rlp"
+	"github.com/ethereum/quorum/trie"
+)
+
+// testState commits a state of two accounts, one with two storage slots, to
+// the trie database and returns its root.
+func testState(t *testing.T, triedb *trie.Database) common.Hash {
+	storage, _ := trie.New(common.Hash{}, triedb)
+	storage.Update(hashKey(1), []byte{0x01})
+	storage.Update(hashKey(2), []byte{0x02})
+	storageRoot, err := storage.Commit(nil)
+	if err != nil {
+		t.Fatalf("failed to commit storage trie: %v", err)
+	}
+	accounts, _ := trie.New(common.Hash{}, triedb)
+	accounts.Update(hashKey(1), accountRLP(1, emptyRoot))
+	accounts.Update(hashKey(2), accountRLP(2, storageRoot))
+	root, err := accounts.Commit(func(leaf []byte, parent common.Hash) error {
+		triedb.Reference(storageRoot, parent)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to commit account trie: %v", err)
+	}
+	if err := triedb.Commit(root, false); err != nil {
+		t.Fatalf("failed to flush tries: %v", err)
+	}
+	return root
+}
+
+func hashKey(n byte) []byte {
+	return crypto.Keccak256([]byte{n})
+}
+
+func accountRLP(nonce uint64, root common.Hash) []byte {
+	data, _ := rlp.EncodeToBytes(&account{Nonce: nonce, Balance: big.NewInt(0), Root: root, CodeHash: crypto.Keccak256(nil)})
+	return data
+}
+
+func checkAccount(t *testing.T, snap Snapshot, n byte, want []byte) {
+	t.Helper()
+	have, err := snap.Account(common.BytesToHash(hashKey(n)))
+	if err != nil {
+		t.Fatalf("account %d: read failed: %v", n, err)
+	}
+	if !bytes.Equal(have, want) {
+		t.Errorf("account %d: value mismatch: have %x, want %x", n, have, want)
+	}
+}
+
+func checkStorage(t *testing.T, snap Snapshot, n, slot byte, want []byte) {
+	t.Helper()
+	have, err := snap.Storage(common.BytesToHash(hashKey(n)), common.BytesToHash(hashKey(slot)))
+	if err != nil {
+		t.Fatalf("account %d slot %d: read failed: %v", n, slot, err)
+	}
+	if !bytes.Equal(have, want) {
+		t.Errorf("account %d slot %d: value mismatch: have %x, want %x", n, slot, have, want)
+	}
+}
+
+// Tests that the snapshot generated from the tries holds their content and
+// verifies, and that it isn't regenerated once on disk.
+func TestGenerate(t *testing.T) {
+	db := ethdb.NewMemDatabase()
+	triedb := trie.NewDatabase(db)
+	root := testState(t, triedb)
+
+	// Stale snapshot entries must be wiped, trie nodes sharing the prefixes kept
+	rawdb.WriteAccountSnapshot(db, common.BytesToHash(hashKey(9)), []byte{0x09})
+	db.Put(append([]byte("a"), make([]byte, common.HashLength-1)...), []byte{0x0a})
+
+	tree := New(db, triedb)
+	if err := tree.Load(root); err != nil {
+		t.Fatalf("failed to load snapshot: %v", err)
+	}
+	snap := tree.Snapshot(root)
+	if snap == nil {
+		t.Fatalf("snapshot missing")
+	}
+	storage, _ := snap.Account(common.BytesToHash(hashKey(2)))
+	checkAccount(t, snap, 1, accountRLP(1, emptyRoot))
+	checkAccount(t, snap, 9, nil)
+	checkStorage(t, snap, 2, 1, []byte{0x01})
+	checkStorage(t, snap, 2, 2, []byte{0x02})
+	checkStorage(t, snap, 2, 3, nil)
+
+	if has, _ := db.Has(append([]byte("a"), make([]byte, common.HashLength-1)...)); !has {
+		t.Errorf("non snapshot key wiped")
+	}
+	if err := Verify(db); err != nil {
+		t.Errorf("failed to verify snapshot: %v", err)
+	}
+	// Corrupt the snapshot, the root on disk prevents regeneration
+	rawdb.WriteAccountSnapshot(db, common.BytesToHash(hashKey(2)), accountRLP(3, emptyRoot))
+	if err := New(db, triedb).Load(root); err != nil {
+		t.Fatalf("failed to reload snapshot: %v", err)
+	}
+	if err := Verify(db); err == nil {
+		t.Errorf("corrupt snapshot verified")
+	}
+	rawdb.WriteAccountSnapshot(db, common.BytesToHash(hashKey(2)), storage)
+	if err := Verify(db); err != nil {
+		t.Errorf("failed to verify restored snapshot: %v", err)
+	}
+}
+
+// Tests that diff layers are read over their parents and merged into the disk
+// layer once capped.
+func TestDiffLayers(t *testing.T) {
+	db := ethdb.NewMemDatabase()
+	triedb := trie.NewDatabase(db)
+	base := testState(t, triedb)
+
+	tree := New(db, triedb)
+	if err := tree.Load(base); err != nil {
+		t.Fatalf("failed to load snapshot: %v", err)
+	}
+	var (
+		acc1, acc2 = common.BytesToHash(hashKey(1)), common.BytesToHash(hashKey(2))
+		slot1      = common.BytesToHash(hashKey(1))
+		slot3      = common.BytesToHash(hashKey(3))
+
+		root1 = common.HexToHash("0x01")
+		root2 = common.HexToHash("0x02")
+		fork  = common.HexToHash("0x03")
+	)
+	// Change account 1 and a slot of account 2, then destruct account 2 and
+	// recreate it with another slot.
+	err := tree.Update(root1, base, nil, map[common.Hash][]byte{acc1: {0x11}}, map[common.Hash]map[common.Hash][]byte{acc2: {slot1: nil, slot3: {0x03}}})
+	if err != nil {
+		t.Fatalf("failed to update snapshot: %v", err)
+	}
+	err = tree.Update(root2, root1, map[common.Hash]struct{}{acc2: {}}, map[common.Hash][]byte{acc2: {0x22}}, map[common.Hash]map[common.Hash][]byte{acc2: {slot1: {0x04}}})
+	if err != nil {
+		t.Fatalf("failed to update snapshot: %v", err)
+	}
+	if err := tree.Update(fork, base, nil, map[common.Hash][]byte{acc1: {0x33}}, nil); err != nil {
+		t.Fatalf("failed to update snapshot: %v", err)
+	}
+	if err := tree.Update(common.HexToHash("0x04"), common.HexToHash("0x05"), nil, nil, nil); err == nil {
+		t.Fatalf("snapshot added without parent")
+	}
+	snap1, snap2 := tree.Snapshot(root1), tree.Snapshot(root2)
+
+	checkAccount(t, snap1, 1, []byte{0x11})
+	checkAccount(t, snap1, 2, accountRLP(2, tree.Snapshot(base).(*diskLayer).accountRoot(t, 2)))
+	checkStorage(t, snap1, 2, 1, nil)
+	checkStorage(t, snap1, 2, 2, []byte{0x02})
+	checkStorage(t, snap1, 2, 3, []byte{0x03})
+
+	checkAccount(t, snap2, 1, []byte{0x11})
+	checkAccount(t, snap2, 2, []byte{0x22})
+	checkStorage(t, snap2, 2, 1, []byte{0x04})
+	checkStorage(t, snap2, 2, 2, nil)
+	checkStorage(t, snap2, 2, 3, nil)
+
+	// Merge the first diff layer, the fork is dropped
+	if err := tree.Cap(root2, 1); err != nil {
+		t.Fatalf("failed to cap snapshot: %v", err)
+	}
+	if root := rawdb.ReadSnapshotRoot(db); root != root1 {
+		t.Errorf("disk root mismatch: have %x, want %x", root, root1)
+	}
+	if tree.Snapshot(fork) != nil || tree.Snapshot(base) != nil {
+		t.Errorf("snapshots of other branches kept")
+	}
+	if _, err := snap1.Account(acc1); err != ErrSnapshotStale {
+		t.Errorf("merged layer read error mismatch: have %v, want %v", err, ErrSnapshotStale)
+	}
+	checkAccount(t, snap2, 1, []byte{0x11})
+	checkStorage(t, snap2, 2, 1, []byte{0x04})
+	checkStorage(t, snap2, 2, 2, nil)
+
+	// Merge the second one, its storage wipe included
+	if err := tree.Cap(root2, 0); err != nil {
+		t.Fatalf("failed to cap snapshot: %v", err)
+	}
+	disk := tree.Snapshot(root2)
+	if _, ok := disk.(*diskLayer); !ok {
+		t.Fatalf("snapshot not merged into disk layer: %T", disk)
+	}
+	checkAccount(t, disk, 1, []byte{0x11})
+	checkAccount(t, disk, 2, []byte{0x22})
+	checkStorage(t, disk, 2, 1, []byte{0x04})
+	checkStorage(t, disk, 2, 2, nil)
+	checkStorage(t, disk, 2, 3, nil)
+}
+
+// accountRoot returns the storage root of the account in the disk layer.
+func (dl *diskLayer) accountRoot(t *testing.T, n byte) common.Hash {
+	data, err := dl.Account(common.BytesToHash(hashKey(n)))
+	if err != nil {
+		t.Fatalf("account %d: read failed: %v", n, err)
+	}
+	var acc account
+	if err := rlp.DecodeBytes(data, &acc); err != nil {
+		t.Fatalf("account %d: invalid: %v", n, err)
+	}
+	return acc.Root
+}
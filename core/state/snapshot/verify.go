@@ -0,0 +1,117 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package snapshot
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/core/rawdb"
+	"github.com/ethereum/quorum/ethdb"
+	"github.com/ethereum/quorum/log"
+	"github.com/ethereum/quorum/rlp"
+	"github.com/ethereum/quorum/trie"
+)
+
+// Root returns the root of the state of the snapshot stored in the database,
+// the zero hash if there is none.
+func Root(diskdb ethdb.Database) common.Hash {
+	return rawdb.ReadSnapshotRoot(diskdb)
+}
+
+// Verify rebuilds the account and storage tries from the snapshot stored in
+// the database, and checks they hash to the roots of the snapshot state and of
+// the storage of its accounts.
+func Verify(diskdb ethdb.Database) error {
+	root := rawdb.ReadSnapshotRoot(diskdb)
+	if root == (common.Hash{}) {
+		return fmt.Errorf("no complete snapshot")
+	}
+	var (
+		start    = time.Now()
+		logged   = time.Now()
+		accounts uint64
+		slots    uint64
+	)
+	accTrie := newVerifyTrie()
+
+	it := diskdb.NewIteratorWithPrefix(rawdb.SnapshotAccountPrefix)
+	defer it.Release()
+
+	for it.Next() {
+		if len(it.Key()) != len(rawdb.SnapshotAccountPrefix)+common.HashLength {
+			continue
+		}
+		accountHash := common.BytesToHash(it.Key()[len(rawdb.SnapshotAccountPrefix):])
+
+		var acc account
+		if err := rlp.DecodeBytes(it.Value(), &acc); err != nil {
+			return fmt.Errorf("invalid account %x: %v", accountHash, err)
+		}
+		storageRoot, n, err := verifyStorage(diskdb, accountHash)
+		if err != nil {
+			return err
+		}
+		if storageRoot != acc.Root {
+			return fmt.Errorf("storage root mismatch of account %x: have %x, want %x", accountHash, storageRoot, acc.Root)
+		}
+		accTrie.Update(accountHash[:], common.CopyBytes(it.Value()))
+		accounts++
+		slots += n
+
+		if time.Since(logged) > logInterval {
+			log.Info("Verifying state snapshot", "root", root, "accounts", accounts, "slots", slots, "elapsed", common.PrettyDuration(time.Since(start)))
+			logged = time.Now()
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	if have := accTrie.Hash(); have != root {
+		return fmt.Errorf("state root mismatch: have %x, want %x", have, root)
+	}
+	log.Info("Verified state snapshot", "root", root, "accounts", accounts, "slots", slots, "elapsed", common.PrettyDuration(time.Since(start)))
+	return nil
+}
+
+// verifyStorage rebuilds the storage trie of the account hash from the snapshot,
+// returning its root and the number of slots.
+func verifyStorage(diskdb ethdb.Database, accountHash common.Hash) (common.Hash, uint64, error) {
+	var (
+		storageTrie = newVerifyTrie()
+		slots       uint64
+	)
+	it := diskdb.NewIteratorWithPrefix(rawdb.StorageSnapshotsKey(accountHash))
+	defer it.Release()
+
+	for it.Next() {
+		key := it.Key()
+		if len(key) != len(rawdb.SnapshotStoragePrefix)+2*common.HashLength {
+			continue
+		}
+		storageTrie.Update(key[len(key)-common.HashLength:], common.CopyBytes(it.Value()))
+		slots++
+	}
+	return storageTrie.Hash(), slots, it.Error()
+}
+
+// newVerifyTrie creates an empty trie held in memory.
+func newVerifyTrie() *trie.Trie {
+	t, _ := trie.New(common.Hash{}, trie.NewDatabase(ethdb.NewMemDatabase()))
+	return t
+}
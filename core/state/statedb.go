@@ -24,6 +24,7 @@ import (
 	"sort"
 
 	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/core/state/snapshot"
 	"github.com/ethereum/quorum/core/types"
 	"github.com/ethereum/quorum/crypto"
 	"github.com/ethereum/quorum/log"
@@ -44,6 +45,10 @@ var (
 	emptyCode = crypto.Keccak256Hash(nil)
 )
 
+// snapshotLayers is the number of diff layers kept in memory by the snapshot
+// tree, matching the number of recent tries kept in memory by the chain.
+const snapshotLayers = 128
+
 type proofList [][]byte
 
 func (n *proofList) Put(key []byte, value []byte) error {
@@ -60,6 +65,14 @@ type StateDB struct {
 	db   Database
 	trie Trie
 
+	// Flat snapshot of the state read before the trie, and the changes to
+	// add to the snapshot tree on commit.
+	snaps         *snapshot.Tree
+	snap          snapshot.Snapshot
+	snapDestructs map[common.Hash]struct{}
+	snapAccounts  map[common.Hash][]byte
+	snapStorage   map[common.Hash]map[common.Hash][]byte
+
 	// This map holds 'live' objects, which will get modified while processing a state transition.
 	stateObjects      map[common.Address]*stateObject
 	stateObjectsDirty map[common.Address]struct{}
@@ -94,7 +107,7 @@ func New(root common.Hash, db Database) (*StateDB, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &StateDB{
+	sdb := &StateDB{
 		db:                db,
 		trie:              tr,
 		stateObjects:      make(map[common.Address]*stateObject),
@@ -102,7 +115,20 @@ func New(root common.Hash, db Database) (*StateDB, error) {
 		logs:              make(map[common.Hash][]*types.Log),
 		preimages:         make(map[common.Hash][]byte),
 		journal:           newJournal(),
-	}, nil
+	}
+	if sdb.snaps = db.Snapshots(); sdb.snaps != nil {
+		sdb.resetSnapshot(root)
+	}
+	return sdb, nil
+}
+
+// resetSnapshot reads the state through the snapshot of the root, if the tree
+// has one, and clears the changes to add to the tree.
+func (self *StateDB) resetSnapshot(root common.Hash) {
+	self.snap = self.snaps.Snapshot(root)
+	self.snapDestructs = make(map[common.Hash]struct{})
+	self.snapAccounts = make(map[common.Hash][]byte)
+	self.snapStorage = make(map[common.Hash]map[common.Hash][]byte)
 }
 
 // setError remembers the first non-nil error it is called with.
@@ -132,6 +158,9 @@ func (self *StateDB) Reset(root common.Hash) error {
 	self.logs = make(map[common.Hash][]*types.Log)
 	self.logSize = 0
 	self.preimages = make(map[common.Hash][]byte)
+	if self.snaps != nil {
+		self.resetSnapshot(root)
+	}
 	self.clearJournalAndRefund()
 	return nil
 }
@@ -402,6 +431,10 @@ func (self *StateDB) updateStateObject(stateObject *stateObject) {
 		panic(fmt.Errorf("can't encode object at %x: %v", addr[:], err))
 	}
 	self.setError(self.trie.TryUpdate(addr[:], data))
+
+	if self.snap != nil {
+		self.snapAccounts[stateObject.addrHash] = data
+	}
 }
 
 // deleteStateObject removes the given object from the state trie.
@@ -409,6 +442,12 @@ func (self *StateDB) deleteStateObject(stateObject *stateObject) {
 	stateObject.deleted = true
 	addr := stateObject.Address()
 	self.setError(self.trie.TryDelete(addr[:]))
+
+	if self.snap != nil {
+		self.snapDestructs[stateObject.addrHash] = struct{}{}
+		delete(self.snapAccounts, stateObject.addrHash)
+		delete(self.snapStorage, stateObject.addrHash)
+	}
 }
 
 // Retrieve a state object given by the address. Returns nil if not found.
@@ -421,8 +460,17 @@ func (self *StateDB) getStateObject(addr common.Address) (stateObject *stateObje
 		return obj
 	}
 
-	// Load the object from the database.
-	enc, err := self.trie.TryGet(addr[:])
+	// Load the object from the snapshot, or the trie if the snapshot is stale.
+	var (
+		enc []byte
+		err error
+	)
+	if self.snap != nil {
+		enc, err = self.snap.Account(crypto.Keccak256Hash(addr[:]))
+	}
+	if self.snap == nil || err != nil {
+		enc, err = self.trie.TryGet(addr[:])
+	}
 	if len(enc) == 0 {
 		self.setError(err)
 		return nil
@@ -455,12 +503,26 @@ func (self *StateDB) GetOrNewStateObject(addr common.Address) *stateObject {
 // the given address, it is overwritten and returned as the second return value.
 func (self *StateDB) createObject(addr common.Address) (newobj, prev *stateObject) {
 	prev = self.getStateObject(addr)
+
+	// The storage of the overwritten account is wiped from the snapshot
+	var (
+		prevdestruct bool
+		prevstorage  map[common.Hash][]byte
+	)
+	if self.snap != nil && prev != nil {
+		_, prevdestruct = self.snapDestructs[prev.addrHash]
+		if !prevdestruct {
+			self.snapDestructs[prev.addrHash] = struct{}{}
+		}
+		prevstorage = self.snapStorage[prev.addrHash]
+		delete(self.snapStorage, prev.addrHash)
+	}
 	newobj = newObject(self, addr, Account{})
 	newobj.setNonce(0) // sets the object to dirty
 	if prev == nil {
 		self.journal.append(createObjectChange{account: &addr})
 	} else {
-		self.journal.append(resetObjectChange{prev: prev})
+		self.journal.append(resetObjectChange{prev: prev, prevdestruct: prevdestruct, prevstorage: prevstorage})
 	}
 	self.setStateObject(newobj)
 	return newobj, prev
@@ -513,6 +575,26 @@ func (self *StateDB) Copy() *StateDB {
 		logSize:           self.logSize,
 		preimages:         make(map[common.Hash][]byte),
 		journal:           newJournal(),
+		snaps:             self.snaps,
+		snap:              self.snap,
+	}
+	if self.snap != nil {
+		state.snapDestructs = make(map[common.Hash]struct{}, len(self.snapDestructs))
+		for hash := range self.snapDestructs {
+			state.snapDestructs[hash] = struct{}{}
+		}
+		state.snapAccounts = make(map[common.Hash][]byte, len(self.snapAccounts))
+		for hash, data := range self.snapAccounts {
+			state.snapAccounts[hash] = data
+		}
+		state.snapStorage = make(map[common.Hash]map[common.Hash][]byte, len(self.snapStorage))
+		for hash, slots := range self.snapStorage {
+			cpy := make(map[common.Hash][]byte, len(slots))
+			for key, data := range slots {
+				cpy[key] = data
+			}
+			state.snapStorage[hash] = cpy
+		}
 	}
 	// Copy the dirty states, logs, and preimages
 	for addr := range self.journal.dirties {
@@ -620,6 +702,11 @@ func (self *StateDB) Prepare(thash, bhash common.Hash, ti int) {
 	self.txIndex = ti
 }
 
+// TxIndex returns the index of the current transaction, as set by Prepare.
+func (self *StateDB) TxIndex() int {
+	return self.txIndex
+}
+
 func (s *StateDB) clearJournalAndRefund() {
 	s.journal = newJournal()
 	s.validRevisions = s.validRevisions[:0]
@@ -672,5 +759,20 @@ func (s *StateDB) Commit(deleteEmptyObjects bool) (root common.Hash, err error)
 		return nil
 	})
 	log.Debug("Trie cache stats after commit", "misses", trie.CacheMisses(), "unloads", trie.CacheUnloads())
+
+	// Add the snapshot of the new state to the tree
+	if err == nil && s.snap != nil {
+		if err := s.snaps.Update(root, s.snap.Root(), s.snapDestructs, s.snapAccounts, s.snapStorage); err != nil {
+			// The parent layer was dropped meanwhile, the tree can't be
+			// extended anymore and is rebuilt from the committed state
+			log.Warn("Failed to update state snapshot, regenerating", "root", root, "parent", s.snap.Root(), "err", err)
+			if err := s.snaps.Load(root); err != nil {
+				log.Error("Failed to regenerate state snapshot", "root", root, "err", err)
+			}
+		} else if err := s.snaps.Cap(root, snapshotLayers); err != nil {
+			log.Error("Failed to cap state snapshot", "root", root, "layers", snapshotLayers, "err", err)
+		}
+		s.resetSnapshot(root)
+	}
 	return root, err
 }
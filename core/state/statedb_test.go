@@ -31,6 +31,7 @@ import (
 	check "gopkg.in/check.v1"
 
 	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/core/state/snapshot"
 	"github.com/ethereum/quorum/core/types"
 	"github.com/ethereum/quorum/ethdb"
 )
@@ -466,3 +467,124 @@ func TestCopyOfCopy(t *testing.T) {
 		t.Fatalf("2nd copy fail, expected 42, got %v", got)
 	}
 }
+
+// Tests that states read through snapshots match the tries, and that the
+// snapshots merged into the disk layer rebuild the state root.
+func TestSnapshotReads(t *testing.T) {
+	db := ethdb.NewMemDatabase()
+	sdb := NewDatabaseWithSnapshots(db, db)
+	if err := sdb.Snapshots().Load(common.Hash{}); err != nil {
+		t.Fatalf("failed to load snapshot: %v", err)
+	}
+	var (
+		addrs = []common.Address{{0x01}, {0x02}, {0x03}, {0x04}, {0x05}}
+		keys  = []common.Hash{{0x01}, {0x02}, {0x03}, {0x04}, {0x05}}
+	)
+	commit := func(state *StateDB) common.Hash {
+		root, err := state.Commit(true)
+		if err != nil {
+			t.Fatalf("failed to commit state: %v", err)
+		}
+		if err := state.Database().TrieDB().Commit(root, false); err != nil {
+			t.Fatalf("failed to flush state: %v", err)
+		}
+		return root
+	}
+	state, _ := New(common.Hash{}, sdb)
+	if state.snap == nil {
+		t.Fatalf("empty state not read through snapshot")
+	}
+	for i, addr := range addrs {
+		state.AddBalance(addr, big.NewInt(int64(i+1)))
+		state.SetState(addr, keys[0], common.Hash{0x10})
+		state.SetState(addr, keys[1], common.Hash{0x20})
+	}
+	state.SetCode(addrs[2], []byte{0x60, 0x00})
+	root := commit(state)
+
+	state, _ = New(root, sdb)
+	if state.snap == nil || state.snap.Root() != root {
+		t.Fatalf("committed state not read through snapshot")
+	}
+	// Change and delete slots, destruct and recreate accounts
+	state.SetState(addrs[0], keys[0], common.Hash{})
+	state.SetState(addrs[0], keys[2], common.Hash{0x30})
+	state.Suicide(addrs[1])
+	state.CreateAccount(addrs[2])
+	state.SetState(addrs[2], keys[3], common.Hash{0x40})
+	state.Suicide(addrs[3])
+	state.Finalise(true)
+
+	state.CreateAccount(addrs[3])
+	state.AddBalance(addrs[3], big.NewInt(10))
+	state.SetState(addrs[3], keys[4], common.Hash{0x50})
+
+	revision := state.Snapshot()
+	state.CreateAccount(addrs[4])
+	state.RevertToSnapshot(revision)
+	root = commit(state)
+
+	tries, _ := New(root, NewDatabase(db))
+	snaps, _ := New(root, sdb)
+	if snaps.snap == nil {
+		t.Fatalf("updated state not read through snapshot")
+	}
+	for _, addr := range addrs {
+		if have, want := snaps.Exist(addr), tries.Exist(addr); have != want {
+			t.Errorf("account %x: existence mismatch: have %v, want %v", addr, have, want)
+		}
+		if have, want := snaps.GetBalance(addr), tries.GetBalance(addr); have.Cmp(want) != 0 {
+			t.Errorf("account %x: balance mismatch: have %v, want %v", addr, have, want)
+		}
+		for _, key := range keys {
+			if have, want := snaps.GetState(addr, key), tries.GetState(addr, key); have != want {
+				t.Errorf("account %x slot %x: value mismatch: have %x, want %x", addr, key, have, want)
+			}
+		}
+	}
+	if err := sdb.Snapshots().Cap(root, 0); err != nil {
+		t.Fatalf("failed to cap snapshot: %v", err)
+	}
+	if have := snapshot.Root(db); have != root {
+		t.Fatalf("disk snapshot root mismatch: have %x, want %x", have, root)
+	}
+	if err := snapshot.Verify(db); err != nil {
+		t.Fatalf("failed to verify snapshot: %v", err)
+	}
+}
+
+// Tests that the snapshot tree is rebuilt from the committed state if the
+// snapshot of its parent state was dropped meanwhile.
+func TestSnapshotUpdateMissingParent(t *testing.T) {
+	db := ethdb.NewMemDatabase()
+	sdb := NewDatabaseWithSnapshots(db, db)
+	if err := sdb.Snapshots().Load(common.Hash{}); err != nil {
+		t.Fatalf("failed to load snapshot: %v", err)
+	}
+	stale, _ := New(common.Hash{}, sdb)
+	stale.AddBalance(common.Address{0x01}, big.NewInt(1))
+
+	state, _ := New(common.Hash{}, sdb)
+	state.AddBalance(common.Address{0x02}, big.NewInt(2))
+	root, err := state.Commit(true)
+	if err != nil {
+		t.Fatalf("failed to commit state: %v", err)
+	}
+	// Merging the new state into the disk layer drops the empty one
+	if err := sdb.Snapshots().Cap(root, 0); err != nil {
+		t.Fatalf("failed to cap snapshot: %v", err)
+	}
+	root, err = stale.Commit(true)
+	if err != nil {
+		t.Fatalf("failed to commit stale state: %v", err)
+	}
+	if sdb.Snapshots().Snapshot(root) == nil {
+		t.Fatalf("snapshot of the committed state not regenerated")
+	}
+	if have := snapshot.Root(db); have != root {
+		t.Fatalf("disk snapshot root mismatch: have %x, want %x", have, root)
+	}
+	if err := snapshot.Verify(db); err != nil {
+		t.Fatalf("failed to verify snapshot: %v", err)
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"strings"
+
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/core/types"
+	"github.com/ethereum/quorum/metrics"
+)
+
+// defaultLaneName is the name of the lane of the senders in no configured lane.
+const defaultLaneName = "default"
+
+// TxLane is a priority class of transaction senders. The senders of a lane
+// have their own executable slot quotas and their transactions are put into
+// blocks before those of the lanes configured after it. Senders in no lane
+// are in the default lane, which comes last and uses the slots of the pool.
+//
+// In Quorum networks the gas price is usually zero, which leaves the pool
+// without a price ordering to separate system senders from bulk loads.
+type TxLane struct {
+	Name    string           // Name of the lane in logs and metrics
+	Senders []common.Address // Accounts in the lane
+	Orgs    []string         // Permission orgs whose accounts, sub orgs included, are in the lane
+
+	AccountSlots uint64 // Number of executable transaction slots guaranteed per account of the lane
+	GlobalSlots  uint64 // Maximum number of executable transaction slots for all accounts of the lane
+}
+
+// txLanes assigns transaction senders to the configured lanes.
+type txLanes struct {
+	lanes   []TxLane
+	senders map[common.Address]int // lane of the configured senders
+	orgs    bool                   // whether any lane has orgs
+
+	pendingGauges     []metrics.Gauge   // executable transactions per lane, default lane last
+	rateLimitCounters []metrics.Counter // executable transactions dropped over the lane quota
+}
+
+// newTxLanes indexes the lanes of a sanitized pool config.
+func newTxLanes(config *TxPoolConfig) *txLanes {
+	l := &txLanes{
+		lanes:   config.Lanes,
+		senders: make(map[common.Address]int),
+	}
+	for i := len(config.Lanes) - 1; i >= 0; i-- {
+		// a sender configured in several lanes is in the first one
+		for _, addr := range config.Lanes[i].Senders {
+			l.senders[addr] = i
+		}
+		if len(config.Lanes[i].Orgs) > 0 {
+			l.orgs = true
+		}
+	}
+	for i := 0; i <= len(config.Lanes); i++ {
+		name := l.name(i)
+		l.pendingGauges = append(l.pendingGauges, metrics.GetOrRegisterGauge("txpool/lane/"+name+"/pending", nil))
+		l.rateLimitCounters = append(l.rateLimitCounters, metrics.GetOrRegisterCounter("txpool/lane/"+name+"/ratelimit", nil))
+	}
+	return l
+}
+
+// count returns the number of lanes, the default lane included.
+func (l *txLanes) count() int {
+	return len(l.lanes) + 1
+}
+
+// name returns the name of the lane.
+func (l *txLanes) name(lane int) string {
+	if lane == len(l.lanes) {
+		return defaultLaneName
+	}
+	return l.lanes[lane].Name
+}
+
+// lane returns the lane of the sender, the default lane if it is in no
+// configured one. Senders are matched before orgs.
+func (l *txLanes) lane(addr common.Address) int {
+	if lane, ok := l.senders[addr]; ok {
+		return lane
+	}
+	if l.orgs {
+		if acct := types.AcctInfoMap.GetAccountCached(addr); acct != nil {
+			for i, lane := range l.lanes {
+				for _, org := range lane.Orgs {
+					if acct.OrgId == org || strings.HasPrefix(acct.OrgId, org+".") {
+						return i
+					}
+				}
+			}
+		}
+	}
+	return len(l.lanes)
+}
+
+// slots returns the executable slots for all accounts and per account of the
+// lane.
+func (l *txLanes) slots(lane int, config *TxPoolConfig) (global uint64, account uint64) {
+	if lane == len(l.lanes) {
+		return config.GlobalSlots, config.AccountSlots
+	}
+	return l.lanes[lane].GlobalSlots, l.lanes[lane].AccountSlots
+}
+
+// capacity returns the executable slots of all lanes.
+func (l *txLanes) capacity(config *TxPoolConfig) uint64 {
+	slots := config.GlobalSlots
+	for _, lane := range l.lanes {
+		slots += lane.GlobalSlots
+	}
+	return slots
+}
+
+// SplitLanes splits the transactions by the lane of their sender, in priority
+// order with the default lane last, for the block producers to include the
+// transactions of higher lanes first.
+func (pool *TxPool) SplitLanes(txs map[common.Address]types.Transactions) []map[common.Address]types.Transactions {
+	lanes := make([]map[common.Address]types.Transactions, pool.lanes.count())
+	for i := range lanes {
+		lanes[i] = make(map[common.Address]types.Transactions)
+	}
+	for addr, list := range txs {
+		lanes[pool.lanes.lane(addr)][addr] = list
+	}
+	return lanes
+}
@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/common/mclock"
 	"github.com/ethereum/quorum/common/prque"
 	"github.com/ethereum/quorum/core/state"
 	"github.com/ethereum/quorum/core/types"
@@ -82,6 +83,26 @@ var (
 	// ErrEtherValueUnsupported is returned if a transaction specifies an Ether Value
 	// for a private Quorum transaction.
 	ErrEtherValueUnsupported = errors.New("ether value is not supported for private transactions")
+
+	// ErrReadOnlyAccount is returned if the sender of a transaction only has read
+	// only access once permissions are enabled.
+	ErrReadOnlyAccount = errors.New("read only account. cannot transact")
+
+	// ErrContractCreationNotPermitted is returned if a transaction creates a contract
+	// but the sender only has transact access once permissions are enabled.
+	ErrContractCreationNotPermitted = errors.New("account does not have contract create permissions")
+
+	// ErrNotInRolePolicy is returned if a transaction calls a contract method the
+	// policies of the sender's role don't allow once permissions are enabled.
+	ErrNotInRolePolicy = errors.New("contract call not allowed by the account role policies")
+
+	// ErrSenderRateLimited is returned if a remote sender submits transactions
+	// faster than the admission rate of the pool allows.
+	ErrSenderRateLimited = errors.New("sender exceeds the transaction rate limit")
+
+	// ErrPeerRateLimited is returned if a peer consistently relays transactions
+	// faster than the admission rate of the pool allows.
+	ErrPeerRateLimited = errors.New("peer exceeds the transaction rate limit")
 )
 
 var (
@@ -105,6 +126,10 @@ var (
 	// General tx metrics
 	invalidTxCounter     = metrics.NewRegisteredCounter("txpool/invalid", nil)
 	underpricedTxCounter = metrics.NewRegisteredCounter("txpool/underpriced", nil)
+
+	// Admission rate limit metrics
+	senderRateLimitCounter = metrics.NewRegisteredCounter("txpool/ratelimit/sender", nil) // Transactions of senders over their rate
+	peerRateLimitCounter   = metrics.NewRegisteredCounter("txpool/ratelimit/peer", nil)   // Transactions of peers over their rate
 )
 
 // TxStatus is the current status of a transaction as seen by the pool.
@@ -146,6 +171,13 @@ type TxPoolConfig struct {
 	GlobalQueue  uint64 // Maximum number of non-executable transaction slots for all accounts
 
 	Lifetime time.Duration // Maximum amount of time non-executable transaction are queued
+
+	Lanes []TxLane // Priority classes of senders with their own slots, highest priority first
+
+	SenderRate  float64 // Transactions per second admitted per remote sender, zero for no limit
+	SenderBurst uint64  // Transactions admitted at once per remote sender
+	PeerRate    float64 // Transactions per second admitted per relaying peer, zero for no limit
+	PeerBurst   uint64  // Transactions admitted at once per relaying peer
 }
 
 // DefaultTxPoolConfig contains the default configurations for the transaction
@@ -184,6 +216,43 @@ func (config *TxPoolConfig) sanitize() TxPoolConfig {
 		log.Warn("Sanitizing invalid txpool price bump", "provided", conf.PriceBump, "updated", DefaultTxPoolConfig.PriceBump)
 		conf.PriceBump = DefaultTxPoolConfig.PriceBump
 	}
+	if conf.SenderRate < 0 {
+		log.Warn("Sanitizing invalid txpool sender rate", "provided", conf.SenderRate, "updated", 0)
+		conf.SenderRate = 0
+	}
+	if conf.SenderRate > 0 && float64(conf.SenderBurst) < conf.SenderRate {
+		burst := uint64(math.Ceil(conf.SenderRate))
+		log.Warn("Sanitizing invalid txpool sender burst", "provided", conf.SenderBurst, "updated", burst)
+		conf.SenderBurst = burst
+	}
+	if conf.PeerRate < 0 {
+		log.Warn("Sanitizing invalid txpool peer rate", "provided", conf.PeerRate, "updated", 0)
+		conf.PeerRate = 0
+	}
+	if conf.PeerRate > 0 && float64(conf.PeerBurst) < conf.PeerRate {
+		burst := uint64(math.Ceil(conf.PeerRate))
+		log.Warn("Sanitizing invalid txpool peer burst", "provided", conf.PeerBurst, "updated", burst)
+		conf.PeerBurst = burst
+	}
+	names := map[string]bool{defaultLaneName: true}
+	conf.Lanes = make([]TxLane, len(config.Lanes))
+	for i, lane := range config.Lanes {
+		if lane.Name == "" || names[lane.Name] {
+			name := fmt.Sprintf("lane%d", i)
+			log.Warn("Sanitizing invalid txpool lane name", "provided", lane.Name, "updated", name)
+			lane.Name = name
+		}
+		names[lane.Name] = true
+		if lane.AccountSlots < 1 {
+			log.Warn("Sanitizing invalid txpool lane account slots", "lane", lane.Name, "provided", lane.AccountSlots, "updated", conf.AccountSlots)
+			lane.AccountSlots = conf.AccountSlots
+		}
+		if lane.GlobalSlots < 1 {
+			log.Warn("Sanitizing invalid txpool lane global slots", "lane", lane.Name, "provided", lane.GlobalSlots, "updated", conf.GlobalSlots)
+			lane.GlobalSlots = conf.GlobalSlots
+		}
+		conf.Lanes[i] = lane
+	}
 	return conf
 }
 
@@ -211,8 +280,12 @@ type TxPool struct {
 	currentMaxGas uint64              // Current gas limit for transaction caps
 
 	locals  *accountSet // Set of local transaction to exempt from eviction rules
+	lanes   *txLanes    // Priority lanes of the senders
 	journal *txJournal  // Journal of local transaction to back up to disk
 
+	senderLimiter *txRateLimiter // Admission rate limits of the remote senders
+	peerLimiter   *txRateLimiter // Admission rate limits of the relaying peers
+
 	pending map[common.Address]*txList   // All currently processable transactions
 	queue   map[common.Address]*txList   // Queued but non-processable transactions
 	beats   map[common.Address]time.Time // Last heartbeat from each known account
@@ -248,6 +321,12 @@ func NewTxPool(config TxPoolConfig, chainconfig *params.ChainConfig, chain block
 		log.Info("Setting new local account", "address", addr)
 		pool.locals.add(addr)
 	}
+	pool.lanes = newTxLanes(&config)
+	pool.senderLimiter = newTxRateLimiter(config.SenderRate, config.SenderBurst, mclock.System{})
+	pool.peerLimiter = newTxRateLimiter(config.PeerRate, config.PeerBurst, mclock.System{})
+	for _, lane := range config.Lanes {
+		log.Info("Setting txpool lane", "name", lane.Name, "senders", len(lane.Senders), "orgs", lane.Orgs, "accountslots", lane.AccountSlots, "globalslots", lane.GlobalSlots)
+	}
 	pool.priced = newTxPricedList(pool.all)
 	pool.reset(nil, chain.CurrentBlock().Header())
 
@@ -341,6 +420,9 @@ func (pool *TxPool) loop() {
 			}
 			pool.mu.Unlock()
 
+			pool.senderLimiter.prune()
+			pool.peerLimiter.prune()
+
 		// Handle local transaction journal rotation
 		case <-journal.C:
 			if pool.journal != nil {
@@ -632,10 +714,10 @@ func (pool *TxPool) validateTx(tx *types.Transaction, local bool) error {
 	}
 
 	// Check if the sender account is authorized to perform the transaction
-	if isQuorum {
-		if err := checkAccount(from, tx.To()); err != nil {
-			return err
-		}
+	head := pool.chain.CurrentBlock().Header()
+	next := new(big.Int).Add(head.Number, common.Big1)
+	if err := CheckAccountAccess(pool.chainconfig, types.CachedPermissions, next, AccessTime(head), from, tx); err != nil {
+		return err
 	}
 
 	return nil
@@ -662,8 +744,16 @@ func (pool *TxPool) add(tx *types.Transaction, local bool) (bool, error) {
 		invalidTxCounter.Inc(1)
 		return false, err
 	}
+	// If the remote sender exceeds its admission rate, discard it
+	from, _ := types.Sender(pool.signer, tx) // already validated
+	if !local && !pool.locals.contains(from) && !pool.senderLimiter.allow(from, 1) {
+		log.Trace("Discarding rate limited transaction", "hash", hash, "from", from)
+		senderRateLimitCounter.Inc(1)
+		return false, ErrSenderRateLimited
+	}
 	// If the transaction pool is full, discard underpriced transactions
-	if uint64(pool.all.Count()) >= pool.config.GlobalSlots+pool.config.GlobalQueue {
+	capacity := pool.lanes.capacity(&pool.config) + pool.config.GlobalQueue
+	if uint64(pool.all.Count()) >= capacity {
 		// If the new transaction is underpriced, don't accept it
 		if !pool.chainconfig.IsQuorum && !local && pool.priced.Underpriced(tx, pool.locals) {
 			log.Trace("Discarding underpriced transaction", "hash", hash, "price", tx.GasPrice())
@@ -671,7 +761,7 @@ func (pool *TxPool) add(tx *types.Transaction, local bool) (bool, error) {
 			return false, ErrUnderpriced
 		}
 		// New transaction is better than our worse ones, make room for it
-		drop := pool.priced.Discard(pool.all.Count()-int(pool.config.GlobalSlots+pool.config.GlobalQueue-1), pool.locals)
+		drop := pool.priced.Discard(pool.all.Count()-int(capacity-1), pool.locals)
 		for _, tx := range drop {
 			log.Trace("Discarding freshly underpriced transaction", "hash", tx.Hash(), "price", tx.GasPrice())
 			underpricedTxCounter.Inc(1)
@@ -679,7 +769,6 @@ func (pool *TxPool) add(tx *types.Transaction, local bool) (bool, error) {
 		}
 	}
 	// If the transaction is replacing an already pending one, do directly
-	from, _ := types.Sender(pool.signer, tx) // already validated
 	if list := pool.pending[from]; list != nil && list.Overlaps(tx) {
 		// Nonce already pending, check if required price bump is met
 		inserted, old := list.Add(tx, pool.config.PriceBump)
@@ -829,6 +918,54 @@ func (pool *TxPool) AddRemotes(txs []*types.Transaction) []error {
 	return pool.addTxs(txs, false)
 }
 
+// AddRemotesFrom enqueues a batch of transactions relayed by the peer into the
+// pool if they are valid. The new transactions over the admission rate of the
+// peer are discarded with ErrPeerRateLimited. If the peer is consistently over
+// its rate, ErrPeerRateLimited is returned too.
+func (pool *TxPool) AddRemotesFrom(peer string, txs []*types.Transaction) ([]error, error) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	// Known transactions are relayed by several peers, don't charge for them
+	var fresh []int
+	for i, tx := range txs {
+		if pool.all.Get(tx.Hash()) == nil {
+			fresh = append(fresh, i)
+		}
+	}
+	admitted, limited := pool.peerLimiter.take(peer, len(fresh))
+	if admitted == len(fresh) {
+		return pool.addTxsLocked(txs, false), nil
+	}
+	// Admit the transactions the peer has the allowance for, drop the rest
+	dropped := fresh[admitted:]
+	log.Debug("Discarding rate limited transactions", "peer", peer, "count", len(dropped))
+	peerRateLimitCounter.Inc(int64(len(dropped)))
+
+	errs := make([]error, len(txs))
+	for _, i := range dropped {
+		errs[i] = ErrPeerRateLimited
+	}
+	var (
+		batch []*types.Transaction
+		index []int
+	)
+	for i, tx := range txs {
+		if errs[i] == nil {
+			batch = append(batch, tx)
+			index = append(index, i)
+		}
+	}
+	for i, err := range pool.addTxsLocked(batch, false) {
+		errs[index[i]] = err
+	}
+	if limited {
+		log.Debug("Peer consistently over the transaction rate limit", "peer", peer)
+		return errs, ErrPeerRateLimited
+	}
+	return errs, nil
+}
+
 // addTx enqueues a single transaction into the pool if it is valid.
 func (pool *TxPool) addTx(tx *types.Transaction, local bool) error {
 	pool.mu.Lock()
@@ -1018,76 +1155,26 @@ func (pool *TxPool) promoteExecutables(accounts []common.Address) {
 	if len(promoted) > 0 {
 		go pool.txFeed.Send(NewTxsEvent{promoted})
 	}
-	// If the pending limit is overflown, start equalizing allowances
-	pending := uint64(0)
-	for _, list := range pool.pending {
-		pending += uint64(list.Len())
-	}
-	if pending > pool.config.GlobalSlots {
-		pendingBeforeCap := pending
-		// Assemble a spam order to penalize large transactors first
-		spammers := prque.New(nil)
-		for addr, list := range pool.pending {
-			// Only evict transactions from high rollers
-			if !pool.locals.contains(addr) && uint64(list.Len()) > pool.config.AccountSlots {
-				spammers.Push(addr, int64(list.Len()))
-			}
-		}
-		// Gradually drop transactions from offenders
-		offenders := []common.Address{}
-		for pending > pool.config.GlobalSlots && !spammers.Empty() {
-			// Retrieve the next offender if not local address
-			offender, _ := spammers.Pop()
-			offenders = append(offenders, offender.(common.Address))
-
-			// Equalize balances until all the same or below threshold
-			if len(offenders) > 1 {
-				// Calculate the equalization threshold for all current offenders
-				threshold := pool.pending[offender.(common.Address)].Len()
-
-				// Iteratively reduce all offenders until below limit or threshold reached
-				for pending > pool.config.GlobalSlots && pool.pending[offenders[len(offenders)-2]].Len() > threshold {
-					for i := 0; i < len(offenders)-1; i++ {
-						list := pool.pending[offenders[i]]
-						for _, tx := range list.Cap(list.Len() - 1) {
-							// Drop the transaction from the global pools too
-							hash := tx.Hash()
-							pool.all.Remove(hash)
-							pool.priced.Removed()
-
-							// Update the account nonce to the dropped transaction
-							if nonce := tx.Nonce(); pool.pendingState.GetNonce(offenders[i]) > nonce {
-								pool.pendingState.SetNonce(offenders[i], nonce)
-							}
-							log.Trace("Removed fairness-exceeding pending transaction", "hash", hash)
-						}
-						pending--
-					}
-				}
-			}
+	// If the pending limit of a lane is overflown, start equalizing allowances
+	lanes := make([][]common.Address, pool.lanes.count())
+	for addr := range pool.pending {
+		lane := pool.lanes.lane(addr)
+		lanes[lane] = append(lanes[lane], addr)
+	}
+	for lane, addrs := range lanes {
+		pending := uint64(0)
+		for _, addr := range addrs {
+			pending += uint64(pool.pending[addr].Len())
 		}
-		// If still above threshold, reduce to limit or min allowance
-		if pending > pool.config.GlobalSlots && len(offenders) > 0 {
-			for pending > pool.config.GlobalSlots && uint64(pool.pending[offenders[len(offenders)-1]].Len()) > pool.config.AccountSlots {
-				for _, addr := range offenders {
-					list := pool.pending[addr]
-					for _, tx := range list.Cap(list.Len() - 1) {
-						// Drop the transaction from the global pools too
-						hash := tx.Hash()
-						pool.all.Remove(hash)
-						pool.priced.Removed()
+		globalSlots, accountSlots := pool.lanes.slots(lane, &pool.config)
+		if pending > globalSlots {
+			pendingBeforeCap := pending
+			pending = pool.truncatePending(addrs, pending, globalSlots, accountSlots)
 
-						// Update the account nonce to the dropped transaction
-						if nonce := tx.Nonce(); pool.pendingState.GetNonce(addr) > nonce {
-							pool.pendingState.SetNonce(addr, nonce)
-						}
-						log.Trace("Removed fairness-exceeding pending transaction", "hash", hash)
-					}
-					pending--
-				}
-			}
+			pendingRateLimitCounter.Inc(int64(pendingBeforeCap - pending))
+			pool.lanes.rateLimitCounters[lane].Inc(int64(pendingBeforeCap - pending))
 		}
-		pendingRateLimitCounter.Inc(int64(pendingBeforeCap - pending))
+		pool.lanes.pendingGauges[lane].Update(int64(pending))
 	}
 	// If we've queued more transactions than the hard limit, drop oldest ones
 	queued := uint64(0)
@@ -1131,6 +1218,75 @@ func (pool *TxPool) promoteExecutables(accounts []common.Address) {
 	}
 }
 
+// truncatePending drops executable transactions of the accounts, the large
+// transactors first, until their pending count is down to the global slots or
+// every account is down to its guaranteed slots. It returns the new count.
+func (pool *TxPool) truncatePending(addrs []common.Address, pending uint64, globalSlots, accountSlots uint64) uint64 {
+	// Assemble a spam order to penalize large transactors first
+	spammers := prque.New(nil)
+	for _, addr := range addrs {
+		// Only evict transactions from high rollers
+		if list := pool.pending[addr]; !pool.locals.contains(addr) && uint64(list.Len()) > accountSlots {
+			spammers.Push(addr, int64(list.Len()))
+		}
+	}
+	// Gradually drop transactions from offenders
+	offenders := []common.Address{}
+	for pending > globalSlots && !spammers.Empty() {
+		// Retrieve the next offender if not local address
+		offender, _ := spammers.Pop()
+		offenders = append(offenders, offender.(common.Address))
+
+		// Equalize balances until all the same or below threshold
+		if len(offenders) > 1 {
+			// Calculate the equalization threshold for all current offenders
+			threshold := pool.pending[offender.(common.Address)].Len()
+
+			// Iteratively reduce all offenders until below limit or threshold reached
+			for pending > globalSlots && pool.pending[offenders[len(offenders)-2]].Len() > threshold {
+				for i := 0; i < len(offenders)-1; i++ {
+					list := pool.pending[offenders[i]]
+					for _, tx := range list.Cap(list.Len() - 1) {
+						// Drop the transaction from the global pools too
+						hash := tx.Hash()
+						pool.all.Remove(hash)
+						pool.priced.Removed()
+
+						// Update the account nonce to the dropped transaction
+						if nonce := tx.Nonce(); pool.pendingState.GetNonce(offenders[i]) > nonce {
+							pool.pendingState.SetNonce(offenders[i], nonce)
+						}
+						log.Trace("Removed fairness-exceeding pending transaction", "hash", hash)
+					}
+					pending--
+				}
+			}
+		}
+	}
+	// If still above threshold, reduce to limit or min allowance
+	if pending > globalSlots && len(offenders) > 0 {
+		for pending > globalSlots && uint64(pool.pending[offenders[len(offenders)-1]].Len()) > accountSlots {
+			for _, addr := range offenders {
+				list := pool.pending[addr]
+				for _, tx := range list.Cap(list.Len() - 1) {
+					// Drop the transaction from the global pools too
+					hash := tx.Hash()
+					pool.all.Remove(hash)
+					pool.priced.Removed()
+
+					// Update the account nonce to the dropped transaction
+					if nonce := tx.Nonce(); pool.pendingState.GetNonce(addr) > nonce {
+						pool.pendingState.SetNonce(addr, nonce)
+					}
+					log.Trace("Removed fairness-exceeding pending transaction", "hash", hash)
+				}
+				pending--
+			}
+		}
+	}
+	return pending
+}
+
 // demoteUnexecutables removes invalid and processed transactions from the pools
 // executable/pending queue and any subsequent transactions that become unexecutable
 // are moved back into the future queue.
@@ -1304,22 +1460,50 @@ func (t *txLookup) Remove(hash common.Hash) {
 	delete(t.all, hash)
 }
 
-// checks if the account is has the necessary access for the transaction
-func checkAccount(fromAcct common.Address, toAcct *common.Address) error {
-	access := types.GetAcctAccess(fromAcct)
+// nanosecondHeaderTime is the header time from which raft timestamps, which
+// are in nanoseconds, are told apart from the ones in seconds.
+var nanosecondHeaderTime = uint64(time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC).UnixNano())
 
-	switch access {
+// AccessTime returns the unix time in seconds the expiry time of account roles
+// is checked against for transactions built on the header, which is the header
+// time. Raft header times are in nanoseconds and converted.
+func AccessTime(header *types.Header) uint64 {
+	t := header.Time.Uint64()
+	if t >= nanosecondHeaderTime {
+		return t / uint64(time.Second)
+	}
+	return t
+}
+
+// CheckAccountAccess checks if the sender has the necessary access for a transaction
+// included in the given block. Access is only enforced from the QIP714 block on,
+// or from genesis on if the chain doesn't define one. time is the unix time in
+// seconds the expiry time of account roles is checked against, as returned by
+// AccessTime, zero skips it.
+func CheckAccountAccess(config *params.ChainConfig, access types.AccessChecker, number *big.Int, time uint64, from common.Address, tx *types.Transaction) error {
+	if !config.IsQuorum || (config.QIP714Block != nil && !config.IsQIP714(number)) {
+		return nil
+	}
+	to := tx.To()
+	switch access.GetAcctAccess(from, number, time) {
 	case types.ReadOnly:
-		return errors.New("read only account. cannot transact")
+		return ErrReadOnlyAccount
 
 	case types.Transact:
-		if toAcct == nil {
-			return errors.New("account does not have contract create permissions")
+		if to == nil {
+			return ErrContractCreationNotPermitted
+		}
+	}
+	if to != nil {
+		// the payload of private transactions is not visible, only the
+		// contract is checked for them
+		var selector []byte
+		if data := tx.Data(); !tx.IsPrivate() && len(data) >= 4 {
+			selector = data[:4]
+		}
+		if !access.CheckAcctPolicy(from, number, time, *to, selector) {
+			return ErrNotInRolePolicy
 		}
-
-	case types.FullAccess, types.ContractDeploy:
-		return nil
-
 	}
 	return nil
 }
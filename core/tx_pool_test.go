@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/common/mclock"
 	"github.com/ethereum/quorum/core/state"
 	"github.com/ethereum/quorum/core/types"
 	"github.com/ethereum/quorum/crypto"
@@ -326,6 +327,81 @@ func TestQuorumInvalidTransactions(t *testing.T) {
 
 }
 
+// Tests that transactions are rejected if the sender lacks the access type once
+// permissions are enabled.
+func TestQuorumUnauthorizedTransactions(t *testing.T) {
+	pool, key := setupQuorumTxPool()
+	defer pool.Stop()
+
+	types.SetDefaultAccess()
+	defer func() {
+		types.DefaultAccess, types.QIP714BlockReached = types.FullAccess, false
+	}()
+
+	// Accounts not known to the permissions cache are read only
+	tx, _ := types.SignTx(types.NewTransaction(0, common.Address{}, common.Big0, 100000, common.Big0, nil), types.HomesteadSigner{}, key)
+	if err := pool.AddRemote(tx); err != ErrReadOnlyAccount {
+		t.Error("expected", ErrReadOnlyAccount, "; got", err)
+	}
+
+	// Accounts with transact access can't deploy contracts
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	types.OrgInfoMap.UpsertOrg("ORG", "", "ORG", big.NewInt(1), types.OrgApproved)
+	types.RoleInfoMap.UpsertRole("ORG", "TRANSACTOR", false, false, types.Transact, true)
+	types.AcctInfoMap.UpsertAccount("ORG", "TRANSACTOR", from, false, types.AcctActive)
+
+	create, _ := types.SignTx(types.NewContractCreation(0, common.Big0, 100000, common.Big0, nil), types.HomesteadSigner{}, key)
+	if err := pool.AddRemote(create); err != ErrContractCreationNotPermitted {
+		t.Error("expected", ErrContractCreationNotPermitted, "; got", err)
+	}
+	if err := pool.AddRemote(tx); err != nil {
+		t.Error("expected", nil, "; got", err)
+	}
+
+	// Role policies restrict the contract methods the account can call
+	contract := common.HexToAddress("0x1932c48b2bf8102ba33b4a6b545c32236e342f34")
+	types.RoleInfoMap.AddRolePolicy("ORG", "TRANSACTOR", types.RolePolicy{Contract: contract, Selector: []byte{0xa9, 0x05, 0x9c, 0xbb}})
+	defer types.RoleInfoMap.RemoveRolePolicy("ORG", "TRANSACTOR", types.RolePolicy{Contract: contract, Selector: []byte{0xa9, 0x05, 0x9c, 0xbb}})
+
+	call := func(nonce uint64, to common.Address, data []byte) *types.Transaction {
+		tx, _ := types.SignTx(types.NewTransaction(nonce, to, common.Big0, 100000, common.Big0, data), types.HomesteadSigner{}, key)
+		return tx
+	}
+	if err := pool.AddRemote(call(1, common.Address{}, nil)); err != ErrNotInRolePolicy {
+		t.Error("expected", ErrNotInRolePolicy, "; got", err)
+	}
+	if err := pool.AddRemote(call(1, contract, []byte{0x09, 0x5e, 0xa7, 0xb3})); err != ErrNotInRolePolicy {
+		t.Error("expected", ErrNotInRolePolicy, "; got", err)
+	}
+	if err := pool.AddRemote(call(1, contract, []byte{0xa9, 0x05, 0x9c, 0xbb, 0x01})); err != nil {
+		t.Error("expected", nil, "; got", err)
+	}
+
+	// Accounts with a lapsed role are read only
+	types.AcctInfoMap.SetAccountExpiry(from, 1, 0)
+	if err := pool.AddRemote(call(2, contract, []byte{0xa9, 0x05, 0x9c, 0xbb, 0x02})); err != ErrReadOnlyAccount {
+		t.Error("expected", ErrReadOnlyAccount, "; got", err)
+	}
+}
+
+// Tests that the expiry time of account roles is checked against the header
+// time in seconds, for raft headers in nanoseconds too.
+func TestAccessTime(t *testing.T) {
+	tests := []struct {
+		time *big.Int
+		want uint64
+	}{
+		{big.NewInt(0), 0},
+		{big.NewInt(1546300800), 1546300800},
+		{new(big.Int).Mul(big.NewInt(1546300800), big.NewInt(int64(time.Second))), 1546300800},
+	}
+	for i, tt := range tests {
+		if have := AccessTime(&types.Header{Time: tt.time}); have != tt.want {
+			t.Errorf("test %d: access time mismatch: have %d, want %d", i, have, tt.want)
+		}
+	}
+}
+
 func TestValidateTx_whenValueZeroTransferForPrivateTransaction(t *testing.T) {
 	pool, key := setupQuorumTxPool()
 	defer pool.Stop()
@@ -1192,6 +1268,274 @@ func TestTransactionPendingGlobalLimiting(t *testing.T) {
 	}
 }
 
+// Tests that the senders of a lane have their own executable slots, neither
+// limited by nor limiting the slots of the default lane.
+func TestTransactionPendingLaneLimiting(t *testing.T) {
+	t.Parallel()
+
+	// Create the pool to test the limit enforcement with
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(ethdb.NewMemDatabase()))
+	blockchain := &testBlockChain{statedb, statedb, 1000000, new(event.Feed)}
+
+	laneKey, _ := crypto.GenerateKey()
+	laneAddr := crypto.PubkeyToAddress(laneKey.PublicKey)
+
+	config := testTxPoolConfig
+	config.AccountSlots = 2
+	config.GlobalSlots = 8
+	config.Lanes = []TxLane{{Name: "system", Senders: []common.Address{laneAddr}, AccountSlots: 20, GlobalSlots: 20}}
+
+	pool := NewTxPool(config, params.TestChainConfig, blockchain)
+	defer pool.Stop()
+
+	// Create a number of test accounts and fund them
+	keys := []*ecdsa.PrivateKey{laneKey}
+	for i := 0; i < 2; i++ {
+		key, _ := crypto.GenerateKey()
+		keys = append(keys, key)
+	}
+	for _, key := range keys {
+		pool.currentState.AddBalance(crypto.PubkeyToAddress(key.PublicKey), big.NewInt(1000000))
+	}
+	// Generate and queue a batch of transactions, the lane sender exceeding its lane
+	txs := types.Transactions{}
+	for i, key := range keys {
+		count := 10
+		if i == 0 {
+			count = 30
+		}
+		for nonce := 0; nonce < count; nonce++ {
+			txs = append(txs, transaction(uint64(nonce), 100000, key))
+		}
+	}
+	pool.AddRemotes(txs)
+
+	// Verify that the limits of both lanes have been enforced separately
+	if pending := pool.pending[laneAddr].Len(); pending != 20 {
+		t.Errorf("lane pending transactions mismatch: have %d, want %d", pending, 20)
+	}
+	pending := 0
+	for addr, list := range pool.pending {
+		if addr != laneAddr {
+			pending += list.Len()
+		}
+	}
+	if pending > int(config.GlobalSlots) {
+		t.Errorf("default lane pending transactions overflow allowance: %d > %d", pending, config.GlobalSlots)
+	}
+	if err := validateTxPoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+}
+
+// Tests that pending transactions are split by the lane of their sender, with
+// senders matched before orgs and sub orgs in the lanes of their org.
+func TestTransactionPoolSplitLanes(t *testing.T) {
+	t.Parallel()
+
+	var (
+		sender    = common.HexToAddress("0x7a7e000000000000000000000000000000000001")
+		orgMember = common.HexToAddress("0x7a7e000000000000000000000000000000000002")
+		subMember = common.HexToAddress("0x7a7e000000000000000000000000000000000003")
+		otherOrg  = common.HexToAddress("0x7a7e000000000000000000000000000000000004")
+		remote    = common.HexToAddress("0x7a7e000000000000000000000000000000000005")
+	)
+	types.AcctInfoMap.UpsertAccount("LANEORG", "ROLE", orgMember, false, types.AcctActive)
+	types.AcctInfoMap.UpsertAccount("LANEORG.SUB", "ROLE", subMember, false, types.AcctActive)
+	types.AcctInfoMap.UpsertAccount("LANEORGX", "ROLE", otherOrg, false, types.AcctActive)
+	types.AcctInfoMap.UpsertAccount("LANEORG", "ROLE", sender, false, types.AcctActive)
+
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(ethdb.NewMemDatabase()))
+	blockchain := &testBlockChain{statedb, statedb, 1000000, new(event.Feed)}
+
+	config := testTxPoolConfig
+	config.Lanes = []TxLane{
+		{Name: "oracles", Senders: []common.Address{sender}},
+		{Orgs: []string{"LANEORG"}},
+	}
+	pool := NewTxPool(config, params.TestChainConfig, blockchain)
+	defer pool.Stop()
+
+	if name := pool.config.Lanes[1].Name; name != "lane1" {
+		t.Errorf("unnamed lane name mismatch: have %s, want lane1", name)
+	}
+	if slots := pool.config.Lanes[0].GlobalSlots; slots != config.GlobalSlots {
+		t.Errorf("lane global slots mismatch: have %d, want %d", slots, config.GlobalSlots)
+	}
+	pending := make(map[common.Address]types.Transactions)
+	for _, addr := range []common.Address{sender, orgMember, subMember, otherOrg, remote} {
+		pending[addr] = types.Transactions{}
+	}
+	lanes := pool.SplitLanes(pending)
+	if len(lanes) != 3 {
+		t.Fatalf("lane count mismatch: have %d, want 3", len(lanes))
+	}
+	for i, want := range [][]common.Address{{sender}, {orgMember, subMember}, {otherOrg, remote}} {
+		if len(lanes[i]) != len(want) {
+			t.Errorf("lane %d: senders mismatch: have %d, want %d", i, len(lanes[i]), len(want))
+		}
+		for _, addr := range want {
+			if _, ok := lanes[i][addr]; !ok {
+				t.Errorf("lane %d: sender %x missing", i, addr)
+			}
+		}
+	}
+}
+
+// Tests that remote senders exceeding their admission rate are rejected, while
+// local transactions are admitted regardless.
+func TestTransactionSenderRateLimiting(t *testing.T) {
+	t.Parallel()
+
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(ethdb.NewMemDatabase()))
+	blockchain := &testBlockChain{statedb, statedb, 1000000, new(event.Feed)}
+
+	config := testTxPoolConfig
+	config.SenderRate = 0.001
+	config.SenderBurst = 2
+
+	pool := NewTxPool(config, params.TestChainConfig, blockchain)
+	defer pool.Stop()
+
+	key, _ := crypto.GenerateKey()
+	pool.currentState.AddBalance(crypto.PubkeyToAddress(key.PublicKey), big.NewInt(1000000))
+
+	for nonce := uint64(0); nonce < 2; nonce++ {
+		if err := pool.AddRemote(transaction(nonce, 100000, key)); err != nil {
+			t.Fatalf("tx %d: failed to add transaction within the burst: %v", nonce, err)
+		}
+	}
+	if err := pool.AddRemote(transaction(2, 100000, key)); err != ErrSenderRateLimited {
+		t.Fatalf("transaction over the burst: have %v, want %v", err, ErrSenderRateLimited)
+	}
+	if err := pool.AddLocal(transaction(2, 100000, key)); err != nil {
+		t.Fatalf("failed to add local transaction: %v", err)
+	}
+	// The sender is local now, its remote transactions aren't limited either
+	if err := pool.AddRemote(transaction(3, 100000, key)); err != nil {
+		t.Fatalf("failed to add remote transaction of a local sender: %v", err)
+	}
+	if pending, _ := pool.Stats(); pending != 4 {
+		t.Fatalf("pending transactions mismatched: have %d, want %d", pending, 4)
+	}
+}
+
+// Tests that the new transactions peers relay over their admission rate are
+// dropped, while relaying known transactions is free, and that peers which are
+// consistently over their rate are rejected.
+func TestTransactionPeerRateLimiting(t *testing.T) {
+	t.Parallel()
+
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(ethdb.NewMemDatabase()))
+	blockchain := &testBlockChain{statedb, statedb, 1000000, new(event.Feed)}
+
+	config := testTxPoolConfig
+	config.PeerRate = 0.001
+	config.PeerBurst = 3
+
+	pool := NewTxPool(config, params.TestChainConfig, blockchain)
+	defer pool.Stop()
+
+	key, _ := crypto.GenerateKey()
+	pool.currentState.AddBalance(crypto.PubkeyToAddress(key.PublicKey), big.NewInt(1000000))
+
+	txs := types.Transactions{}
+	for nonce := uint64(0); nonce < 8; nonce++ {
+		txs = append(txs, transaction(nonce, 100000, key))
+	}
+	// A batch larger than the burst is admitted up to it
+	errs, err := pool.AddRemotesFrom("peer", txs[:4])
+	if err != nil {
+		t.Fatalf("peer rejected for a single batch over the burst: %v", err)
+	}
+	for i, err := range errs {
+		if i < 3 && err != nil {
+			t.Fatalf("tx %d: failed to add transaction within the burst: %v", i, err)
+		}
+		if i >= 3 && err != ErrPeerRateLimited {
+			t.Fatalf("tx %d: transaction over the burst: have %v, want %v", i, err, ErrPeerRateLimited)
+		}
+	}
+	if pending, _ := pool.Stats(); pending != 3 {
+		t.Fatalf("pending transactions mismatched: have %d, want %d", pending, 3)
+	}
+	// Known transactions cost nothing
+	if errs, err := pool.AddRemotesFrom("peer", txs[:3]); err != nil || errs[0] == ErrPeerRateLimited {
+		t.Fatalf("failed to relay known transactions: %v, %v", errs, err)
+	}
+	// Other peers have their own allowance
+	if errs, err := pool.AddRemotesFrom("other", txs[3:4]); err != nil || errs[0] != nil {
+		t.Fatalf("failed to add transaction from another peer: %v, %v", errs, err)
+	}
+	// Peers staying over their rate are rejected
+	if _, err := pool.AddRemotesFrom("peer", txs[4:5]); err != nil {
+		t.Fatalf("peer rejected after two batches over the burst: %v", err)
+	}
+	if _, err := pool.AddRemotesFrom("peer", txs[4:5]); err != ErrPeerRateLimited {
+		t.Fatalf("peer consistently over the rate: have %v, want %v", err, ErrPeerRateLimited)
+	}
+	if pending, _ := pool.Stats(); pending != 4 {
+		t.Fatalf("pending transactions mismatched: have %d, want %d", pending, 4)
+	}
+}
+
+// Tests that the token buckets refill at the configured rate up to the burst.
+func TestTransactionRateLimiterRefill(t *testing.T) {
+	clock := &mclock.Simulated{}
+	limiter := newTxRateLimiter(2, 4, clock)
+
+	if !limiter.allow("key", 4) {
+		t.Fatalf("burst not admitted")
+	}
+	if limiter.allow("key", 1) {
+		t.Fatalf("admitted over the burst")
+	}
+	clock.Run(time.Second)
+	if limiter.allow("key", 3) {
+		t.Fatalf("admitted more than refilled")
+	}
+	if !limiter.allow("key", 2) {
+		t.Fatalf("refill not admitted")
+	}
+	// Buckets are capped at the burst and pruned once full
+	clock.Run(time.Minute)
+	limiter.prune()
+	if len(limiter.buckets) != 0 {
+		t.Fatalf("full bucket not pruned")
+	}
+	if limiter.allow("key", 5) {
+		t.Fatalf("admitted over the burst after refill")
+	}
+	if !limiter.allow("key", 4) {
+		t.Fatalf("burst not admitted after refill")
+	}
+}
+
+// Tests that takes get the tokens available, and that keys falling short on
+// several takes in a row are reported as over their rate.
+func TestTransactionRateLimiterTake(t *testing.T) {
+	clock := &mclock.Simulated{}
+	limiter := newTxRateLimiter(1, 4, clock)
+
+	for i, want := range []int{4, 0, 0} {
+		taken, limited := limiter.take("key", 6)
+		if taken != want {
+			t.Fatalf("take %d: tokens mismatch: have %d, want %d", i, taken, want)
+		}
+		if limited != (i == maxRateStrikes-1) {
+			t.Fatalf("take %d: limited mismatch: have %v", i, limited)
+		}
+	}
+	// A take within the refilled allowance clears the strikes
+	clock.Run(2 * time.Second)
+	if taken, limited := limiter.take("key", 2); taken != 2 || limited {
+		t.Fatalf("take within the refill: have %d, %v, want %d, %v", taken, limited, 2, false)
+	}
+	if taken, limited := limiter.take("key", 1); taken != 0 || limited {
+		t.Fatalf("take after strikes cleared: have %d, %v, want %d, %v", taken, limited, 0, false)
+	}
+}
+
 // Tests that if transactions start being capped, transactions are also removed from 'all'
 func TestTransactionCapClearsFromAll(t *testing.T) {
 	t.Parallel()
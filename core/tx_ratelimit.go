@@ -0,0 +1,134 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/quorum/common/mclock"
+)
+
+// maxRateStrikes is the number of consecutive takes falling short of tokens
+// after which a key is considered to be consistently over its rate.
+const maxRateStrikes = 3
+
+// tokenBucket is the admission allowance of a single sender or peer.
+type tokenBucket struct {
+	tokens  float64        // Transactions which can be admitted at the last update
+	last    mclock.AbsTime // Time of the last update
+	strikes int            // Consecutive takes which fell short of tokens
+}
+
+// txRateLimiter admits transactions at a sustained rate per key with bursts,
+// using a token bucket for each key. A zero rate admits everything.
+//
+// In Quorum networks the gas price is usually zero, leaving the pool without an
+// economic defence against a client flooding it.
+type txRateLimiter struct {
+	rate  float64 // Tokens added per second
+	burst float64 // Capacity of the buckets
+	clock mclock.Clock
+
+	buckets map[interface{}]*tokenBucket
+	lock    sync.Mutex
+}
+
+func newTxRateLimiter(rate float64, burst uint64, clock mclock.Clock) *txRateLimiter {
+	return &txRateLimiter{
+		rate:    rate,
+		burst:   float64(burst),
+		clock:   clock,
+		buckets: make(map[interface{}]*tokenBucket),
+	}
+}
+
+// enabled reports whether the limiter limits anything.
+func (l *txRateLimiter) enabled() bool {
+	return l.rate > 0
+}
+
+// allow takes n tokens from the bucket of the key and reports whether it had
+// enough of them. Nothing is taken if it hadn't.
+func (l *txRateLimiter) allow(key interface{}, n int) bool {
+	if !l.enabled() || n == 0 {
+		return true
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	bucket := l.refill(key)
+	if bucket.tokens < float64(n) {
+		return false
+	}
+	bucket.tokens -= float64(n)
+	return true
+}
+
+// take takes up to n tokens from the bucket of the key and returns how many it
+// had. It also reports whether the last maxRateStrikes takes of the key all fell
+// short, i.e. whether the key is consistently over its rate.
+func (l *txRateLimiter) take(key interface{}, n int) (int, bool) {
+	if !l.enabled() || n == 0 {
+		return n, false
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	bucket := l.refill(key)
+	taken := n
+	if available := int(bucket.tokens); available < n {
+		taken = available
+	}
+	bucket.tokens -= float64(taken)
+	if taken < n {
+		bucket.strikes++
+	} else {
+		bucket.strikes = 0
+	}
+	return taken, bucket.strikes >= maxRateStrikes
+}
+
+// refill adds the tokens earned since the last update to the bucket of the key,
+// creating a full one if the key is new.
+func (l *txRateLimiter) refill(key interface{}) *tokenBucket {
+	now := l.clock.Now()
+	bucket := l.buckets[key]
+	if bucket == nil {
+		bucket = &tokenBucket{tokens: l.burst, last: now}
+		l.buckets[key] = bucket
+		return bucket
+	}
+	bucket.tokens += time.Duration(now-bucket.last).Seconds() * l.rate
+	if bucket.tokens > l.burst {
+		bucket.tokens = l.burst
+	}
+	bucket.last = now
+	return bucket
+}
+
+// prune drops the buckets which refilled, they are recreated full on demand.
+func (l *txRateLimiter) prune() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	for key := range l.buckets {
+		if l.refill(key).tokens >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+}
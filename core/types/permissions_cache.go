@@ -1,12 +1,18 @@
 package types
 
 import (
+	"bytes"
 	"math/big"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/ethereum/quorum/p2p/enode"
 
 	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/common/hexutil"
+	"github.com/ethereum/quorum/log"
+	"github.com/ethereum/quorum/metrics"
 	lru "github.com/hashicorp/golang-lru"
 )
 
@@ -74,6 +80,29 @@ type RoleInfo struct {
 	IsAdmin bool       `json:"isAdmin"`
 	Access  AccessType `json:"access"`
 	Active  bool       `json:"active"`
+
+	// Policies restrict the contracts the accounts of the role can call,
+	// roles without policies can call any contract.
+	Policies []RolePolicy `json:"policies,omitempty"`
+}
+
+// RolePolicy allows a contract method to be called. A zero selector allows
+// any method of the contract.
+type RolePolicy struct {
+	Contract common.Address `json:"contract"`
+	Selector hexutil.Bytes  `json:"selector"`
+}
+
+var zeroSelector = make([]byte, 4)
+
+// Allows reports whether the policy allows calling the contract with the
+// given method selector. Without a selector, as for private transactions
+// whose payload is not visible, only the contract is checked.
+func (p RolePolicy) Allows(to common.Address, selector []byte) bool {
+	if p.Contract != to {
+		return false
+	}
+	return selector == nil || bytes.Equal(p.Selector, zeroSelector) || bytes.Equal(p.Selector, selector)
 }
 
 type AccountInfo struct {
@@ -82,6 +111,20 @@ type AccountInfo struct {
 	AcctId     common.Address `json:"acctId"`
 	IsOrgAdmin bool           `json:"isOrgAdmin"`
 	Status     AcctStatus     `json:"status"`
+
+	// ExpiryBlock and ExpiryTime are the block number and unix time from which
+	// the role of the account lapses, zero if it doesn't expire.
+	ExpiryBlock uint64 `json:"expiryBlock,omitempty"`
+	ExpiryTime  uint64 `json:"expiryTime,omitempty"`
+}
+
+// Expired reports whether the role of the account lapsed for a transaction in
+// the given block. A zero time skips the check of ExpiryTime.
+func (a *AccountInfo) Expired(number *big.Int, time uint64) bool {
+	if a.ExpiryBlock != 0 && number != nil && number.Uint64() >= a.ExpiryBlock {
+		return true
+	}
+	return a.ExpiryTime != 0 && time != 0 && time >= a.ExpiryTime
 }
 
 type OrgDetailInfo struct {
@@ -128,41 +171,160 @@ type AccountKey struct {
 	AcctId common.Address
 }
 
+const (
+	// maxPendingLookups is the maximum number of entries of a cache looked up
+	// in the permission contracts at once, further lookups are queued.
+	maxPendingLookups = 64
+
+	// cachedLookupTimeout is how long the transaction pool and block
+	// production wait for the lookup of a missed entry.
+	cachedLookupTimeout = 250 * time.Millisecond
+)
+
+// permissionCache is an LRU of permission entries. As long as nothing was
+// evicted the cache holds every entry of the permission contracts, afterwards a
+// miss has to be looked up in the contracts.
+type permissionCache struct {
+	c       *lru.Cache
+	evicted int32
+	hit     metrics.Meter
+	miss    metrics.Meter
+
+	funcLock sync.RWMutex // protects the populateCacheFunc of the cache
+
+	pending     map[interface{}]chan struct{} // keys being looked up, closed once done
+	pendingLock sync.Mutex
+	slots       chan struct{} // limits the lookups running at once
+}
+
+func newPermissionCache(name string, size int) *permissionCache {
+	pc := &permissionCache{
+		hit:     metrics.NewRegisteredMeter("permission/cache/"+name+"/hit", nil),
+		miss:    metrics.NewRegisteredMeter("permission/cache/"+name+"/miss", nil),
+		pending: make(map[interface{}]chan struct{}),
+		slots:   make(chan struct{}, maxPendingLookups),
+	}
+	pc.c, _ = lru.NewWithEvict(size, pc.onEvict)
+	return pc
+}
+
+func (pc *permissionCache) onEvict(key, value interface{}) {
+	atomic.StoreInt32(&pc.evicted, 1)
+}
+
+// resize replaces the LRU, keeping the most recently used entries.
+func (pc *permissionCache) resize(size int) {
+	c, _ := lru.NewWithEvict(size, pc.onEvict)
+	for _, k := range pc.c.Keys() {
+		if v, ok := pc.c.Peek(k); ok {
+			c.Add(k, v)
+		}
+	}
+	pc.c = c
+}
+
+func (pc *permissionCache) get(key interface{}) (interface{}, bool) {
+	v, ok := pc.c.Get(key)
+	if ok {
+		pc.hit.Mark(1)
+	} else {
+		pc.miss.Mark(1)
+	}
+	return v, ok
+}
+
+// add adds an entry looked up after a miss and returns the cached entry, which
+// differs if the entry was updated in the meantime.
+func (pc *permissionCache) add(key, value interface{}) interface{} {
+	if ok, _ := pc.c.ContainsOrAdd(key, value); ok {
+		if v, ok := pc.c.Peek(key); ok {
+			return v
+		}
+	}
+	return value
+}
+
+// needsLookup reports whether a miss may be an evicted entry.
+func (pc *permissionCache) needsLookup() bool {
+	return atomic.LoadInt32(&pc.evicted) == 1
+}
+
+// lookupWait runs the lookup of a missed key in the background and waits a
+// bounded time for it, for the paths which must not block on the permission
+// contracts. Concurrent misses of a key share the lookup, lookups beyond
+// maxPendingLookups are queued. It returns the entry cached by the lookup.
+func (pc *permissionCache) lookupWait(key interface{}, lookup func()) (interface{}, bool) {
+	if !pc.needsLookup() {
+		return nil, false
+	}
+	pc.pendingLock.Lock()
+	done, ok := pc.pending[key]
+	if !ok {
+		done = make(chan struct{})
+		pc.pending[key] = done
+		go func() {
+			pc.slots <- struct{}{}
+			lookup()
+			<-pc.slots
+
+			pc.pendingLock.Lock()
+			delete(pc.pending, key)
+			pc.pendingLock.Unlock()
+			close(done)
+		}()
+	}
+	pc.pendingLock.Unlock()
+
+	timeout := time.NewTimer(cachedLookupTimeout)
+	defer timeout.Stop()
+	select {
+	case <-done:
+		return pc.c.Peek(key)
+	case <-timeout.C:
+		log.Warn("Permission lookup timed out", "key", key, "timeout", cachedLookupTimeout)
+		return nil, false
+	}
+}
+
 type OrgCache struct {
-	c   *lru.Cache
-	mux sync.Mutex
+	*permissionCache
+	mux               sync.Mutex
+	populateCacheFunc func(orgId string) (*OrgInfo, error)
+
+	// subOrgs indexes the sub orgs of every org by its full id, it is not
+	// subject to evictions so that the sub orgs of looked up orgs are known
+	subOrgs map[string][]string
 }
 
 type NodeCache struct {
-	c *lru.Cache
+	*permissionCache
+	populateCacheFunc func(url string) (*NodeInfo, error)
 }
 
 type RoleCache struct {
-	c *lru.Cache
+	*permissionCache
+	populateCacheFunc func(orgId, roleId string) (*RoleInfo, error)
 }
 
 type AcctCache struct {
-	c *lru.Cache
+	*permissionCache
+	populateCacheFunc func(acct common.Address) (*AccountInfo, error)
 }
 
 func NewOrgCache() *OrgCache {
-	c, _ := lru.New(defaultOrgMapLimit)
-	return &OrgCache{c, sync.Mutex{}}
+	return &OrgCache{permissionCache: newPermissionCache("org", DefaultOrgMapLimit), subOrgs: make(map[string][]string)}
 }
 
 func NewNodeCache() *NodeCache {
-	c, _ := lru.New(defaultNodeMapLimit)
-	return &NodeCache{c}
+	return &NodeCache{permissionCache: newPermissionCache("node", DefaultNodeMapLimit)}
 }
 
 func NewRoleCache() *RoleCache {
-	c, _ := lru.New(defaultRoleMapLimit)
-	return &RoleCache{c}
+	return &RoleCache{permissionCache: newPermissionCache("role", DefaultRoleMapLimit)}
 }
 
 func NewAcctCache() *AcctCache {
-	c, _ := lru.New(defaultAccountMapLimit)
-	return &AcctCache{c}
+	return &AcctCache{permissionCache: newPermissionCache("account", DefaultAccountMapLimit)}
 }
 
 var syncStarted = false
@@ -172,16 +334,28 @@ var QIP714BlockReached = false
 var networkAdminRole string
 var orgAdminRole string
 
-const defaultOrgMapLimit = 2000
-const defaultRoleMapLimit = 2500
-const defaultNodeMapLimit = 1000
-const defaultAccountMapLimit = 6000
+// default number of entries of the permission caches
+const (
+	DefaultOrgMapLimit     = 2000
+	DefaultRoleMapLimit    = 2500
+	DefaultNodeMapLimit    = 1000
+	DefaultAccountMapLimit = 6000
+)
 
 var OrgInfoMap = NewOrgCache()
 var NodeInfoMap = NewNodeCache()
 var RoleInfoMap = NewRoleCache()
 var AcctInfoMap = NewAcctCache()
 
+// SetCacheSizes sets the number of entries of the permission caches. It has
+// to be called before the caches are used.
+func SetCacheSizes(orgs, nodes, roles, accounts int) {
+	OrgInfoMap.resize(orgs)
+	NodeInfoMap.resize(nodes)
+	RoleInfoMap.resize(roles)
+	AcctInfoMap.resize(accounts)
+}
+
 func (pc *PermissionConfig) IsEmpty() bool {
 	return pc.InterfAddress == common.HexToAddress("0x0")
 }
@@ -211,6 +385,13 @@ func GetDefaults() (string, string, AccessType) {
 	return networkAdminRole, orgAdminRole, DefaultAccess
 }
 
+// PopulateCacheFunc sets the function looking up orgs missing from the cache.
+func (o *OrgCache) PopulateCacheFunc(cf func(orgId string) (*OrgInfo, error)) {
+	o.funcLock.Lock()
+	defer o.funcLock.Unlock()
+	o.populateCacheFunc = cf
+}
+
 func (o *OrgCache) UpsertOrg(orgId, parentOrg, ultimateParent string, level *big.Int, status OrgStatus) {
 	defer o.mux.Unlock()
 	o.mux.Lock()
@@ -219,20 +400,28 @@ func (o *OrgCache) UpsertOrg(orgId, parentOrg, ultimateParent string, level *big
 		key = OrgKey{OrgId: orgId}
 	} else {
 		key = OrgKey{OrgId: parentOrg + "." + orgId}
-		pkey := OrgKey{OrgId: parentOrg}
-		if ent, ok := o.c.Get(pkey); ok {
-			porg := ent.(*OrgInfo)
-			if !containsKey(porg.SubOrgList, key.OrgId) {
-				porg.SubOrgList = append(porg.SubOrgList, key.OrgId)
-				o.c.Add(pkey, porg)
+		if !containsKey(o.subOrgs[parentOrg], key.OrgId) {
+			o.subOrgs[parentOrg] = append(o.subOrgs[parentOrg], key.OrgId)
+			pkey := OrgKey{OrgId: parentOrg}
+			if ent, ok := o.c.Peek(pkey); ok {
+				porg := *ent.(*OrgInfo)
+				porg.SubOrgList = o.subOrgList(parentOrg)
+				o.c.Add(pkey, &porg)
 			}
 		}
 	}
-
-	norg := &OrgInfo{orgId, key.OrgId, parentOrg, ultimateParent, level, nil, status}
+	norg := &OrgInfo{orgId, key.OrgId, parentOrg, ultimateParent, level, o.subOrgList(key.OrgId), status}
 	o.c.Add(key, norg)
 }
 
+// subOrgList returns a copy of the indexed sub orgs of the org.
+func (o *OrgCache) subOrgList(orgId string) []string {
+	if subs := o.subOrgs[orgId]; len(subs) > 0 {
+		return append([]string{}, subs...)
+	}
+	return nil
+}
+
 func containsKey(s []string, e string) bool {
 	for _, a := range s {
 		if a == e {
@@ -243,25 +432,72 @@ func containsKey(s []string, e string) bool {
 }
 
 func (o *OrgCache) GetOrg(orgId string) *OrgInfo {
-	defer o.mux.Unlock()
 	o.mux.Lock()
 	key := OrgKey{OrgId: orgId}
-	if ent, ok := o.c.Get(key); ok {
+	ent, ok := o.get(key)
+	o.mux.Unlock()
+	if ok {
+		return ent.(*OrgInfo)
+	}
+	return o.lookup(orgId)
+}
+
+// getOrgCached returns the org if it is cached, a miss is looked up with a
+// bounded wait.
+func (o *OrgCache) getOrgCached(orgId string) *OrgInfo {
+	o.mux.Lock()
+	key := OrgKey{OrgId: orgId}
+	ent, ok := o.get(key)
+	o.mux.Unlock()
+	if !ok {
+		ent, ok = o.lookupWait(key, func() { o.lookup(orgId) })
+	}
+	if ok {
 		return ent.(*OrgInfo)
 	}
 	return nil
 }
 
+// lookup looks up an org missing from the cache in the permission contracts,
+// its sub orgs are taken from the index.
+func (o *OrgCache) lookup(orgId string) *OrgInfo {
+	if !o.needsLookup() {
+		return nil
+	}
+	o.funcLock.RLock()
+	defer o.funcLock.RUnlock()
+	if o.populateCacheFunc == nil {
+		return nil
+	}
+	org, err := o.populateCacheFunc(orgId)
+	if err != nil || org == nil {
+		log.Debug("Org not found in the permission contracts", "org", orgId, "err", err)
+		return nil
+	}
+	o.mux.Lock()
+	defer o.mux.Unlock()
+	org.SubOrgList = o.subOrgList(orgId)
+	return o.add(OrgKey{OrgId: orgId}, org).(*OrgInfo)
+}
+
+// GetOrgList returns the orgs held by the cache.
 func (o *OrgCache) GetOrgList() []OrgInfo {
-	olist := make([]OrgInfo, len(o.c.Keys()))
-	for i, k := range o.c.Keys() {
-		v, _ := o.c.Get(k)
-		vp := v.(*OrgInfo)
-		olist[i] = *vp
+	olist := make([]OrgInfo, 0, o.c.Len())
+	for _, k := range o.c.Keys() {
+		if v, ok := o.c.Peek(k); ok {
+			olist = append(olist, *v.(*OrgInfo))
+		}
 	}
 	return olist
 }
 
+// PopulateCacheFunc sets the function looking up nodes missing from the cache.
+func (n *NodeCache) PopulateCacheFunc(cf func(url string) (*NodeInfo, error)) {
+	n.funcLock.Lock()
+	defer n.funcLock.Unlock()
+	n.populateCacheFunc = cf
+}
+
 func (n *NodeCache) UpsertNode(orgId string, url string, status NodeStatus) {
 	key := NodeKey{OrgId: orgId, Url: url}
 	n.c.Add(key, &NodeInfo{orgId, url, status})
@@ -271,52 +507,127 @@ func (n *NodeCache) GetNodeByUrl(url string) *NodeInfo {
 	for _, k := range n.c.Keys() {
 		ent := k.(NodeKey)
 		if ent.Url == url {
-			v, _ := n.c.Get(ent)
-			return v.(*NodeInfo)
+			if v, ok := n.c.Get(ent); ok {
+				n.hit.Mark(1)
+				return v.(*NodeInfo)
+			}
 		}
 	}
-	return nil
+	n.miss.Mark(1)
+	if !n.needsLookup() {
+		return nil
+	}
+	n.funcLock.RLock()
+	defer n.funcLock.RUnlock()
+	if n.populateCacheFunc == nil {
+		return nil
+	}
+	node, err := n.populateCacheFunc(url)
+	if err != nil || node == nil {
+		log.Debug("Node not found in the permission contracts", "url", url, "err", err)
+		return nil
+	}
+	return n.add(NodeKey{OrgId: node.OrgId, Url: node.Url}, node).(*NodeInfo)
 }
 
+// GetNodeList returns the nodes held by the cache.
 func (n *NodeCache) GetNodeList() []NodeInfo {
-	olist := make([]NodeInfo, len(n.c.Keys()))
-	for i, k := range n.c.Keys() {
-		v, _ := n.c.Get(k)
-		vp := v.(*NodeInfo)
-		olist[i] = *vp
+	olist := make([]NodeInfo, 0, n.c.Len())
+	for _, k := range n.c.Keys() {
+		if v, ok := n.c.Peek(k); ok {
+			olist = append(olist, *v.(*NodeInfo))
+		}
 	}
 	return olist
 }
 
+// PopulateCacheFunc sets the function looking up accounts missing from the cache.
+func (a *AcctCache) PopulateCacheFunc(cf func(acct common.Address) (*AccountInfo, error)) {
+	a.funcLock.Lock()
+	defer a.funcLock.Unlock()
+	a.populateCacheFunc = cf
+}
+
+// UpsertAccount adds or updates the account. The expiry of the account is kept
+// unless its role changes.
 func (a *AcctCache) UpsertAccount(orgId string, role string, acct common.Address, orgAdmin bool, status AcctStatus) {
 	key := AccountKey{acct}
-	a.c.Add(key, &AccountInfo{orgId, role, acct, orgAdmin, status})
+	ac := &AccountInfo{OrgId: orgId, RoleId: role, AcctId: acct, IsOrgAdmin: orgAdmin, Status: status}
+	if v, ok := a.c.Peek(key); ok {
+		if old := v.(*AccountInfo); old.OrgId == orgId && old.RoleId == role {
+			ac.ExpiryBlock, ac.ExpiryTime = old.ExpiryBlock, old.ExpiryTime
+		}
+	}
+	a.c.Add(key, ac)
+}
+
+// SetAccountExpiry sets the expiry of the role of a cached account, zero values
+// clear it.
+func (a *AcctCache) SetAccountExpiry(acct common.Address, expiryBlock, expiryTime uint64) {
+	if ac := a.GetAccount(acct); ac != nil {
+		updated := *ac
+		updated.ExpiryBlock, updated.ExpiryTime = expiryBlock, expiryTime
+		a.c.Add(AccountKey{acct}, &updated)
+	}
 }
 
 func (a *AcctCache) GetAccount(acct common.Address) *AccountInfo {
-	if v, ok := a.c.Get(AccountKey{acct}); ok {
+	if v, ok := a.get(AccountKey{acct}); ok {
+		return v.(*AccountInfo)
+	}
+	return a.lookup(acct)
+}
+
+// GetAccountCached returns the account if it is cached. A miss is looked up
+// with a bounded wait, it is meant for the transaction pool and block
+// production which must not block on the permission contracts.
+func (a *AcctCache) GetAccountCached(acct common.Address) *AccountInfo {
+	key := AccountKey{acct}
+	v, ok := a.get(key)
+	if !ok {
+		v, ok = a.lookupWait(key, func() { a.lookup(acct) })
+	}
+	if ok {
 		return v.(*AccountInfo)
 	}
 	return nil
 }
 
+// lookup looks up an account missing from the cache in the permission
+// contracts.
+func (a *AcctCache) lookup(acct common.Address) *AccountInfo {
+	if !a.needsLookup() {
+		return nil
+	}
+	a.funcLock.RLock()
+	defer a.funcLock.RUnlock()
+	if a.populateCacheFunc == nil {
+		return nil
+	}
+	ac, err := a.populateCacheFunc(acct)
+	if err != nil || ac == nil {
+		log.Debug("Account not found in the permission contracts", "account", acct, "err", err)
+		return nil
+	}
+	return a.add(AccountKey{acct}, ac).(*AccountInfo)
+}
+
+// GetAcctList returns the accounts held by the cache.
 func (a *AcctCache) GetAcctList() []AccountInfo {
-	alist := make([]AccountInfo, len(a.c.Keys()))
-	for i, k := range a.c.Keys() {
-		v, _ := a.c.Get(k)
-		vp := v.(*AccountInfo)
-		alist[i] = *vp
+	alist := make([]AccountInfo, 0, a.c.Len())
+	for _, k := range a.c.Keys() {
+		if v, ok := a.c.Peek(k); ok {
+			alist = append(alist, *v.(*AccountInfo))
+		}
 	}
 	return alist
 }
 
 func (a *AcctCache) GetAcctListOrg(orgId string) []AccountInfo {
 	var alist []AccountInfo
-	for _, k := range a.c.Keys() {
-		v, _ := a.c.Get(k)
-		vp := v.(*AccountInfo)
+	for _, vp := range a.GetAcctList() {
 		if vp.OrgId == orgId {
-			alist = append(alist, *vp)
+			alist = append(alist, vp)
 		}
 	}
 	return alist
@@ -324,72 +635,359 @@ func (a *AcctCache) GetAcctListOrg(orgId string) []AccountInfo {
 
 func (a *AcctCache) GetAcctListRole(orgId, roleId string) []AccountInfo {
 	var alist []AccountInfo
-	for _, k := range a.c.Keys() {
-		v, _ := a.c.Get(k)
-		vp := v.(*AccountInfo)
-
-		if vp.RoleId == roleId && (vp.OrgId == orgId || OrgInfoMap.GetOrg(vp.OrgId).UltimateParent == orgId) {
-			alist = append(alist, *vp)
+	for _, vp := range a.GetAcctList() {
+		if vp.RoleId != roleId {
+			continue
+		}
+		if vp.OrgId == orgId {
+			alist = append(alist, vp)
+		} else if o := OrgInfoMap.GetOrg(vp.OrgId); o != nil && o.UltimateParent == orgId {
+			alist = append(alist, vp)
 		}
 	}
 	return alist
 }
 
+// PopulateCacheFunc sets the function looking up roles missing from the cache.
+func (r *RoleCache) PopulateCacheFunc(cf func(orgId, roleId string) (*RoleInfo, error)) {
+	r.funcLock.Lock()
+	defer r.funcLock.Unlock()
+	r.populateCacheFunc = cf
+}
+
+// UpsertRole adds or updates the role, keeping its policies.
 func (r *RoleCache) UpsertRole(orgId string, role string, voter bool, admin bool, access AccessType, active bool) {
 	key := RoleKey{orgId, role}
-	r.c.Add(key, &RoleInfo{orgId, role, voter, admin, access, active})
+	ri := &RoleInfo{OrgId: orgId, RoleId: role, IsVoter: voter, IsAdmin: admin, Access: access, Active: active}
+	if v, ok := r.c.Peek(key); ok {
+		ri.Policies = v.(*RoleInfo).Policies
+	}
+	r.c.Add(key, ri)
+}
 
+// AddRolePolicy adds a policy to a cached role.
+func (r *RoleCache) AddRolePolicy(orgId, roleId string, policy RolePolicy) {
+	if ri := r.GetRole(orgId, roleId); ri != nil {
+		for _, p := range ri.Policies {
+			if p.Contract == policy.Contract && bytes.Equal(p.Selector, policy.Selector) {
+				return
+			}
+		}
+		updated := *ri
+		updated.Policies = append(append([]RolePolicy{}, ri.Policies...), policy)
+		r.c.Add(RoleKey{orgId, roleId}, &updated)
+	}
+}
+
+// RemoveRolePolicy removes a policy from a cached role.
+func (r *RoleCache) RemoveRolePolicy(orgId, roleId string, policy RolePolicy) {
+	if ri := r.GetRole(orgId, roleId); ri != nil {
+		updated := *ri
+		updated.Policies = nil
+		for _, p := range ri.Policies {
+			if p.Contract != policy.Contract || !bytes.Equal(p.Selector, policy.Selector) {
+				updated.Policies = append(updated.Policies, p)
+			}
+		}
+		r.c.Add(RoleKey{orgId, roleId}, &updated)
+	}
+}
+
+// SetRolePolicies replaces the policies of a cached role.
+func (r *RoleCache) SetRolePolicies(orgId, roleId string, policies []RolePolicy) {
+	if ri := r.GetRole(orgId, roleId); ri != nil {
+		updated := *ri
+		updated.Policies = policies
+		r.c.Add(RoleKey{orgId, roleId}, &updated)
+	}
 }
 
 func (r *RoleCache) GetRole(orgId string, roleId string) *RoleInfo {
+	if ent, ok := r.get(RoleKey{OrgId: orgId, RoleId: roleId}); ok {
+		return ent.(*RoleInfo)
+	}
+	return r.lookup(orgId, roleId)
+}
+
+// getRoleCached returns the role if it is cached, a miss is looked up with a
+// bounded wait.
+func (r *RoleCache) getRoleCached(orgId string, roleId string) *RoleInfo {
 	key := RoleKey{OrgId: orgId, RoleId: roleId}
-	if ent, ok := r.c.Get(key); ok {
+	ent, ok := r.get(key)
+	if !ok {
+		ent, ok = r.lookupWait(key, func() { r.lookup(orgId, roleId) })
+	}
+	if ok {
 		return ent.(*RoleInfo)
 	}
 	return nil
 }
 
+// lookup looks up a role missing from the cache in the permission contracts.
+func (r *RoleCache) lookup(orgId string, roleId string) *RoleInfo {
+	if !r.needsLookup() {
+		return nil
+	}
+	r.funcLock.RLock()
+	defer r.funcLock.RUnlock()
+	if r.populateCacheFunc == nil {
+		return nil
+	}
+	role, err := r.populateCacheFunc(orgId, roleId)
+	if err != nil || role == nil {
+		log.Debug("Role not found in the permission contracts", "org", orgId, "role", roleId, "err", err)
+		return nil
+	}
+	return r.add(RoleKey{OrgId: orgId, RoleId: roleId}, role).(*RoleInfo)
+}
+
+// GetRoleList returns the roles held by the cache.
 func (r *RoleCache) GetRoleList() []RoleInfo {
-	rlist := make([]RoleInfo, len(r.c.Keys()))
-	for i, k := range r.c.Keys() {
-		v, _ := r.c.Get(k)
-		vp := v.(*RoleInfo)
-		rlist[i] = *vp
+	rlist := make([]RoleInfo, 0, r.c.Len())
+	for _, k := range r.c.Keys() {
+		if v, ok := r.c.Peek(k); ok {
+			rlist = append(rlist, *v.(*RoleInfo))
+		}
 	}
 	return rlist
 }
 
-// Returns the access type for an account. If not found returns
-// default access
-func GetAcctAccess(acctId common.Address) AccessType {
+// AccessChecker evaluates what accounts are permitted to do in a block.
+type AccessChecker interface {
+	// GetAcctAccess returns the access type of the account in the block. A
+	// zero time skips the check of the expiry time of its role.
+	GetAcctAccess(acctId common.Address, number *big.Int, time uint64) AccessType
+
+	// CheckAcctPolicy reports whether the policies of the role of the account
+	// allow calling the contract with the given method selector.
+	CheckAcctPolicy(acctId common.Address, number *big.Int, time uint64, to common.Address, selector []byte) bool
+}
+
+// permissionSource provides the permission entries access is evaluated with,
+// nil for the entries it doesn't know.
+type permissionSource interface {
+	account(acctId common.Address) *AccountInfo
+	org(orgId string) *OrgInfo
+	role(orgId, roleId string) *RoleInfo
+}
+
+// CachedPermissions evaluates access against the permission caches, following
+// the permission contracts at the head of the chain.
+var CachedPermissions AccessChecker = cachedPermissions{}
+
+type cachedPermissions struct{}
+
+func (cachedPermissions) account(acctId common.Address) *AccountInfo {
+	return AcctInfoMap.GetAccountCached(acctId)
+}
+
+func (cachedPermissions) org(orgId string) *OrgInfo {
+	return OrgInfoMap.getOrgCached(orgId)
+}
+
+func (cachedPermissions) role(orgId, roleId string) *RoleInfo {
+	return RoleInfoMap.getRoleCached(orgId, roleId)
+}
+
+// GetAcctAccess implements AccessChecker, see GetAcctAccess.
+func (c cachedPermissions) GetAcctAccess(acctId common.Address, number *big.Int, time uint64) AccessType {
 	//if we have not reached QIP714 block return default access
 	//which will be full access
 	if !QIP714BlockReached {
 		return DefaultAccess
 	}
+	return acctAccess(c, acctId, number, time, DefaultAccess)
+}
 
-	// check if the org status is fine to do the transaction
-	a := AcctInfoMap.GetAccount(acctId)
-	if a != nil && a.Status == AcctActive {
-		// get the org details and ultimate org details. check org status
-		// if the org is not approved or pending suspension
-		o := OrgInfoMap.GetOrg(a.OrgId)
-		if o != nil && (o.Status == OrgApproved || o.Status == OrgPendingSuspension) {
-			u := OrgInfoMap.GetOrg(o.UltimateParent)
-			if u != nil && (u.Status == OrgApproved || u.Status == OrgPendingSuspension) {
-				if a.RoleId == networkAdminRole || a.RoleId == orgAdminRole {
-					return FullAccess
-				}
-				if r := RoleInfoMap.GetRole(a.OrgId, a.RoleId); r != nil && r.Active {
-					return r.Access
-				}
-				if r := RoleInfoMap.GetRole(o.UltimateParent, a.RoleId); r != nil && r.Active {
-					return r.Access
-				}
-			}
+// CheckAcctPolicy implements AccessChecker, see CheckAcctPolicy.
+func (c cachedPermissions) CheckAcctPolicy(acctId common.Address, number *big.Int, time uint64, to common.Address, selector []byte) bool {
+	if !QIP714BlockReached {
+		return true
+	}
+	return acctPolicyAllows(c, acctId, number, time, to, selector)
+}
+
+// Returns the access type for an account in the given block. If not found or
+// if the role of the account expired returns default access. A zero time skips
+// the check of the expiry time.
+func GetAcctAccess(acctId common.Address, number *big.Int, time uint64) AccessType {
+	return CachedPermissions.GetAcctAccess(acctId, number, time)
+}
+
+// CheckAcctPolicy reports whether the policies of the role of an account allow
+// calling the contract with the given method selector. Accounts with admin
+// roles or the default access are not restricted by policies.
+func CheckAcctPolicy(acctId common.Address, number *big.Int, time uint64, to common.Address, selector []byte) bool {
+	return CachedPermissions.CheckAcctPolicy(acctId, number, time, to, selector)
+}
+
+// acctAccess returns the access type of an account, or the given default
+// access if it can't transact.
+func acctAccess(src permissionSource, acctId common.Address, number *big.Int, time uint64, defaultAccess AccessType) AccessType {
+	a, r := getAcctRole(src, acctId, number, time)
+	if a != nil && (a.RoleId == networkAdminRole || a.RoleId == orgAdminRole) {
+		return FullAccess
+	}
+	if r != nil {
+		return r.Access
+	}
+	return defaultAccess
+}
+
+// acctPolicyAllows reports whether the policies of the role of an account
+// allow calling the contract with the given method selector.
+func acctPolicyAllows(src permissionSource, acctId common.Address, number *big.Int, time uint64, to common.Address, selector []byte) bool {
+	_, r := getAcctRole(src, acctId, number, time)
+	if r == nil || len(r.Policies) == 0 {
+		return true
+	}
+	for _, p := range r.Policies {
+		if p.Allows(to, selector) {
+			return true
 		}
 	}
-	return DefaultAccess
+	return false
+}
+
+// getAcctRole returns the account and its active role if the account can
+// transact in the given block. The role of admin accounts is not looked up.
+func getAcctRole(src permissionSource, acctId common.Address, number *big.Int, time uint64) (*AccountInfo, *RoleInfo) {
+	// check if the org status is fine to do the transaction
+	a := src.account(acctId)
+	if a == nil || a.Status != AcctActive || a.Expired(number, time) {
+		return nil, nil
+	}
+	// get the org details and ultimate org details. check org status
+	// if the org is not approved or pending suspension
+	o := src.org(a.OrgId)
+	if o == nil || (o.Status != OrgApproved && o.Status != OrgPendingSuspension) {
+		return nil, nil
+	}
+	u := src.org(o.UltimateParent)
+	if u == nil || (u.Status != OrgApproved && u.Status != OrgPendingSuspension) {
+		return nil, nil
+	}
+	if a.RoleId == networkAdminRole || a.RoleId == orgAdminRole {
+		return a, nil
+	}
+	if r := src.role(a.OrgId, a.RoleId); r != nil && r.Active {
+		return a, r
+	}
+	if r := src.role(o.UltimateParent, a.RoleId); r != nil && r.Active {
+		return a, r
+	}
+	return a, nil
+}
+
+// PermissionReader reads permission entries from the permission contracts,
+// nil entries if they don't exist.
+type PermissionReader interface {
+	ReadOrg(orgId string) (*OrgInfo, error)
+	ReadRole(orgId, roleId string) (*RoleInfo, error)
+	ReadAccount(acctId common.Address) (*AccountInfo, error)
+}
+
+// PermissionSnapshot evaluates access against the permission contracts as read
+// at a single block, independent of the caches. Accounts that can't transact
+// get read only access. Every entry is read once per snapshot.
+type PermissionSnapshot struct {
+	reader   PermissionReader
+	orgs     map[string]*OrgInfo
+	roles    map[RoleKey]*RoleInfo
+	accounts map[common.Address]*AccountInfo
+	err      error // first error reading the contracts
+}
+
+// NewPermissionSnapshot creates a snapshot reading the entries from the reader.
+func NewPermissionSnapshot(reader PermissionReader) *PermissionSnapshot {
+	return &PermissionSnapshot{
+		reader:   reader,
+		orgs:     make(map[string]*OrgInfo),
+		roles:    make(map[RoleKey]*RoleInfo),
+		accounts: make(map[common.Address]*AccountInfo),
+	}
+}
+
+func (s *PermissionSnapshot) account(acctId common.Address) *AccountInfo {
+	if a, ok := s.accounts[acctId]; ok {
+		return a
+	}
+	a, err := s.reader.ReadAccount(acctId)
+	s.setErr(err)
+	s.accounts[acctId] = a
+	return a
+}
+
+func (s *PermissionSnapshot) org(orgId string) *OrgInfo {
+	if o, ok := s.orgs[orgId]; ok {
+		return o
+	}
+	o, err := s.reader.ReadOrg(orgId)
+	s.setErr(err)
+	s.orgs[orgId] = o
+	return o
+}
+
+func (s *PermissionSnapshot) role(orgId, roleId string) *RoleInfo {
+	key := RoleKey{OrgId: orgId, RoleId: roleId}
+	if r, ok := s.roles[key]; ok {
+		return r
+	}
+	r, err := s.reader.ReadRole(orgId, roleId)
+	s.setErr(err)
+	s.roles[key] = r
+	return r
+}
+
+func (s *PermissionSnapshot) setErr(err error) {
+	if err != nil && s.err == nil {
+		s.err = err
+	}
+}
+
+// Err returns the first error met reading the permission contracts. Access
+// evaluated after an error treats the entries that failed as missing.
+func (s *PermissionSnapshot) Err() error {
+	return s.err
+}
+
+// GetAcctAccess implements AccessChecker.
+func (s *PermissionSnapshot) GetAcctAccess(acctId common.Address, number *big.Int, time uint64) AccessType {
+	return acctAccess(s, acctId, number, time, ReadOnly)
+}
+
+// CheckAcctPolicy implements AccessChecker.
+func (s *PermissionSnapshot) CheckAcctPolicy(acctId common.Address, number *big.Int, time uint64, to common.Address, selector []byte) bool {
+	return acctPolicyAllows(s, acctId, number, time, to, selector)
+}
+
+var (
+	permissionsAtFunc func(header *Header) (PermissionReader, error)
+	permissionsAtLock sync.RWMutex
+)
+
+// SetPermissionsAt sets the function reading the permission contracts in the
+// state of a block. It returns a nil reader for blocks at which the network
+// isn't permissioned yet.
+func SetPermissionsAt(f func(header *Header) (PermissionReader, error)) {
+	permissionsAtLock.Lock()
+	defer permissionsAtLock.Unlock()
+	permissionsAtFunc = f
+}
+
+// PermissionsAt returns a snapshot of the permission contracts in the state of
+// the given block, nil if there is none to enforce.
+func PermissionsAt(header *Header) (*PermissionSnapshot, error) {
+	permissionsAtLock.RLock()
+	defer permissionsAtLock.RUnlock()
+	if permissionsAtFunc == nil {
+		return nil, nil
+	}
+	reader, err := permissionsAtFunc(header)
+	if reader == nil || err != nil {
+		return nil, err
+	}
+	return NewPermissionSnapshot(reader), nil
 }
 
 func ValidateNodeForTxn(hexnodeId string, from common.Address) bool {
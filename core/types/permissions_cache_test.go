@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 	"strconv"
+	"sync"
 	"testing"
 
 	"github.com/ethereum/quorum/common"
@@ -20,6 +21,7 @@ var (
 
 var Acct1 = common.BytesToAddress([]byte("permission"))
 var Acct2 = common.BytesToAddress([]byte("perm-test"))
+var Acct3 = common.BytesToAddress([]byte("perm-expiry"))
 
 func TestSetSyncStatus(t *testing.T) {
 	assert := testifyassert.New(t)
@@ -155,7 +157,7 @@ func TestGetAcctAccess(t *testing.T) {
 	// default access when the cache is not populated, should return default access
 	SetDefaults(NETWORKADMIN, ORGADMIN)
 	SetDefaultAccess()
-	access := GetAcctAccess(Acct1)
+	access := GetAcctAccess(Acct1, nil, 0)
 	assert.True(access == ReadOnly, fmt.Sprintf("Expected account access to be %v, got %v", ReadOnly, access))
 
 	// Create an org with two roles and two accounts linked to different roles. Validate account access
@@ -165,26 +167,144 @@ func TestGetAcctAccess(t *testing.T) {
 	AcctInfoMap.UpsertAccount(NETWORKADMIN, NETWORKADMIN, Acct1, true, AcctActive)
 	AcctInfoMap.UpsertAccount(NETWORKADMIN, "ROLE1", Acct2, true, AcctActive)
 
-	access = GetAcctAccess(Acct1)
+	access = GetAcctAccess(Acct1, nil, 0)
 	assert.True(access == FullAccess, fmt.Sprintf("Expected account access to be %v, got %v", FullAccess, access))
 
 	// mark the org as pending suspension. The account access should not change
 	OrgInfoMap.UpsertOrg(NETWORKADMIN, "", NETWORKADMIN, big.NewInt(1), OrgPendingSuspension)
-	access = GetAcctAccess(Acct1)
+	access = GetAcctAccess(Acct1, nil, 0)
 	assert.True(access == FullAccess, fmt.Sprintf("Expected account access to be %v, got %v", FullAccess, access))
 
 	// suspend the org and the account access should be readonly now
 	OrgInfoMap.UpsertOrg(NETWORKADMIN, "", NETWORKADMIN, big.NewInt(1), OrgSuspended)
-	access = GetAcctAccess(Acct1)
+	access = GetAcctAccess(Acct1, nil, 0)
 	assert.True(access == ReadOnly, fmt.Sprintf("Expected account access to be %v, got %v", ReadOnly, access))
 
 	// mark the role as inactive and account access should now nbe read only
 	OrgInfoMap.UpsertOrg(NETWORKADMIN, "", NETWORKADMIN, big.NewInt(1), OrgApproved)
 	RoleInfoMap.UpsertRole(NETWORKADMIN, "ROLE1", true, true, FullAccess, false)
-	access = GetAcctAccess(Acct2)
+	access = GetAcctAccess(Acct2, nil, 0)
 	assert.True(access == ReadOnly, fmt.Sprintf("Expected account access to be %v, got %v", ReadOnly, access))
 }
 
+func TestGetAcctAccess_expiry(t *testing.T) {
+	assert := testifyassert.New(t)
+
+	SetDefaults(NETWORKADMIN, ORGADMIN)
+	SetDefaultAccess()
+	OrgInfoMap.UpsertOrg(NETWORKADMIN, "", NETWORKADMIN, big.NewInt(1), OrgApproved)
+	RoleInfoMap.UpsertRole(NETWORKADMIN, "ROLE2", false, false, Transact, true)
+	AcctInfoMap.UpsertAccount(NETWORKADMIN, "ROLE2", Acct3, false, AcctActive)
+
+	AcctInfoMap.SetAccountExpiry(Acct3, 10, 1000)
+	assert.Equal(Transact, GetAcctAccess(Acct3, big.NewInt(9), 999))
+	assert.Equal(ReadOnly, GetAcctAccess(Acct3, big.NewInt(10), 999))
+	assert.Equal(ReadOnly, GetAcctAccess(Acct3, big.NewInt(9), 1000))
+	// a zero time skips the expiry time
+	assert.Equal(Transact, GetAcctAccess(Acct3, big.NewInt(9), 0))
+
+	// updates keep the expiry, a new role clears it
+	AcctInfoMap.UpsertAccount(NETWORKADMIN, "ROLE2", Acct3, false, AcctActive)
+	assert.Equal(uint64(10), AcctInfoMap.GetAccount(Acct3).ExpiryBlock)
+	AcctInfoMap.UpsertAccount(NETWORKADMIN, NETWORKADMIN, Acct3, false, AcctActive)
+	assert.Zero(AcctInfoMap.GetAccount(Acct3).ExpiryBlock)
+	assert.Equal(FullAccess, GetAcctAccess(Acct3, big.NewInt(10), 1000))
+}
+
+func TestCheckAcctPolicy(t *testing.T) {
+	assert := testifyassert.New(t)
+
+	SetDefaults(NETWORKADMIN, ORGADMIN)
+	SetDefaultAccess()
+	contract := common.BytesToAddress([]byte("policy-contract"))
+	OrgInfoMap.UpsertOrg(NETWORKADMIN, "", NETWORKADMIN, big.NewInt(1), OrgApproved)
+	RoleInfoMap.UpsertRole(NETWORKADMIN, "ROLE3", false, false, Transact, true)
+	AcctInfoMap.UpsertAccount(NETWORKADMIN, "ROLE3", Acct3, false, AcctActive)
+
+	// roles without policies can call any contract
+	assert.True(CheckAcctPolicy(Acct3, nil, 0, contract, []byte{1, 2, 3, 4}))
+
+	RoleInfoMap.AddRolePolicy(NETWORKADMIN, "ROLE3", RolePolicy{Contract: contract, Selector: []byte{1, 2, 3, 4}})
+	// updates of the role keep its policies
+	RoleInfoMap.UpsertRole(NETWORKADMIN, "ROLE3", false, false, Transact, true)
+	assert.True(CheckAcctPolicy(Acct3, nil, 0, contract, []byte{1, 2, 3, 4}))
+	assert.True(CheckAcctPolicy(Acct3, nil, 0, contract, nil))
+	assert.False(CheckAcctPolicy(Acct3, nil, 0, contract, []byte{4, 3, 2, 1}))
+	assert.False(CheckAcctPolicy(Acct3, nil, 0, common.Address{}, nil))
+
+	// a zero selector allows any method
+	RoleInfoMap.AddRolePolicy(NETWORKADMIN, "ROLE3", RolePolicy{Contract: contract, Selector: []byte{0, 0, 0, 0}})
+	assert.True(CheckAcctPolicy(Acct3, nil, 0, contract, []byte{4, 3, 2, 1}))
+
+	RoleInfoMap.RemoveRolePolicy(NETWORKADMIN, "ROLE3", RolePolicy{Contract: contract, Selector: []byte{0, 0, 0, 0}})
+	assert.Len(RoleInfoMap.GetRole(NETWORKADMIN, "ROLE3").Policies, 1)
+
+	// the policies read back from the contract replace the cached ones
+	RoleInfoMap.SetRolePolicies(NETWORKADMIN, "ROLE3", nil)
+	assert.Empty(RoleInfoMap.GetRole(NETWORKADMIN, "ROLE3").Policies)
+	assert.True(CheckAcctPolicy(Acct3, nil, 0, common.Address{}, nil))
+}
+
+// testPermissionReader serves permission entries from maps and counts the reads.
+type testPermissionReader struct {
+	orgs     map[string]*OrgInfo
+	roles    map[RoleKey]*RoleInfo
+	accounts map[common.Address]*AccountInfo
+	reads    int
+}
+
+func (r *testPermissionReader) ReadOrg(orgId string) (*OrgInfo, error) {
+	r.reads++
+	return r.orgs[orgId], nil
+}
+
+func (r *testPermissionReader) ReadRole(orgId, roleId string) (*RoleInfo, error) {
+	r.reads++
+	return r.roles[RoleKey{orgId, roleId}], nil
+}
+
+func (r *testPermissionReader) ReadAccount(acctId common.Address) (*AccountInfo, error) {
+	r.reads++
+	return r.accounts[acctId], nil
+}
+
+func TestPermissionSnapshot(t *testing.T) {
+	assert := testifyassert.New(t)
+
+	SetDefaults(NETWORKADMIN, ORGADMIN)
+	contract := common.BytesToAddress([]byte("policy-contract"))
+	reader := &testPermissionReader{
+		orgs: map[string]*OrgInfo{
+			"ORG1": {OrgId: "ORG1", FullOrgId: "ORG1", UltimateParent: "ORG1", Level: big.NewInt(1), Status: OrgApproved},
+		},
+		roles: map[RoleKey]*RoleInfo{
+			{"ORG1", "ROLE1"}: {OrgId: "ORG1", RoleId: "ROLE1", Access: Transact, Active: true,
+				Policies: []RolePolicy{{Contract: contract, Selector: []byte{1, 2, 3, 4}}}},
+		},
+		accounts: map[common.Address]*AccountInfo{
+			Acct1: {OrgId: "ORG1", RoleId: "ROLE1", AcctId: Acct1, Status: AcctActive, ExpiryBlock: 10},
+			Acct2: {OrgId: "ORG1", RoleId: ORGADMIN, AcctId: Acct2, Status: AcctActive},
+		},
+	}
+	snap := NewPermissionSnapshot(reader)
+
+	// the snapshot doesn't depend on the caches or the QIP714 status
+	assert.Equal(Transact, snap.GetAcctAccess(Acct1, big.NewInt(9), 0))
+	assert.Equal(ReadOnly, snap.GetAcctAccess(Acct1, big.NewInt(10), 0))
+	assert.Equal(FullAccess, snap.GetAcctAccess(Acct2, big.NewInt(9), 0))
+	assert.Equal(ReadOnly, snap.GetAcctAccess(Acct3, big.NewInt(9), 0))
+
+	assert.True(snap.CheckAcctPolicy(Acct1, big.NewInt(9), 0, contract, []byte{1, 2, 3, 4}))
+	assert.False(snap.CheckAcctPolicy(Acct1, big.NewInt(9), 0, contract, []byte{4, 3, 2, 1}))
+	assert.NoError(snap.Err())
+
+	// every entry is read once
+	reads := reader.reads
+	snap.GetAcctAccess(Acct1, big.NewInt(9), 0)
+	snap.GetAcctAccess(Acct3, big.NewInt(9), 0)
+	assert.Equal(reads, reader.reads)
+}
+
 func TestValidateNodeForTxn(t *testing.T) {
 	assert := testifyassert.New(t)
 	// pass the enode as null and the response should be true
@@ -234,7 +354,7 @@ func TestValidateNodeForTxn_whenUsingOnlyHexNodeId(t *testing.T) {
 
 // test the cache limit
 func TestLRUCacheLimit(t *testing.T) {
-	for i := 0; i < defaultOrgMapLimit ; i++ {
+	for i := 0; i < DefaultOrgMapLimit; i++ {
 		orgName := "ORG" + strconv.Itoa(i)
 		OrgInfoMap.UpsertOrg(orgName, "", NETWORKADMIN, big.NewInt(1), OrgApproved)
 	}
@@ -242,3 +362,95 @@ func TestLRUCacheLimit(t *testing.T) {
 	o := OrgInfoMap.GetOrg("ORG1")
 	testifyassert.True(t, o != nil)
 }
+
+func TestAcctCache_lookupAfterEviction(t *testing.T) {
+	assert := testifyassert.New(t)
+
+	cache := NewAcctCache()
+	cache.resize(1)
+	lookups := 0
+	cache.PopulateCacheFunc(func(acct common.Address) (*AccountInfo, error) {
+		lookups++
+		if acct == Acct1 {
+			return &AccountInfo{OrgId: NETWORKADMIN, RoleId: NETWORKADMIN, AcctId: Acct1, IsOrgAdmin: true, Status: AcctActive}, nil
+		}
+		return nil, nil
+	})
+
+	// nothing was evicted, a miss means the account does not exist
+	assert.Nil(cache.GetAccount(Acct1))
+	assert.Equal(0, lookups)
+
+	cache.UpsertAccount(NETWORKADMIN, NETWORKADMIN, Acct1, true, AcctActive)
+	cache.UpsertAccount(ORGADMIN, ORGADMIN, Acct2, true, AcctActive)
+
+	// the evicted account is looked up and cached again
+	acctInfo := cache.GetAccount(Acct1)
+	assert.Equal(1, lookups)
+	if assert.NotNil(acctInfo) {
+		assert.Equal(NETWORKADMIN, acctInfo.OrgId)
+	}
+	assert.NotNil(cache.GetAccount(Acct1))
+	assert.Equal(1, lookups)
+
+	// unknown accounts are still reported missing
+	assert.Nil(cache.GetAccount(common.BytesToAddress([]byte("unknown"))))
+	assert.Equal(2, lookups)
+}
+
+func TestOrgCache_lookupAfterEviction(t *testing.T) {
+	assert := testifyassert.New(t)
+
+	cache := NewOrgCache()
+	cache.resize(1)
+	cache.PopulateCacheFunc(func(orgId string) (*OrgInfo, error) {
+		return &OrgInfo{OrgId: orgId, FullOrgId: orgId, UltimateParent: orgId, Level: big.NewInt(1), Status: OrgApproved}, nil
+	})
+
+	cache.UpsertOrg(NETWORKADMIN, "", NETWORKADMIN, big.NewInt(1), OrgApproved)
+	cache.UpsertOrg("SUB1", NETWORKADMIN, NETWORKADMIN, big.NewInt(2), OrgApproved)
+	cache.UpsertOrg(ORGADMIN, "", ORGADMIN, big.NewInt(1), OrgApproved)
+
+	// the sub orgs of the evicted org are taken from the index
+	orgInfo := cache.GetOrg(NETWORKADMIN)
+	if assert.NotNil(orgInfo) {
+		assert.Equal([]string{NETWORKADMIN + ".SUB1"}, orgInfo.SubOrgList)
+	}
+	assert.Len(cache.GetOrgList(), 1)
+}
+
+func TestAcctCache_lookupWait(t *testing.T) {
+	assert := testifyassert.New(t)
+
+	cache := NewAcctCache()
+	cache.resize(1)
+	release := make(chan struct{})
+	cache.PopulateCacheFunc(func(acct common.Address) (*AccountInfo, error) {
+		<-release
+		return &AccountInfo{OrgId: NETWORKADMIN, RoleId: NETWORKADMIN, AcctId: acct, Status: AcctActive}, nil
+	})
+	cache.UpsertAccount(NETWORKADMIN, NETWORKADMIN, Acct1, true, AcctActive)
+	cache.UpsertAccount(ORGADMIN, ORGADMIN, Acct2, true, AcctActive)
+	cache.resize(4 * maxPendingLookups)
+
+	// a lookup which takes too long reports the miss
+	assert.Nil(cache.GetAccountCached(Acct1))
+	close(release)
+
+	// misses beyond the lookups running at once are queued, not dropped
+	var wg sync.WaitGroup
+	found := make(chan bool, 2*maxPendingLookups)
+	for i := 0; i < 2*maxPendingLookups; i++ {
+		wg.Add(1)
+		go func(acct common.Address) {
+			defer wg.Done()
+			found <- cache.GetAccountCached(acct) != nil
+		}(common.BigToAddress(big.NewInt(int64(i + 100))))
+	}
+	wg.Wait()
+	close(found)
+	for ok := range found {
+		assert.True(ok)
+	}
+	assert.NotNil(cache.GetAccountCached(Acct1))
+}
@@ -93,6 +93,8 @@ type Context struct {
 	// Message information
 	Origin   common.Address // Provides information for ORIGIN
 	GasPrice *big.Int       // Provides information for GASPRICE
+	TxHash   common.Hash    // Hash of the transaction, for the hooks
+	TxIndex  int            // Index of the transaction in the block, for the hooks
 
 	// Block information
 	Coinbase    common.Address // Provides information for COINBASE
@@ -105,6 +107,12 @@ type Context struct {
 type PublicState StateDB
 type PrivateState StateDB
 
+// Names of the states a call runs in, as reported by the tracers.
+const (
+	PublicStateName  = "public"
+	PrivateStateName = "private"
+)
+
 // EVM is the Ethereum Virtual Machine base object and provides
 // the necessary tools to run a contract on the given state with
 // the provided context. It should be noted that any error
@@ -220,6 +228,11 @@ func (evm *EVM) Call(caller ContractRef, addr common.Address, input []byte, gas
 	if evm.depth > int(params.CallCreateDepth) {
 		return nil, gas, ErrDepth
 	}
+	if hooks := evm.vmConfig.Hooks; hooks != nil {
+		scope := evm.hookScope(evm.StateDB, evm.depth+1)
+		hooks.CallEnter(scope, CALL, caller.Address(), addr, input, gas, value)
+		defer func() { hooks.CallExit(scope, ret, gas-leftOverGas, err) }()
+	}
 	// Fail if we're trying to transfer more than the available balance
 	if !evm.Context.CanTransfer(evm.StateDB, caller.Address(), value) {
 		return nil, gas, ErrInsufficientBalance
@@ -305,6 +318,11 @@ func (evm *EVM) CallCode(caller ContractRef, addr common.Address, input []byte,
 	if evm.depth > int(params.CallCreateDepth) {
 		return nil, gas, ErrDepth
 	}
+	if hooks := evm.vmConfig.Hooks; hooks != nil {
+		scope := evm.hookScope(evm.StateDB, evm.depth+1)
+		hooks.CallEnter(scope, CALLCODE, caller.Address(), addr, input, gas, value)
+		defer func() { hooks.CallExit(scope, ret, gas-leftOverGas, err) }()
+	}
 	// Fail if we're trying to transfer more than the available balance
 	if !evm.CanTransfer(evm.StateDB, caller.Address(), value) {
 		return nil, gas, ErrInsufficientBalance
@@ -347,6 +365,11 @@ func (evm *EVM) DelegateCall(caller ContractRef, addr common.Address, input []by
 	if evm.depth > int(params.CallCreateDepth) {
 		return nil, gas, ErrDepth
 	}
+	if hooks := evm.vmConfig.Hooks; hooks != nil {
+		scope := evm.hookScope(evm.StateDB, evm.depth+1)
+		hooks.CallEnter(scope, DELEGATECALL, caller.Address(), addr, input, gas, nil)
+		defer func() { hooks.CallExit(scope, ret, gas-leftOverGas, err) }()
+	}
 
 	var (
 		snapshot = evm.StateDB.Snapshot()
@@ -386,6 +409,11 @@ func (evm *EVM) StaticCall(caller ContractRef, addr common.Address, input []byte
 		stateDb  = getDualState(evm, addr)
 		snapshot = stateDb.Snapshot()
 	)
+	if hooks := evm.vmConfig.Hooks; hooks != nil {
+		scope := evm.hookScope(stateDb, evm.depth+1)
+		hooks.CallEnter(scope, STATICCALL, caller.Address(), addr, input, gas, new(big.Int))
+		defer func() { hooks.CallExit(scope, ret, gas-leftOverGas, err) }()
+	}
 	// Initialise a new contract and set the code that is to be used by the
 	// EVM. The contract is a scoped environment for this execution context
 	// only.
@@ -417,13 +445,24 @@ func (c *codeAndHash) Hash() common.Hash {
 	return c.hash
 }
 
-// create creates a new contract using code as deployment code.
-func (evm *EVM) create(caller ContractRef, codeAndHash *codeAndHash, gas uint64, value *big.Int, address common.Address) ([]byte, common.Address, uint64, error) {
+// create creates a new contract using code as deployment code, typ being the
+// opcode of the creation.
+func (evm *EVM) create(caller ContractRef, codeAndHash *codeAndHash, gas uint64, value *big.Int, address common.Address, typ OpCode) (ret []byte, contractAddr common.Address, leftOverGas uint64, err error) {
 	// Depth check execution. Fail if we're trying to execute above the
 	// limit.
 	if evm.depth > int(params.CallCreateDepth) {
 		return nil, common.Address{}, gas, ErrDepth
 	}
+	if hooks := evm.vmConfig.Hooks; hooks != nil {
+		scope := evm.hookScope(evm.StateDB, evm.depth+1)
+		hooks.CallEnter(scope, typ, caller.Address(), address, codeAndHash.code, gas, value)
+		defer func() {
+			if err == nil {
+				hooks.ContractCreated(scope, caller.Address(), address, ret)
+			}
+			hooks.CallExit(scope, ret, gas-leftOverGas, err)
+		}()
+	}
 	if !evm.CanTransfer(evm.StateDB, caller.Address(), value) {
 		return nil, common.Address{}, gas, ErrInsufficientBalance
 	}
@@ -485,7 +524,7 @@ func (evm *EVM) create(caller ContractRef, codeAndHash *codeAndHash, gas uint64,
 	}
 	start := time.Now()
 
-	ret, err := run(evm, contract, nil, false)
+	ret, err = run(evm, contract, nil, false)
 
 	var maxCodeSize int
 	if evm.ChainConfig().MaxCodeSize > 0 {
@@ -551,7 +590,7 @@ func (evm *EVM) Create(caller ContractRef, code []byte, gas uint64, value *big.I
 	// Ensure there's no existing contract already at the designated address
 	nonce := creatorStateDb.GetNonce(caller.Address())
 	contractAddr = crypto.CreateAddress(caller.Address(), nonce)
-	return evm.create(caller, &codeAndHash{code: code}, gas, value, contractAddr)
+	return evm.create(caller, &codeAndHash{code: code}, gas, value, contractAddr, CREATE)
 }
 
 // Create2 creates a new contract using code as deployment code.
@@ -561,7 +600,7 @@ func (evm *EVM) Create(caller ContractRef, code []byte, gas uint64, value *big.I
 func (evm *EVM) Create2(caller ContractRef, code []byte, gas uint64, endowment *big.Int, salt *big.Int) (ret []byte, contractAddr common.Address, leftOverGas uint64, err error) {
 	codeAndHash := &codeAndHash{code: code}
 	contractAddr = crypto.CreateAddress2(caller.Address(), common.BigToHash(salt), codeAndHash.Hash().Bytes())
-	return evm.create(caller, codeAndHash, gas, endowment, contractAddr)
+	return evm.create(caller, codeAndHash, gas, endowment, contractAddr, CREATE2)
 }
 
 // ChainConfig returns the environment's chain configuration
@@ -584,6 +623,32 @@ func getDualState(env *EVM, addr common.Address) StateDB {
 
 func (env *EVM) PublicState() PublicState   { return env.publicState }
 func (env *EVM) PrivateState() PrivateState { return env.privateState }
+
+// StateName returns the name of the state the current call runs in, which is
+// always public for public transactions.
+func (env *EVM) StateName() string {
+	if env.privateState != env.publicState && env.StateDB == env.privateState {
+		return PrivateStateName
+	}
+	return PublicStateName
+}
+
+// hookScope returns the scope of the hook events happening in the state at the
+// call depth.
+func (env *EVM) hookScope(db StateDB, depth int) HookScope {
+	return HookScope{
+		BlockNumber: env.BlockNumber,
+		TxHash:      env.TxHash,
+		TxIndex:     env.TxIndex,
+		Depth:       depth,
+		Private:     env.privateState != env.publicState && db == env.privateState,
+	}
+}
+
+// QuorumReadOnly reports whether the current call is a read of the public
+// state by a private contract, in which state modifications are prohibited.
+func (env *EVM) QuorumReadOnly() bool { return env.quorumReadOnly }
+
 func (env *EVM) Push(statedb StateDB) {
 	// Quorum : the read only depth to be set up only once for the entire
 	// op code execution. This will be set first time transition from
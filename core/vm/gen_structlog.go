@@ -16,19 +16,21 @@ var _ = (*structLogMarshaling)(nil)
 // MarshalJSON marshals as JSON.
 func (s StructLog) MarshalJSON() ([]byte, error) {
 	type StructLog struct {
-		Pc            uint64                      `json:"pc"`
-		Op            OpCode                      `json:"op"`
-		Gas           math.HexOrDecimal64         `json:"gas"`
-		GasCost       math.HexOrDecimal64         `json:"gasCost"`
-		Memory        hexutil.Bytes               `json:"memory"`
-		MemorySize    int                         `json:"memSize"`
-		Stack         []*math.HexOrDecimal256     `json:"stack"`
-		Storage       map[common.Hash]common.Hash `json:"-"`
-		Depth         int                         `json:"depth"`
-		RefundCounter uint64                      `json:"refund"`
-		Err           error                       `json:"-"`
-		OpName        string                      `json:"opName"`
-		ErrorString   string                      `json:"error"`
+		Pc             uint64                      `json:"pc"`
+		Op             OpCode                      `json:"op"`
+		Gas            math.HexOrDecimal64         `json:"gas"`
+		GasCost        math.HexOrDecimal64         `json:"gasCost"`
+		Memory         hexutil.Bytes               `json:"memory"`
+		MemorySize     int                         `json:"memSize"`
+		Stack          []*math.HexOrDecimal256     `json:"stack"`
+		Storage        map[common.Hash]common.Hash `json:"-"`
+		Depth          int                         `json:"depth"`
+		RefundCounter  uint64                      `json:"refund"`
+		Err            error                       `json:"-"`
+		State          string                      `json:"state"`
+		QuorumReadOnly bool                        `json:"quorumReadOnly"`
+		OpName         string                      `json:"opName"`
+		ErrorString    string                      `json:"error"`
 	}
 	var enc StructLog
 	enc.Pc = s.Pc
@@ -47,6 +49,8 @@ func (s StructLog) MarshalJSON() ([]byte, error) {
 	enc.Depth = s.Depth
 	enc.RefundCounter = s.RefundCounter
 	enc.Err = s.Err
+	enc.State = s.State
+	enc.QuorumReadOnly = s.QuorumReadOnly
 	enc.OpName = s.OpName()
 	enc.ErrorString = s.ErrorString()
 	return json.Marshal(&enc)
@@ -55,17 +59,19 @@ func (s StructLog) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON unmarshals from JSON.
 func (s *StructLog) UnmarshalJSON(input []byte) error {
 	type StructLog struct {
-		Pc            *uint64                     `json:"pc"`
-		Op            *OpCode                     `json:"op"`
-		Gas           *math.HexOrDecimal64        `json:"gas"`
-		GasCost       *math.HexOrDecimal64        `json:"gasCost"`
-		Memory        *hexutil.Bytes              `json:"memory"`
-		MemorySize    *int                        `json:"memSize"`
-		Stack         []*math.HexOrDecimal256     `json:"stack"`
-		Storage       map[common.Hash]common.Hash `json:"-"`
-		Depth         *int                        `json:"depth"`
-		RefundCounter *uint64                     `json:"refund"`
-		Err           error                       `json:"-"`
+		Pc             *uint64                     `json:"pc"`
+		Op             *OpCode                     `json:"op"`
+		Gas            *math.HexOrDecimal64        `json:"gas"`
+		GasCost        *math.HexOrDecimal64        `json:"gasCost"`
+		Memory         *hexutil.Bytes              `json:"memory"`
+		MemorySize     *int                        `json:"memSize"`
+		Stack          []*math.HexOrDecimal256     `json:"stack"`
+		Storage        map[common.Hash]common.Hash `json:"-"`
+		Depth          *int                        `json:"depth"`
+		RefundCounter  *uint64                     `json:"refund"`
+		Err            error                       `json:"-"`
+		State          *string                     `json:"state"`
+		QuorumReadOnly *bool                       `json:"quorumReadOnly"`
 	}
 	var dec StructLog
 	if err := json.Unmarshal(input, &dec); err != nil {
@@ -107,5 +113,11 @@ func (s *StructLog) UnmarshalJSON(input []byte) error {
 	if dec.Err != nil {
 		s.Err = dec.Err
 	}
+	if dec.State != nil {
+		s.State = *dec.State
+	}
+	if dec.QuorumReadOnly != nil {
+		s.QuorumReadOnly = *dec.QuorumReadOnly
+	}
 	return nil
 }
@@ -0,0 +1,309 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package vm
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/core/types"
+)
+
+// ErrHookRegistered is returned when registering hooks under a name already in
+// use.
+var ErrHookRegistered = errors.New("hooks already registered")
+
+// HookScope describes where an EVM event happens.
+type HookScope struct {
+	BlockNumber *big.Int    // Number of the block executed
+	BlockHash   common.Hash // Hash of the block executed, known once it is committed
+	TxHash      common.Hash // Hash of the transaction executed
+	TxIndex     int         // Index of the transaction in the block
+	Depth       int         // Depth of the call frame of the event, one for the call of the transaction
+	Private     bool        // Whether the event happens in the private state
+}
+
+// Hooks is implemented by instrumentation plugins, such as indexers, audit
+// loggers or metrics, to receive the events of the EVM as transactions execute.
+// Unlike a Tracer, hooks get no access to the interpreter and see every call
+// depth.
+//
+// The methods are invoked synchronously and must neither block nor modify their
+// arguments. The events of calls which are reverted later are not retracted,
+// the error of CallExit tells whether a call was reverted.
+//
+// The hooks of a node receive the events of a block through a HookRecorder,
+// once the block is committed to the chain.
+type Hooks interface {
+	// ContractCreated is invoked once a contract creation succeeded, with the
+	// code deployed.
+	ContractCreated(scope HookScope, creator, contract common.Address, code []byte)
+
+	// CallEnter is invoked when a call or a contract creation starts, typ being
+	// the opcode of the call. The input of a contract creation is its init code.
+	CallEnter(scope HookScope, typ OpCode, from, to common.Address, input []byte, gas uint64, value *big.Int)
+
+	// CallExit is invoked when the call last entered at the depth of the scope
+	// ends.
+	CallExit(scope HookScope, output []byte, gasUsed uint64, err error)
+
+	// StorageWritten is invoked when a contract sets a storage slot.
+	StorageWritten(scope HookScope, contract common.Address, key, value common.Hash)
+
+	// LogEmitted is invoked when a contract emits a log.
+	LogEmitted(scope HookScope, log *types.Log)
+
+	// BlockCommitted is invoked once a block is written to the chain, after the
+	// events of its transactions.
+	BlockCommitted(block *types.Block)
+
+	// BlockRejected is invoked when a block fails processing or validation.
+	// The events of its transactions are dropped.
+	BlockRejected(block *types.Block, err error)
+}
+
+// NoopHooks implements Hooks ignoring every event, it is meant to be embedded by
+// hooks interested in a few events only.
+type NoopHooks struct{}
+
+func (NoopHooks) ContractCreated(HookScope, common.Address, common.Address, []byte) {}
+
+func (NoopHooks) CallEnter(HookScope, OpCode, common.Address, common.Address, []byte, uint64, *big.Int) {
+}
+
+func (NoopHooks) CallExit(HookScope, []byte, uint64, error) {}
+
+func (NoopHooks) StorageWritten(HookScope, common.Address, common.Hash, common.Hash) {}
+
+func (NoopHooks) LogEmitted(HookScope, *types.Log) {}
+
+func (NoopHooks) BlockCommitted(*types.Block) {}
+
+func (NoopHooks) BlockRejected(*types.Block, error) {}
+
+// namedHooks are hooks registered in a registry.
+type namedHooks struct {
+	name  string
+	hooks Hooks
+}
+
+// HookRegistry is a set of hooks subscribed to the EVM events, itself the Hooks
+// dispatching the events to all of them in registration order. Hooks may be
+// registered and unregistered while transactions execute, an EVM event is only
+// dispatched to the hooks registered when it is.
+type HookRegistry struct {
+	hooks atomic.Value // []namedHooks, replaced on every change
+	lock  sync.Mutex   // Lock serializing the changes
+}
+
+// NewHookRegistry creates a registry without hooks.
+func NewHookRegistry() *HookRegistry {
+	r := new(HookRegistry)
+	r.hooks.Store([]namedHooks(nil))
+	return r
+}
+
+// Register subscribes the hooks to the EVM events under the name.
+func (r *HookRegistry) Register(name string, hooks Hooks) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	current := r.list()
+	for _, h := range current {
+		if h.name == name {
+			return ErrHookRegistered
+		}
+	}
+	updated := make([]namedHooks, len(current), len(current)+1)
+	copy(updated, current)
+	r.hooks.Store(append(updated, namedHooks{name, hooks}))
+	return nil
+}
+
+// Unregister unsubscribes the hooks registered under the name, it reports
+// whether there were any.
+func (r *HookRegistry) Unregister(name string) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	current := r.list()
+	for i, h := range current {
+		if h.name == name {
+			updated := make([]namedHooks, 0, len(current)-1)
+			updated = append(updated, current[:i]...)
+			r.hooks.Store(append(updated, current[i+1:]...))
+			return true
+		}
+	}
+	return false
+}
+
+// Names returns the names of the registered hooks, in registration order.
+func (r *HookRegistry) Names() []string {
+	var names []string
+	for _, h := range r.list() {
+		names = append(names, h.name)
+	}
+	return names
+}
+
+// list returns the registered hooks.
+func (r *HookRegistry) list() []namedHooks {
+	return r.hooks.Load().([]namedHooks)
+}
+
+// ContractCreated implements Hooks.
+func (r *HookRegistry) ContractCreated(scope HookScope, creator, contract common.Address, code []byte) {
+	for _, h := range r.list() {
+		h.hooks.ContractCreated(scope, creator, contract, code)
+	}
+}
+
+// CallEnter implements Hooks.
+func (r *HookRegistry) CallEnter(scope HookScope, typ OpCode, from, to common.Address, input []byte, gas uint64, value *big.Int) {
+	for _, h := range r.list() {
+		h.hooks.CallEnter(scope, typ, from, to, input, gas, value)
+	}
+}
+
+// CallExit implements Hooks.
+func (r *HookRegistry) CallExit(scope HookScope, output []byte, gasUsed uint64, err error) {
+	for _, h := range r.list() {
+		h.hooks.CallExit(scope, output, gasUsed, err)
+	}
+}
+
+// StorageWritten implements Hooks.
+func (r *HookRegistry) StorageWritten(scope HookScope, contract common.Address, key, value common.Hash) {
+	for _, h := range r.list() {
+		h.hooks.StorageWritten(scope, contract, key, value)
+	}
+}
+
+// LogEmitted implements Hooks.
+func (r *HookRegistry) LogEmitted(scope HookScope, log *types.Log) {
+	for _, h := range r.list() {
+		h.hooks.LogEmitted(scope, log)
+	}
+}
+
+// BlockCommitted implements Hooks.
+func (r *HookRegistry) BlockCommitted(block *types.Block) {
+	for _, h := range r.list() {
+		h.hooks.BlockCommitted(block)
+	}
+}
+
+// BlockRejected implements Hooks.
+func (r *HookRegistry) BlockRejected(block *types.Block, err error) {
+	for _, h := range r.list() {
+		h.hooks.BlockRejected(block, err)
+	}
+}
+
+// hookEvent is an EVM event recorded for later dispatch.
+type hookEvent struct {
+	scope    HookScope
+	dispatch func(hooks Hooks, scope HookScope)
+}
+
+// HookRecorder is the Hooks of the execution of a single block, buffering the
+// EVM events until the block is committed to the chain. They are dispatched
+// to the target hooks then, with the hash of the block, which is not known
+// before a block being sealed is. The events of blocks which are rejected, or
+// never sealed, are dropped.
+type HookRecorder struct {
+	target Hooks
+	events []hookEvent
+}
+
+// NewHookRecorder creates a recorder of the events of a block for the hooks.
+func NewHookRecorder(target Hooks) *HookRecorder {
+	return &HookRecorder{target: target}
+}
+
+// Copy returns a recorder with the events recorded so far, to be committed
+// separately.
+func (r *HookRecorder) Copy() *HookRecorder {
+	return &HookRecorder{
+		target: r.target,
+		events: append([]hookEvent(nil), r.events...),
+	}
+}
+
+// Snapshot returns an identifier of the events recorded so far.
+func (r *HookRecorder) Snapshot() int {
+	return len(r.events)
+}
+
+// RevertToSnapshot drops the events recorded since the snapshot, the ones of a
+// transaction left out of the block.
+func (r *HookRecorder) RevertToSnapshot(id int) {
+	r.events = r.events[:id]
+}
+
+// record buffers an event, unless the target is a registry without hooks.
+func (r *HookRecorder) record(scope HookScope, dispatch func(hooks Hooks, scope HookScope)) {
+	if registry, ok := r.target.(*HookRegistry); ok && len(registry.list()) == 0 {
+		return
+	}
+	r.events = append(r.events, hookEvent{scope, dispatch})
+}
+
+// ContractCreated implements Hooks.
+func (r *HookRecorder) ContractCreated(scope HookScope, creator, contract common.Address, code []byte) {
+	r.record(scope, func(hooks Hooks, scope HookScope) { hooks.ContractCreated(scope, creator, contract, code) })
+}
+
+// CallEnter implements Hooks.
+func (r *HookRecorder) CallEnter(scope HookScope, typ OpCode, from, to common.Address, input []byte, gas uint64, value *big.Int) {
+	r.record(scope, func(hooks Hooks, scope HookScope) { hooks.CallEnter(scope, typ, from, to, input, gas, value) })
+}
+
+// CallExit implements Hooks.
+func (r *HookRecorder) CallExit(scope HookScope, output []byte, gasUsed uint64, err error) {
+	r.record(scope, func(hooks Hooks, scope HookScope) { hooks.CallExit(scope, output, gasUsed, err) })
+}
+
+// StorageWritten implements Hooks.
+func (r *HookRecorder) StorageWritten(scope HookScope, contract common.Address, key, value common.Hash) {
+	r.record(scope, func(hooks Hooks, scope HookScope) { hooks.StorageWritten(scope, contract, key, value) })
+}
+
+// LogEmitted implements Hooks.
+func (r *HookRecorder) LogEmitted(scope HookScope, log *types.Log) {
+	r.record(scope, func(hooks Hooks, scope HookScope) { hooks.LogEmitted(scope, log) })
+}
+
+// BlockCommitted implements Hooks, dispatching the events recorded to the
+// target hooks.
+func (r *HookRecorder) BlockCommitted(block *types.Block) {
+	hash := block.Hash()
+	for _, event := range r.events {
+		event.scope.BlockHash = hash
+		event.dispatch(r.target, event.scope)
+	}
+	r.target.BlockCommitted(block)
+}
+
+// BlockRejected implements Hooks, the events recorded are not dispatched.
+func (r *HookRecorder) BlockRejected(block *types.Block, err error) {
+	r.target.BlockRejected(block, err)
+}
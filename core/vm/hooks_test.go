@@ -0,0 +1,183 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package vm
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/core/state"
+	"github.com/ethereum/quorum/core/types"
+	"github.com/ethereum/quorum/ethdb"
+	"github.com/ethereum/quorum/params"
+)
+
+// recordingHooks records the events it receives and their scopes.
+type recordingHooks struct {
+	events []string
+	scopes []HookScope
+}
+
+func (h *recordingHooks) record(scope HookScope, format string, args ...interface{}) {
+	h.events = append(h.events, fmt.Sprintf("%d %v ", scope.Depth, scope.Private)+fmt.Sprintf(format, args...))
+	h.scopes = append(h.scopes, scope)
+}
+
+func (h *recordingHooks) ContractCreated(scope HookScope, creator, contract common.Address, code []byte) {
+	h.record(scope, "created %x", code)
+}
+
+func (h *recordingHooks) CallEnter(scope HookScope, typ OpCode, from, to common.Address, input []byte, gas uint64, value *big.Int) {
+	h.record(scope, "enter %v", typ)
+}
+
+func (h *recordingHooks) CallExit(scope HookScope, output []byte, gasUsed uint64, err error) {
+	h.record(scope, "exit %v", err)
+}
+
+func (h *recordingHooks) StorageWritten(scope HookScope, contract common.Address, key, value common.Hash) {
+	h.record(scope, "store %x", value[len(value)-1:])
+}
+
+func (h *recordingHooks) LogEmitted(scope HookScope, log *types.Log) {
+	h.record(scope, "log %d", len(log.Topics))
+}
+
+func (h *recordingHooks) BlockCommitted(block *types.Block) {
+	h.events = append(h.events, fmt.Sprintf("committed %d", block.NumberU64()))
+}
+
+func (h *recordingHooks) BlockRejected(block *types.Block, err error) {
+	h.events = append(h.events, fmt.Sprintf("rejected %d %v", block.NumberU64(), err))
+}
+
+// Tests that the hooks receive the events of the public and private calls, and
+// that the registry dispatches them to the hooks registered.
+func TestHooks(t *testing.T) {
+	var (
+		publicState, _  = state.New(common.Hash{}, state.NewDatabase(ethdb.NewMemDatabase()))
+		privateState, _ = state.New(common.Hash{}, state.NewDatabase(ethdb.NewMemDatabase()))
+		public          = common.HexToAddress("0xaa")
+		private         = common.HexToAddress("0xbb")
+		registry        = NewHookRegistry()
+		first, second   = new(recordingHooks), new(recordingHooks)
+	)
+	// Store a value, emit a log and create an empty contract
+	code := []byte{
+		byte(PUSH1), 0x01, byte(PUSH1), 0x00, byte(SSTORE),
+		byte(PUSH1), 0x00, byte(PUSH1), 0x00, byte(LOG0),
+		byte(PUSH1), 0x00, byte(PUSH1), 0x00, byte(PUSH1), 0x00, byte(CREATE), byte(POP),
+		byte(STOP),
+	}
+	publicState.SetCode(public, code)
+	privateState.SetCode(private, code)
+
+	if err := registry.Register("first", first); err != nil {
+		t.Fatalf("failed to register hooks: %v", err)
+	}
+	if err := registry.Register("second", second); err != nil {
+		t.Fatalf("failed to register hooks: %v", err)
+	}
+	if err := registry.Register("first", second); err != ErrHookRegistered {
+		t.Fatalf("duplicate registration error mismatch: have %v, want %v", err, ErrHookRegistered)
+	}
+	if names := registry.Names(); !reflect.DeepEqual(names, []string{"first", "second"}) {
+		t.Fatalf("registered hooks mismatch: have %v", names)
+	}
+	context := Context{
+		CanTransfer: func(StateDB, common.Address, *big.Int) bool { return true },
+		Transfer:    func(StateDB, common.Address, common.Address, *big.Int) {},
+		BlockNumber: big.NewInt(1),
+	}
+	// A private transaction, then a public one which runs on the public state only
+	for _, tx := range []struct {
+		contract     common.Address
+		privateState StateDB
+	}{
+		{private, privateState},
+		{public, publicState},
+	} {
+		evm := NewEVM(context, publicState, tx.privateState, params.QuorumTestChainConfig, Config{Hooks: registry})
+		if _, _, err := evm.Call(AccountRef(common.Address{}), tx.contract, nil, 100000, new(big.Int)); err != nil {
+			t.Fatalf("failed to execute call: %v", err)
+		}
+		registry.Unregister("second")
+	}
+	var want []string
+	for _, private := range []bool{true, false} {
+		want = append(want,
+			fmt.Sprintf("1 %v enter CALL", private),
+			fmt.Sprintf("1 %v store 01", private),
+			fmt.Sprintf("1 %v log 0", private),
+			fmt.Sprintf("2 %v enter CREATE", private),
+			fmt.Sprintf("2 %v created ", private),
+			fmt.Sprintf("2 %v exit <nil>", private),
+			fmt.Sprintf("1 %v exit <nil>", private),
+		)
+	}
+	if !reflect.DeepEqual(first.events, want) {
+		t.Errorf("events mismatch:\nhave %q\nwant %q", first.events, want)
+	}
+	if !reflect.DeepEqual(second.events, want[:7]) {
+		t.Errorf("events of unregistered hooks mismatch:\nhave %q\nwant %q", second.events, want[:7])
+	}
+}
+
+// Tests that the recorder dispatches the events of a block once it is committed
+// with the hashes of the block and transactions, and drops the ones reverted or
+// of rejected blocks.
+func TestHookRecorder(t *testing.T) {
+	var (
+		target   = new(recordingHooks)
+		recorder = NewHookRecorder(target)
+		block    = types.NewBlockWithHeader(&types.Header{Number: big.NewInt(1)})
+		scope    = HookScope{TxHash: common.HexToHash("0x01"), TxIndex: 0, Depth: 1}
+	)
+	recorder.CallEnter(scope, CALL, common.Address{}, common.Address{}, nil, 0, nil)
+	recorder.CallExit(scope, nil, 0, nil)
+
+	snap := recorder.Snapshot()
+	recorder.CallEnter(HookScope{TxIndex: 1, Depth: 1}, CREATE, common.Address{}, common.Address{}, nil, 0, nil)
+	recorder.RevertToSnapshot(snap)
+
+	if len(target.events) != 0 {
+		t.Fatalf("events dispatched before the block is committed: %q", target.events)
+	}
+	recorder.Copy().BlockRejected(block, ErrDepth)
+
+	recorder.StorageWritten(scope, common.Address{}, common.Hash{}, common.BytesToHash([]byte{0x02}))
+	recorder.BlockCommitted(block)
+
+	want := []string{
+		fmt.Sprintf("rejected 1 %v", ErrDepth),
+		"1 false enter CALL",
+		"1 false exit <nil>",
+		"1 false store 02",
+		"committed 1",
+	}
+	if !reflect.DeepEqual(target.events, want) {
+		t.Errorf("events mismatch:\nhave %q\nwant %q", target.events, want)
+	}
+	for i, have := range target.scopes {
+		if have.BlockHash != block.Hash() || have.TxHash != scope.TxHash || have.TxIndex != scope.TxIndex {
+			t.Errorf("event %d: scope mismatch: have %+v", i, have)
+		}
+	}
+}
@@ -644,7 +644,11 @@ func opSstore(pc *uint64, interpreter *EVMInterpreter, contract *Contract, memor
 	loc := common.BigToHash(stack.pop())
 	val := stack.pop()
 	// Quorum: get public/private state db based on addr
-	getDualState(interpreter.evm, contract.Address()).SetState(contract.Address(), loc, common.BigToHash(val))
+	db := getDualState(interpreter.evm, contract.Address())
+	db.SetState(contract.Address(), loc, common.BigToHash(val))
+	if hooks := interpreter.cfg.Hooks; hooks != nil {
+		hooks.StorageWritten(interpreter.evm.hookScope(db, interpreter.evm.depth), contract.Address(), loc, common.BigToHash(val))
+	}
 
 	interpreter.intPool.put(val)
 	return nil, nil
@@ -908,14 +912,18 @@ func makeLog(size int) executionFunc {
 		}
 
 		d := memory.Get(mStart.Int64(), mSize.Int64())
-		interpreter.evm.StateDB.AddLog(&types.Log{
+		log := &types.Log{
 			Address: contract.Address(),
 			Topics:  topics,
 			Data:    d,
 			// This is a non-consensus field, but assigned here because
 			// core/state doesn't know the current block number.
 			BlockNumber: interpreter.evm.BlockNumber.Uint64(),
-		})
+		}
+		interpreter.evm.StateDB.AddLog(log)
+		if hooks := interpreter.cfg.Hooks; hooks != nil {
+			hooks.LogEmitted(interpreter.evm.hookScope(interpreter.evm.StateDB, interpreter.evm.depth), log)
+		}
 
 		interpreter.intPool.put(mStart, mSize)
 		return nil, nil
@@ -32,6 +32,8 @@ type Config struct {
 	Debug bool
 	// Tracer is the op code logger
 	Tracer Tracer
+	// Hooks receive the events of the execution, for instrumentation plugins
+	Hooks Hooks
 	// NoRecursion disabled Interpreter call, callcode,
 	// delegate call and create.
 	NoRecursion bool
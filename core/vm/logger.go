@@ -67,6 +67,10 @@ type StructLog struct {
 	Depth         int                         `json:"depth"`
 	RefundCounter uint64                      `json:"refund"`
 	Err           error                       `json:"-"`
+	// Quorum: the state the step runs in and whether a private contract is
+	// reading the public state
+	State          string `json:"state"`
+	QuorumReadOnly bool   `json:"quorumReadOnly"`
 }
 
 // overrides for gencodec
@@ -178,7 +182,7 @@ func (l *StructLogger) CaptureState(env *EVM, pc uint64, op OpCode, gas, cost ui
 		storage = l.changedValues[contract.Address()].Copy()
 	}
 	// create a new snaptshot of the EVM.
-	log := StructLog{pc, op, gas, cost, mem, memory.Len(), stck, storage, depth, env.StateDB.GetRefund(), err}
+	log := StructLog{pc, op, gas, cost, mem, memory.Len(), stck, storage, depth, env.StateDB.GetRefund(), err, env.StateName(), env.QuorumReadOnly()}
 
 	l.logs = append(l.logs, log)
 	return nil
@@ -22,6 +22,7 @@ import (
 
 	"github.com/ethereum/quorum/common"
 	"github.com/ethereum/quorum/core/state"
+	"github.com/ethereum/quorum/ethdb"
 	"github.com/ethereum/quorum/params"
 )
 
@@ -69,3 +70,32 @@ func TestStoreCapture(t *testing.T) {
 		t.Errorf("expected %x, got %x", exp, logger.changedValues[contract.Address()][index])
 	}
 }
+
+func TestStructLoggerState(t *testing.T) {
+	var (
+		publicState, _  = state.New(common.Hash{}, state.NewDatabase(ethdb.NewMemDatabase()))
+		privateState, _ = state.New(common.Hash{}, state.NewDatabase(ethdb.NewMemDatabase()))
+		env             = NewEVM(Context{}, publicState, privateState, params.QuorumTestChainConfig, Config{})
+		logger          = NewStructLogger(nil)
+		contract        = NewContract(&dummyContractRef{}, &dummyContractRef{}, new(big.Int), 0)
+	)
+	// a private contract reads the public state and returns
+	logger.CaptureState(env, 0, STOP, 0, 0, NewMemory(), newstack(), contract, 1, nil)
+	env.Push(publicState)
+	logger.CaptureState(env, 0, STOP, 0, 0, NewMemory(), newstack(), contract, 2, nil)
+	env.Pop()
+	logger.CaptureState(env, 0, STOP, 0, 0, NewMemory(), newstack(), contract, 1, nil)
+
+	for i, want := range []struct {
+		state    string
+		readOnly bool
+	}{
+		{PrivateStateName, false},
+		{PublicStateName, true},
+		{PrivateStateName, false},
+	} {
+		if log := logger.StructLogs()[i]; log.State != want.state || log.QuorumReadOnly != want.readOnly {
+			t.Errorf("log %d: have state %s, read only %v, want %s, %v", i, log.State, log.QuorumReadOnly, want.state, want.readOnly)
+		}
+	}
+}
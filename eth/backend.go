@@ -122,7 +122,7 @@ func New(ctx *node.ServiceContext, config *Config) (*Ethereum, error) {
 		config.MinerGasPrice = new(big.Int).Set(DefaultConfig.MinerGasPrice)
 	}
 	// Assemble the Ethereum object
-	chainDb, err := CreateDB(ctx, config, "chaindata")
+	chainDb, err := ctx.OpenDatabaseWithFreezer("chaindata", config.DatabaseCache, config.DatabaseHandles, config.DatabaseFreezer, "eth/db/chaindata/")
 	if err != nil {
 		return nil, err
 	}
@@ -180,8 +180,11 @@ func New(ctx *node.ServiceContext, config *Config) (*Ethereum, error) {
 			EWASMInterpreter:        config.EWASMInterpreter,
 			EVMInterpreter:          config.EVMInterpreter,
 		}
-		cacheConfig = &core.CacheConfig{Disabled: config.NoPruning, TrieNodeLimit: config.TrieCache, TrieTimeLimit: config.TrieTimeout}
+		cacheConfig = &core.CacheConfig{Disabled: config.NoPruning, TrieNodeLimit: config.TrieCache, TrieTimeLimit: config.TrieTimeout, Snapshots: config.Snapshot}
 	)
+	if ctx.EVMHooks != nil {
+		vmConfig.Hooks = ctx.EVMHooks
+	}
 	eth.blockchain, err = core.NewBlockChain(chainDb, cacheConfig, eth.chainConfig, eth.engine, vmConfig, eth.shouldPreserve)
 	if err != nil {
 		return nil, err
@@ -259,6 +262,14 @@ func CreateConsensusEngine(ctx *node.ServiceContext, chainConfig *params.ChainCo
 		}
 		config.Istanbul.ProposerPolicy = istanbul.ProposerPolicy(chainConfig.Istanbul.ProposerPolicy)
 		config.Istanbul.Ceil2Nby3Block = chainConfig.Istanbul.Ceil2Nby3Block
+		config.Istanbul.ValidatorSetBlock = chainConfig.Istanbul.ValidatorSetBlock
+		config.Istanbul.BlockPeriods = make([]istanbul.BlockPeriod, len(chainConfig.Istanbul.BlockPeriods))
+		for i, transition := range chainConfig.Istanbul.BlockPeriods {
+			config.Istanbul.BlockPeriods[i] = istanbul.BlockPeriod{Block: transition.Block, Period: transition.Period}
+		}
+		// A raft network switches over to Istanbul at the fork block
+		config.Istanbul.ForkBlock = chainConfig.IstanbulBlock
+		config.Istanbul.ForkValidators = chainConfig.IstanbulValidators
 
 		return istanbulBackend.New(&config.Istanbul, ctx.NodeKey(), db)
 	}
@@ -540,6 +551,12 @@ func (s *Ethereum) Start(srvr *p2p.Server) error {
 	if s.lesServer != nil {
 		s.lesServer.Start(srvr)
 	}
+	// Let Istanbul validators discover each other and keep direct connections
+	if istanbul, ok := s.engine.(consensus.Istanbul); ok {
+		if err := istanbul.StartAnnouncing(s.blockchain, srvr); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -548,6 +565,9 @@ func (s *Ethereum) Start(srvr *p2p.Server) error {
 func (s *Ethereum) Stop() error {
 	s.bloomIndexer.Close()
 	s.blockchain.Stop()
+	if istanbul, ok := s.engine.(consensus.Istanbul); ok {
+		istanbul.StopAnnouncing()
+	}
 	s.engine.Close()
 	s.protocolManager.Stop()
 	if s.lesServer != nil {
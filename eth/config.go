@@ -97,8 +97,10 @@ type Config struct {
 	SkipBcVersionCheck bool `toml:"-"`
 	DatabaseHandles    int  `toml:"-"`
 	DatabaseCache      int
+	DatabaseFreezer    string // Directory of the ancient chain data, inside the chain database by default
 	TrieCache          int
 	TrieTimeout        time.Duration
+	Snapshot           bool // Whether to read the states through flat snapshots
 
 	// Mining-related options
 	Etherbase      common.Address `toml:",omitempty"`
@@ -225,11 +225,12 @@ func (pm *ProtocolManager) Start(maxPeers int) {
 	pm.txsSub = pm.txpool.SubscribeNewTxsEvent(pm.txsCh)
 	go pm.txBroadcastLoop()
 
-	if !pm.raftMode {
+	if !pm.raftMode || pm.chainconfig.IstanbulBlock != nil {
 		// broadcast mined blocks
 		pm.minedBlockSub = pm.eventMux.Subscribe(core.NewMinedBlockEvent{})
 		go pm.minedBroadcastLoop()
-	} else {
+	}
+	if pm.raftMode {
 		// We set this immediately in raft mode to make sure the miner never drops
 		// incoming txes. Raft mode doesn't use the fetcher or downloader, and so
 		// this would never be set otherwise.
@@ -245,7 +246,7 @@ func (pm *ProtocolManager) Stop() {
 	log.Info("Stopping Ethereum protocol")
 
 	pm.txsSub.Unsubscribe() // quits txBroadcastLoop
-	if !pm.raftMode {
+	if pm.minedBlockSub != nil {
 		pm.minedBlockSub.Unsubscribe() // quits blockBroadcastLoop
 	}
 
@@ -352,7 +353,7 @@ func (pm *ProtocolManager) handleMsg(p *peer) error {
 	}
 	defer msg.Discard()
 
-	if pm.raftMode {
+	if pm.isRaft() {
 		if msg.Code != TxMsg &&
 			msg.Code != GetBlockHeadersMsg && msg.Code != BlockHeadersMsg &&
 			msg.Code != GetBlockBodiesMsg && msg.Code != BlockBodiesMsg {
@@ -724,7 +725,10 @@ func (pm *ProtocolManager) handleMsg(p *peer) error {
 			}
 			p.MarkTransaction(tx.Hash())
 		}
-		pm.txpool.AddRemotes(txs)
+		// Disconnect peers flooding the pool
+		if _, err := pm.txpool.AddRemotesFrom(p.id, txs); err != nil {
+			return errResp(ErrTxRateLimited, "%v", err)
+		}
 
 	default:
 		return errResp(ErrInvalidMsgCode, "%v", msg.Code)
@@ -806,6 +810,10 @@ func (pm *ProtocolManager) minedBroadcastLoop() {
 	// automatically stops if unsubscribe
 	for obj := range pm.minedBlockSub.Chan() {
 		if ev, ok := obj.Data.(core.NewMinedBlockEvent); ok {
+			// Blocks minted by raft are propagated by raft itself
+			if pm.raftMode && !pm.chainconfig.IsIstanbul(ev.Block.Number()) {
+				continue
+			}
 			pm.BroadcastBlock(ev.Block, true)  // First propagate block to peers
 			pm.BroadcastBlock(ev.Block, false) // Only then announce to the rest
 		}
@@ -850,9 +858,19 @@ func (pm *ProtocolManager) NodeInfo() *NodeInfo {
 	}
 }
 
+// isRaft returns whether raft is responsible for the next block, i.e. the node
+// runs in raft mode and the chain has not reached the Istanbul fork yet.
+func (pm *ProtocolManager) isRaft() bool {
+	if !pm.raftMode {
+		return false
+	}
+	next := new(big.Int).Add(pm.blockchain.CurrentBlock().Number(), common.Big1)
+	return !pm.chainconfig.IsIstanbul(next)
+}
+
 func (pm *ProtocolManager) getConsensusAlgorithm() string {
 	var consensusAlgo string
-	if pm.raftMode { // raft does not use consensus interface
+	if pm.isRaft() { // raft does not use consensus interface
 		consensusAlgo = "raft"
 	} else {
 		switch pm.engine.(type) {
@@ -880,3 +898,12 @@ func (self *ProtocolManager) FindPeers(targets map[common.Address]bool) map[comm
 	}
 	return m
 }
+
+func (self *ProtocolManager) AllPeers() map[common.Address]consensus.Peer {
+	m := make(map[common.Address]consensus.Peer)
+	for _, p := range self.peers.Peers() {
+		pubKey := p.Node().Pubkey()
+		m[crypto.PubkeyToAddress(*pubKey)] = p
+	}
+	return m
+}
@@ -149,6 +149,8 @@ type testTxPool struct {
 	pool   []*types.Transaction        // Collection of all transactions
 	added  chan<- []*types.Transaction // Notification channel for new transactions
 
+	rateLimited bool // Whether peers are over their rate limit
+
 	lock sync.RWMutex // Protects the transaction pool
 }
 
@@ -165,6 +167,15 @@ func (p *testTxPool) AddRemotes(txs []*types.Transaction) []error {
 	return make([]error, len(txs))
 }
 
+// AddRemotesFrom appends a batch of transactions relayed by a peer to the pool
+// unless peers are rate limited.
+func (p *testTxPool) AddRemotesFrom(peer string, txs []*types.Transaction) ([]error, error) {
+	if p.rateLimited {
+		return nil, core.ErrPeerRateLimited
+	}
+	return p.AddRemotes(txs), nil
+}
+
 // Pending returns all the transactions known to the pool
 func (p *testTxPool) Pending() (map[common.Address]types.Transactions, error) {
 	p.lock.RLock()
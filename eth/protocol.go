@@ -76,6 +76,7 @@ const (
 	ErrNoStatusMsg
 	ErrExtraStatusMsg
 	ErrSuspendedPeer
+	ErrTxRateLimited
 )
 
 func (e errCode) String() string {
@@ -93,12 +94,18 @@ var errorToString = map[int]string{
 	ErrNoStatusMsg:             "No status message",
 	ErrExtraStatusMsg:          "Extra status message",
 	ErrSuspendedPeer:           "Suspended peer",
+	ErrTxRateLimited:           "Transaction rate limit exceeded",
 }
 
 type txPool interface {
 	// AddRemotes should add the given transactions to the pool.
 	AddRemotes([]*types.Transaction) []error
 
+	// AddRemotesFrom should add the given transactions relayed by the peer to
+	// the pool within its admission rate, and fail if the peer is consistently
+	// over it.
+	AddRemotesFrom(peer string, txs []*types.Transaction) ([]error, error)
+
 	// Pending should return pending transactions.
 	// The slice should be modifiable by the caller.
 	Pending() (map[common.Address]types.Transactions, error)
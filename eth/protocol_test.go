@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/core"
 	"github.com/ethereum/quorum/core/types"
 	"github.com/ethereum/quorum/crypto"
 	"github.com/ethereum/quorum/eth/downloader"
@@ -120,6 +121,30 @@ func testRecvTransactions(t *testing.T, protocol int) {
 	}
 }
 
+// This test checks that peers relaying transactions over their rate limit are
+// disconnected.
+func TestRecvTransactionsRateLimited(t *testing.T) {
+	pm, _ := newTestProtocolManagerMust(t, downloader.FullSync, 0, nil, nil)
+	pm.acceptTxs = 1 // mark synced to accept transactions
+	pm.txpool.(*testTxPool).rateLimited = true
+	p, errc := newTestPeer("peer", 63, pm, true)
+	defer pm.Stop()
+	defer p.close()
+
+	tx := newTestTransaction(testAccount, 0, 0)
+	if err := p2p.Send(p.app, TxMsg, []interface{}{tx}); err != nil {
+		t.Fatalf("send error: %v", err)
+	}
+	select {
+	case err := <-errc:
+		if want := errResp(ErrTxRateLimited, "%v", core.ErrPeerRateLimited); err == nil || err.Error() != want.Error() {
+			t.Errorf("wrong disconnect error: got %v, want %v", err, want)
+		}
+	case <-time.After(2 * time.Second):
+		t.Errorf("peer not disconnected within 2 seconds")
+	}
+}
+
 // This test checks that pending transactions are sent.
 func TestSendTransactions62(t *testing.T) { testSendTransactions(t, 62) }
 func TestSendTransactions63(t *testing.T) { testSendTransactions(t, 63) }
@@ -148,12 +148,12 @@ func (pm *ProtocolManager) syncer() {
 			if pm.peers.Len() < minDesiredPeerCount {
 				break
 			}
-			if !pm.raftMode {
+			if !pm.isRaft() {
 				go pm.synchronise(pm.peers.BestPeer())
 			}
 
 		case <-forceSync.C:
-			if !pm.raftMode {
+			if !pm.isRaft() {
 				// Force a sync even if not enough peers are present
 				go pm.synchronise(pm.peers.BestPeer())
 			}
@@ -0,0 +1,127 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracers
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ethereum/quorum/common/hexutil"
+	"github.com/ethereum/quorum/core/vm"
+)
+
+// ResultTracer is a vm.Tracer whose result is returned by the debug_trace*
+// APIs. It is implemented by the JavaScript Tracer and the native tracers.
+type ResultTracer interface {
+	vm.Tracer
+
+	// GetResult returns the JSON encoded result of the trace.
+	GetResult() (json.RawMessage, error)
+
+	// Stop interrupts the trace, GetResult returns err afterwards.
+	Stop(err error)
+}
+
+var (
+	nativeLock sync.RWMutex
+	native     = make(map[string]func() ResultTracer) // native tracer constructors by name
+)
+
+// RegisterNative makes a tracer implemented in Go selectable by name. Native
+// tracers take precedence over the JavaScript tracers of the same name.
+func RegisterNative(name string, ctor func() ResultTracer) {
+	nativeLock.Lock()
+	defer nativeLock.Unlock()
+
+	if _, ok := native[name]; ok {
+		panic(fmt.Sprintf("native tracer %s registered twice", name))
+	}
+	native[name] = ctor
+}
+
+// NewTracer creates the native tracer registered under the name, or else a
+// JavaScript tracer from the name of a built in tracer or from code.
+func NewTracer(code string) (ResultTracer, error) {
+	nativeLock.RLock()
+	ctor, ok := native[code]
+	nativeLock.RUnlock()
+
+	if ok {
+		return ctor(), nil
+	}
+	return New(code)
+}
+
+// interruptible implements Stop for the native tracers.
+type interruptible struct {
+	interrupt uint32 // Atomic flag to signal execution interruption
+	reason    error  // Textual reason for the interruption
+}
+
+// Stop terminates the tracing at the first opportune moment.
+func (i *interruptible) Stop(err error) {
+	i.reason = err
+	atomic.StoreUint32(&i.interrupt, 1)
+}
+
+// stopped reports whether the tracing was interrupted.
+func (i *interruptible) stopped() bool {
+	return atomic.LoadUint32(&i.interrupt) > 0
+}
+
+// hexBig encodes the number like the JavaScript tracers, '0x' + n.toString(16).
+func hexBig(n *big.Int) string {
+	if n == nil {
+		return "0x0"
+	}
+	if n.Sign() < 0 {
+		return "0x-" + new(big.Int).Neg(n).Text(16)
+	}
+	return hexutil.EncodeBig(n)
+}
+
+// hexInt encodes the result of gas arithmetic like the JavaScript tracers,
+// which keep the sign after the prefix.
+func hexInt(n int64) string {
+	if n < 0 {
+		return "0x-" + fmt.Sprintf("%x", -n)
+	}
+	return fmt.Sprintf("0x%x", n)
+}
+
+// peekStack returns the nth-from-the-top element of the stack, zero if the
+// stack is too small, like the stack wrapper of the JavaScript tracers.
+func peekStack(stack *vm.Stack, n int) *big.Int {
+	data := stack.Data()
+	if len(data) <= n {
+		return new(big.Int)
+	}
+	return data[len(data)-n-1]
+}
+
+// sliceMemory returns the memory in [offset, offset+size), nil if it is out of
+// bounds, like the memory wrapper of the JavaScript tracers.
+func sliceMemory(memory *vm.Memory, offset, size *big.Int) []byte {
+	end := new(big.Int).Add(offset, size)
+	if !end.IsUint64() || end.Uint64() > uint64(memory.Len()) {
+		return nil
+	}
+	return memory.Get(offset.Int64(), size.Int64())
+}
@@ -0,0 +1,276 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracers
+
+import (
+	"encoding/json"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/common/hexutil"
+	"github.com/ethereum/quorum/core/vm"
+)
+
+func init() {
+	RegisterNative("callTracer", func() ResultTracer { return newCallTracer() })
+}
+
+// callFrame is a call of the trace, its fields are encoded in the order of
+// the JavaScript callTracer with the Quorum fields added.
+type callFrame struct {
+	Type    string `json:"type"`
+	From    string `json:"from,omitempty"`
+	To      string `json:"to,omitempty"`
+	Value   string `json:"value,omitempty"`
+	Gas     string `json:"gas,omitempty"`
+	GasUsed string `json:"gasUsed,omitempty"`
+	Input   string `json:"input,omitempty"`
+	Output  string `json:"output,omitempty"`
+	Error   string `json:"error,omitempty"`
+
+	// Quorum: the state the call ran in, unknown for calls which were not
+	// stepped into, and whether a private contract read the public state
+	State          string `json:"state,omitempty"`
+	QuorumReadOnly bool   `json:"quorumReadOnly,omitempty"`
+
+	Time  string       `json:"time,omitempty"`
+	Calls []*callFrame `json:"calls,omitempty"`
+
+	gasIn   uint64   // gas available before the call opcode
+	gasCost uint64   // cost of the call opcode
+	gas     *uint64  // gas given to the call, nil for calls to plain accounts
+	outOff  *big.Int // memory offset of the call output
+	outLen  *big.Int // memory size of the call output
+}
+
+// callTracer is the native implementation of callTracer, it reports all the
+// internal calls made by a transaction. Unlike the JavaScript callTracer, it
+// also reports the public or private state each call ran in.
+type callTracer struct {
+	interruptible
+
+	callstack []*callFrame // current recursive call stack of the EVM execution
+	descended bool         // whether we've just descended into an inner call
+
+	ctx callFrame // outer call, completed by CaptureEnd
+	err error     // error aborting the trace
+}
+
+func newCallTracer() *callTracer {
+	return &callTracer{callstack: []*callFrame{{}}}
+}
+
+// CaptureStart implements the Tracer interface to initialize the tracing operation.
+func (t *callTracer) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
+	t.ctx = callFrame{
+		Type:  "CALL",
+		From:  hexutil.Encode(from[:]),
+		To:    hexutil.Encode(to[:]),
+		Value: hexBig(value),
+		Gas:   hexInt(int64(gas)),
+		Input: hexutil.Encode(input),
+	}
+	if create {
+		t.ctx.Type = "CREATE"
+	}
+	return nil
+}
+
+// CaptureState implements the Tracer interface to trace a single step of VM execution.
+func (t *callTracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	if t.err != nil {
+		return nil
+	}
+	if t.stopped() {
+		t.err = t.reason
+		return nil
+	}
+	// The outer call runs in the state of the first step
+	if t.ctx.State == "" {
+		t.ctx.State, t.ctx.QuorumReadOnly = env.StateName(), env.QuorumReadOnly()
+	}
+	// Capture any errors immediately
+	if err != nil {
+		t.fault(err)
+		return nil
+	}
+	syscall := op&0xf0 == 0xf0
+
+	switch {
+	case syscall && op == vm.CREATE:
+		// If a new contract is being created, add to the call stack
+		t.callstack = append(t.callstack, &callFrame{
+			Type:    op.String(),
+			From:    hexutil.Encode(contract.Address().Bytes()),
+			Input:   hexutil.Encode(sliceMemory(memory, peekStack(stack, 1), peekStack(stack, 2))),
+			Value:   hexBig(peekStack(stack, 0)),
+			gasIn:   gas,
+			gasCost: cost,
+		})
+		t.descended = true
+		return nil
+
+	case syscall && op == vm.SELFDESTRUCT:
+		// If a contract is being self destructed, gather that as a subcall too
+		parent := t.callstack[len(t.callstack)-1]
+		parent.Calls = append(parent.Calls, &callFrame{Type: op.String(), State: env.StateName(), QuorumReadOnly: env.QuorumReadOnly()})
+		return nil
+
+	case syscall && (op == vm.CALL || op == vm.CALLCODE || op == vm.DELEGATECALL || op == vm.STATICCALL):
+		// Skip any pre-compile invocations, those are just fancy opcodes
+		to := common.BigToAddress(peekStack(stack, 1))
+		if _, ok := vm.PrecompiledContractsByzantium[to]; ok {
+			return nil
+		}
+		off := 1
+		if op == vm.DELEGATECALL || op == vm.STATICCALL {
+			off = 0
+		}
+		call := &callFrame{
+			Type:    op.String(),
+			From:    hexutil.Encode(contract.Address().Bytes()),
+			To:      hexutil.Encode(to[:]),
+			Input:   hexutil.Encode(sliceMemory(memory, peekStack(stack, 2+off), peekStack(stack, 3+off))),
+			gasIn:   gas,
+			gasCost: cost,
+			outOff:  new(big.Int).Set(peekStack(stack, 4+off)),
+			outLen:  new(big.Int).Set(peekStack(stack, 5+off)),
+		}
+		if off == 1 {
+			call.Value = hexBig(peekStack(stack, 2))
+		}
+		t.callstack = append(t.callstack, call)
+		t.descended = true
+		return nil
+	}
+	// If we've just descended into an inner call, retrieve it's true allowance. We
+	// need to extract if from within the call as there may be funky gas dynamics
+	// with regard to requested and actually given gas (2300 stipend, 63/64 rule).
+	// Calls to plain accounts are not stepped into, their gas is unknown.
+	if t.descended {
+		if depth >= len(t.callstack) {
+			call := t.callstack[len(t.callstack)-1]
+			given := gas
+			call.gas = &given
+			call.State, call.QuorumReadOnly = env.StateName(), env.QuorumReadOnly()
+		}
+		t.descended = false
+	}
+	if syscall && op == vm.REVERT {
+		t.callstack[len(t.callstack)-1].Error = "execution reverted"
+		return nil
+	}
+	if depth == len(t.callstack)-1 {
+		// Pop off the last call and get the execution results
+		call := t.callstack[len(t.callstack)-1]
+		t.callstack = t.callstack[:len(t.callstack)-1]
+
+		ret := peekStack(stack, 0)
+		if call.Type == vm.CREATE.String() {
+			// If the call was a CREATE, retrieve the contract address and output code
+			call.GasUsed = hexInt(int64(call.gasIn - call.gasCost - gas))
+
+			if ret.Sign() != 0 {
+				addr := common.BigToAddress(ret)
+				call.To = hexutil.Encode(addr[:])
+				call.Output = hexutil.Encode(env.StateDB.GetCode(addr))
+			} else if call.Error == "" {
+				call.Error = "internal failure"
+			}
+		} else if call.gas != nil {
+			// If the call was a contract call, retrieve the gas usage and output
+			call.GasUsed = hexInt(int64(call.gasIn - call.gasCost + *call.gas - gas))
+
+			if ret.Sign() != 0 {
+				call.Output = hexutil.Encode(sliceMemory(memory, call.outOff, call.outLen))
+			} else if call.Error == "" {
+				call.Error = "internal failure"
+			}
+		}
+		if call.gas != nil {
+			call.Gas = hexInt(int64(*call.gas))
+		}
+		// Inject the call into the previous one
+		parent := t.callstack[len(t.callstack)-1]
+		parent.Calls = append(parent.Calls, call)
+	}
+	return nil
+}
+
+// CaptureFault implements the Tracer interface to trace an execution fault
+// while running an opcode.
+func (t *callTracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	if t.err == nil {
+		t.fault(err)
+	}
+	return nil
+}
+
+// fault handles the failure of an opcode.
+func (t *callTracer) fault(err error) {
+	// If the topmost call already reverted, don't handle the additional fault again
+	if t.callstack[len(t.callstack)-1].Error != "" {
+		return
+	}
+	// Pop off the just failed call
+	call := t.callstack[len(t.callstack)-1]
+	t.callstack = t.callstack[:len(t.callstack)-1]
+	call.Error = err.Error()
+
+	// Consume all available gas
+	if call.gas != nil {
+		call.Gas = hexInt(int64(*call.gas))
+		call.GasUsed = call.Gas
+	}
+	// Flatten the failed call into its parent
+	if len(t.callstack) > 0 {
+		parent := t.callstack[len(t.callstack)-1]
+		parent.Calls = append(parent.Calls, call)
+		return
+	}
+	// Last call failed too, leave it in the stack
+	t.callstack = append(t.callstack, call)
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *callTracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) error {
+	t.ctx.Output = hexutil.Encode(output)
+	t.ctx.GasUsed = hexInt(int64(gasUsed))
+	t.ctx.Time = d.String()
+	if err != nil {
+		t.ctx.Error = err.Error()
+	}
+	return nil
+}
+
+// GetResult returns the outer call with all its internal calls, or the error
+// which aborted the trace.
+func (t *callTracer) GetResult() (json.RawMessage, error) {
+	if t.err != nil {
+		return nil, t.err
+	}
+	result := t.ctx
+	result.Calls = t.callstack[0].Calls
+	if t.callstack[0].Error != "" {
+		result.Error = t.callstack[0].Error
+	}
+	if result.Error != "" {
+		result.Output = ""
+	}
+	return json.Marshal(&result)
+}
@@ -0,0 +1,186 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracers
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/common/hexutil"
+	"github.com/ethereum/quorum/core/vm"
+	"github.com/ethereum/quorum/crypto"
+)
+
+func init() {
+	RegisterNative("prestateTracer", func() ResultTracer { return newPrestateTracer() })
+}
+
+// errNoPrestate is returned if no opcode was executed, like the failure of the
+// JavaScript prestateTracer.
+var errNoPrestate = errors.New("prestate unavailable, no code was executed")
+
+// prestateAccount is an account of the prestate, its fields are encoded in the
+// order of the JavaScript prestateTracer.
+type prestateAccount struct {
+	Balance *big.Int          `json:"-"`
+	Nonce   int64             `json:"nonce"`
+	Code    string            `json:"code"`
+	Storage map[string]string `json:"storage"`
+}
+
+func (acc *prestateAccount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Balance string            `json:"balance"`
+		Nonce   int64             `json:"nonce"`
+		Code    string            `json:"code"`
+		Storage map[string]string `json:"storage"`
+	}{hexBig(acc.Balance), acc.Nonce, acc.Code, acc.Storage})
+}
+
+// prestateTracer is the native implementation of prestateTracer, it outputs
+// the accounts and storage a transaction accessed before it was executed.
+type prestateTracer struct {
+	interruptible
+
+	prestate map[string]*prestateAccount // genesis alloc being built, nil before the first step
+	db       vm.StateDB                  // state the accounts are looked up in
+
+	create bool
+	from   common.Address
+	to     common.Address
+	value  *big.Int
+
+	err error // error aborting the trace
+}
+
+func newPrestateTracer() *prestateTracer {
+	return &prestateTracer{}
+}
+
+// lookupAccount injects the specified account into the prestate.
+func (t *prestateTracer) lookupAccount(addr common.Address) {
+	acc := hexutil.Encode(addr[:])
+	if _, ok := t.prestate[acc]; ok {
+		return
+	}
+	t.prestate[acc] = &prestateAccount{
+		Balance: new(big.Int).Set(t.db.GetBalance(addr)),
+		Nonce:   int64(t.db.GetNonce(addr)),
+		Code:    hexutil.Encode(t.db.GetCode(addr)),
+		Storage: make(map[string]string),
+	}
+}
+
+// lookupStorage injects the specified storage entry of the given account into
+// the prestate.
+func (t *prestateTracer) lookupStorage(addr common.Address, key common.Hash) {
+	storage := t.prestate[hexutil.Encode(addr[:])].Storage
+	idx := hexutil.Encode(key[:])
+	if _, ok := storage[idx]; ok {
+		return
+	}
+	if val := t.db.GetState(addr, key); val != (common.Hash{}) {
+		storage[idx] = hexutil.Encode(val[:])
+	}
+}
+
+// CaptureStart implements the Tracer interface to initialize the tracing operation.
+func (t *prestateTracer) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
+	t.create, t.from, t.to, t.value = create, from, to, value
+	return nil
+}
+
+// CaptureState implements the Tracer interface to trace a single step of VM execution.
+func (t *prestateTracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	if t.err != nil {
+		return nil
+	}
+	if t.stopped() {
+		t.err = t.reason
+		return nil
+	}
+	t.db = env.StateDB
+
+	// Add the current account if we just started tracing
+	if t.prestate == nil {
+		t.prestate = make(map[string]*prestateAccount)
+		// Balance will potentially be wrong here, since this will include the value
+		// sent along with the message. We fix that in GetResult.
+		t.lookupAccount(contract.Address())
+	}
+	// Whenever new state is accessed, add it to the prestate
+	switch op {
+	case vm.EXTCODECOPY, vm.EXTCODESIZE, vm.BALANCE:
+		t.lookupAccount(common.BigToAddress(peekStack(stack, 0)))
+	case vm.CREATE:
+		from := contract.Address()
+		t.lookupAccount(crypto.CreateAddress(from, t.db.GetNonce(from)))
+	case vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL:
+		t.lookupAccount(common.BigToAddress(peekStack(stack, 1)))
+	case vm.SSTORE, vm.SLOAD:
+		t.lookupStorage(contract.Address(), common.BigToHash(peekStack(stack, 0)))
+	}
+	return nil
+}
+
+// CaptureFault implements the Tracer interface to trace an execution fault
+// while running an opcode.
+func (t *prestateTracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	return nil
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *prestateTracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) error {
+	return nil
+}
+
+// GetResult returns the assembled prestate, or the error which aborted the
+// trace.
+func (t *prestateTracer) GetResult() (json.RawMessage, error) {
+	if t.err != nil {
+		return nil, t.err
+	}
+	if t.prestate == nil {
+		return nil, errNoPrestate
+	}
+	// At this point, we need to deduct the 'value' from the outer transaction,
+	// and move it back to the origin
+	t.lookupAccount(t.from)
+	t.lookupAccount(t.to)
+
+	from, to := hexutil.Encode(t.from[:]), hexutil.Encode(t.to[:])
+	fromBal, toBal := t.prestate[from].Balance, t.prestate[to].Balance
+
+	value := t.value
+	if value == nil {
+		value = new(big.Int)
+	}
+	t.prestate[to].Balance = new(big.Int).Sub(toBal, value)
+	t.prestate[from].Balance = new(big.Int).Add(fromBal, value)
+
+	// Decrement the caller's nonce, and remove empty create targets
+	t.prestate[from].Nonce--
+	if t.create {
+		// We can blindly delete the contract prestate, as any existing state would
+		// have caused the transaction to be rejected as invalid in the first place.
+		delete(t.prestate, to)
+	}
+	return json.Marshal(t.prestate)
+}
@@ -0,0 +1,216 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracers
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/core"
+	"github.com/ethereum/quorum/core/state"
+	"github.com/ethereum/quorum/core/vm"
+	"github.com/ethereum/quorum/ethdb"
+	"github.com/ethereum/quorum/params"
+)
+
+func TestNewTracer(t *testing.T) {
+	for name, want := range map[string]interface{}{
+		"callTracer":     &callTracer{},
+		"prestateTracer": &prestateTracer{},
+		"4byteTracer":    &Tracer{},
+		"{step: function() {}, fault: function() {}, result: function() { return null; }}": &Tracer{},
+	} {
+		tracer, err := NewTracer(name)
+		if err != nil {
+			t.Fatalf("%s: failed to create tracer: %v", name, err)
+		}
+		if reflect.TypeOf(tracer) != reflect.TypeOf(want) {
+			t.Errorf("%s: tracer type mismatch: have %T, want %T", name, tracer, want)
+		}
+	}
+	if _, err := NewTracer("unknownTracer"); err == nil {
+		t.Errorf("unknown tracer created")
+	}
+}
+
+// Tests that the native tracers produce the same results as the JavaScript
+// tracers of the same name, apart from the execution time and the states.
+func TestNativeTracersMatchJavaScript(t *testing.T) {
+	for _, name := range []string{"callTracer", "prestateTracer"} {
+		for _, file := range callTracerTests(t) {
+			test, err := loadCallTracerTest(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			jsTracer, err := New(name)
+			if err != nil {
+				t.Fatalf("failed to create tracer: %v", err)
+			}
+			want, err := runCallTracerTest(test, jsTracer)
+			if err != nil {
+				t.Fatalf("%s %s: %v", name, file, err)
+			}
+			have, err := runCallTracerTest(test, native[name]())
+			if err != nil {
+				t.Fatalf("%s %s: %v", name, file, err)
+			}
+			// Field order is kept for the calls, not for the prestate accounts
+			if name == "callTracer" {
+				have, want = stripExtras(t, have), stripExtras(t, want)
+				if string(have) != string(want) {
+					t.Errorf("%s %s: result mismatch:\nhave %s\nwant %s", name, file, have, want)
+				}
+				continue
+			}
+			var haveObj, wantObj interface{}
+			if err := json.Unmarshal(have, &haveObj); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal(want, &wantObj); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(haveObj, wantObj) {
+				t.Errorf("%s %s: result mismatch:\nhave %s\nwant %s", name, file, have, want)
+			}
+		}
+	}
+}
+
+// quorumFields matches the execution time and the Quorum additions of a
+// callTracer result, which the JavaScript tracer doesn't report.
+var quorumFields = regexp.MustCompile(`,"(time|state)":"[^"]*"|,"quorumReadOnly":true`)
+
+// stripTime removes the execution time and the Quorum additions from a
+// callTracer result.
+func stripExtras(t *testing.T, result json.RawMessage) json.RawMessage {
+	if !strings.Contains(string(result), `,"time":"`) {
+		t.Fatalf("no time in result %s", result)
+	}
+	return json.RawMessage(quorumFields.ReplaceAllString(string(result), ""))
+}
+
+func TestNativeTracerStop(t *testing.T) {
+	test, err := loadCallTracerTest("call_tracer_deep_calls.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, ctor := range native {
+		tracer := ctor()
+		tracer.Stop(errors.New("stopped"))
+		if _, err := runCallTracerTest(test, tracer); err == nil || !strings.Contains(err.Error(), "stopped") {
+			t.Errorf("%s: expected stop error, got %v", name, err)
+		}
+	}
+}
+
+// Tests that the call frames report the state they ran in for a private
+// contract reading a public one: S -> (A) -> [B].
+func TestNativeCallTracerPrivateState(t *testing.T) {
+	var (
+		sender  = common.HexToAddress("0x1000")
+		private = common.HexToAddress("0x2000")
+		public  = common.HexToAddress("0x3000")
+	)
+	publicState, _ := state.New(common.Hash{}, state.NewDatabase(ethdb.NewMemDatabase()))
+	privateState, _ := state.New(common.Hash{}, state.NewDatabase(ethdb.NewMemDatabase()))
+
+	// (A) calls [B], which reads its storage
+	code := []byte{
+		byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0,
+		byte(vm.PUSH20),
+	}
+	code = append(code, public.Bytes()...)
+	code = append(code, byte(vm.PUSH2), 0x10, 0x00, byte(vm.CALL), byte(vm.STOP))
+	privateState.SetCode(private, code)
+	publicState.SetCode(public, []byte{byte(vm.PUSH1), 0, byte(vm.SLOAD), byte(vm.POP), byte(vm.STOP)})
+
+	tracer := newCallTracer()
+	context := vm.Context{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		BlockNumber: big.NewInt(1),
+	}
+	evm := vm.NewEVM(context, publicState, privateState, params.QuorumTestChainConfig, vm.Config{Debug: true, Tracer: tracer})
+	if _, _, err := evm.Call(vm.AccountRef(sender), private, nil, 100000, new(big.Int)); err != nil {
+		t.Fatalf("failed to execute call: %v", err)
+	}
+	res, err := tracer.GetResult()
+	if err != nil {
+		t.Fatalf("failed to retrieve trace result: %v", err)
+	}
+	result := new(callFrame)
+	if err := json.Unmarshal(res, result); err != nil {
+		t.Fatalf("failed to unmarshal trace result: %v", err)
+	}
+	if result.State != vm.PrivateStateName || result.QuorumReadOnly {
+		t.Errorf("outer call: have state %s, read only %v, want private, not read only", result.State, result.QuorumReadOnly)
+	}
+	if len(result.Calls) != 1 {
+		t.Fatalf("have %d inner calls, want 1", len(result.Calls))
+	}
+	if call := result.Calls[0]; call.State != vm.PublicStateName || !call.QuorumReadOnly {
+		t.Errorf("inner call: have state %s, read only %v, want public, read only", call.State, call.QuorumReadOnly)
+	}
+}
+
+func benchmarkTracer(b *testing.B, newTracer func() (ResultTracer, error)) {
+	var tests []*callTracerTest
+	for _, file := range callTracerTests(b) {
+		test, err := loadCallTracerTest(file)
+		if err != nil {
+			b.Fatal(err)
+		}
+		tests = append(tests, test)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, test := range tests {
+			tracer, err := newTracer()
+			if err != nil {
+				b.Fatal(err)
+			}
+			if _, err := runCallTracerTest(test, tracer); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkCallTracer(b *testing.B) {
+	b.Run("js", func(b *testing.B) {
+		benchmarkTracer(b, func() (ResultTracer, error) { return New("callTracer") })
+	})
+	b.Run("native", func(b *testing.B) {
+		benchmarkTracer(b, func() (ResultTracer, error) { return NewTracer("callTracer") })
+	})
+}
+
+func BenchmarkPrestateTracer(b *testing.B) {
+	b.Run("js", func(b *testing.B) {
+		benchmarkTracer(b, func() (ResultTracer, error) { return New("prestateTracer") })
+	})
+	b.Run("native", func(b *testing.B) {
+		benchmarkTracer(b, func() (ResultTracer, error) { return NewTracer("prestateTracer") })
+	})
+}
@@ -0,0 +1,324 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// +build !js
+
+package ethdb
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/log"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	boltFile          = "bolt.db" // Name of the database file in the database directory
+	boltTimeout       = time.Second
+	boltIteratorChunk = 256 // Number of pairs read by iterators in one transaction
+)
+
+var (
+	// boltBucket is the bucket holding all the data.
+	boltBucket = []byte("ethdb")
+
+	// boltKeyPrefix is prepended to all keys, as bolt refuses empty ones.
+	boltKeyPrefix = byte('k')
+
+	errBoltNotFound = errors.New("not found")
+)
+
+func init() {
+	RegisterEngine("bolt", Engine{
+		Open: func(file string, cache int, handles int) (Database, error) {
+			db, err := NewBoltDatabase(file)
+			if err != nil {
+				return nil, err
+			}
+			return db, nil
+		},
+		Exists: func(file string) bool {
+			_, err := os.Stat(filepath.Join(file, boltFile))
+			return err == nil
+		},
+	})
+}
+
+// BoltDatabase is a database stored in a bolt B+tree. Unlike LevelDB it never
+// compacts, so writes don't stall during bulk imports, at the cost of a larger
+// write amplification for small random updates. Bolt caches nothing itself, it
+// relies on the page cache of the operating system.
+type BoltDatabase struct {
+	fn string   // filename for reporting
+	db *bolt.DB // bolt instance
+
+	log log.Logger // Contextual logger tracking the database path
+}
+
+// NewBoltDatabase opens the bolt database in the directory, creating it if it
+// doesn't exist yet.
+func NewBoltDatabase(file string) (*BoltDatabase, error) {
+	logger := log.New("database", file)
+
+	if err := os.MkdirAll(file, 0755); err != nil {
+		return nil, err
+	}
+	db, err := bolt.Open(filepath.Join(file, boltFile), 0644, &bolt.Options{
+		Timeout:        boltTimeout,
+		NoFreelistSync: true,
+		FreelistType:   bolt.FreelistMapType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(boltBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	logger.Info("Opened bolt database")
+
+	return &BoltDatabase{
+		fn:  file,
+		db:  db,
+		log: logger,
+	}, nil
+}
+
+// boltKey returns the key of the bolt bucket for a database key.
+func boltKey(key []byte) []byte {
+	return append([]byte{boltKeyPrefix}, key...)
+}
+
+// Path returns the path to the database directory.
+func (db *BoltDatabase) Path() string {
+	return db.fn
+}
+
+// Put inserts the given value into the database.
+func (db *BoltDatabase) Put(key []byte, value []byte) error {
+	return db.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(boltBucket).Put(boltKey(key), value)
+	})
+}
+
+// Has reports whether the key is in the database.
+func (db *BoltDatabase) Has(key []byte) (bool, error) {
+	var found bool
+	err := db.db.View(func(tx *bolt.Tx) error {
+		found = boltGet(tx, key) != nil
+		return nil
+	})
+	return found, err
+}
+
+// Get returns the value of the key if it's present.
+func (db *BoltDatabase) Get(key []byte) ([]byte, error) {
+	var value []byte
+	err := db.db.View(func(tx *bolt.Tx) error {
+		if v := boltGet(tx, key); v != nil {
+			// Values are only valid during the transaction
+			value = common.CopyBytes(v)
+			return nil
+		}
+		return errBoltNotFound
+	})
+	return value, err
+}
+
+// boltGet returns the value of the key, nil if it's missing. Empty values are
+// returned as empty, not nil slices.
+func boltGet(tx *bolt.Tx, key []byte) []byte {
+	k, v := tx.Bucket(boltBucket).Cursor().Seek(boltKey(key))
+	if k == nil || !bytes.Equal(k[1:], key) {
+		return nil
+	}
+	if v == nil {
+		return []byte{}
+	}
+	return v
+}
+
+// Delete removes the key from the database.
+func (db *BoltDatabase) Delete(key []byte) error {
+	return db.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(boltBucket).Delete(boltKey(key))
+	})
+}
+
+// Close closes the database, waiting for the pending transactions.
+func (db *BoltDatabase) Close() {
+	if err := db.db.Close(); err == nil {
+		db.log.Info("Database closed")
+	} else {
+		db.log.Error("Failed to close database", "err", err)
+	}
+}
+
+// NewIterator returns an iterator over the entire database content.
+func (db *BoltDatabase) NewIterator() Iterator {
+	return db.NewIteratorWithRange(nil, nil)
+}
+
+// NewIteratorWithPrefix returns an iterator over the keys with the prefix.
+func (db *BoltDatabase) NewIteratorWithPrefix(prefix []byte) Iterator {
+	return db.NewIteratorWithRange(prefix, prefixLimit(prefix))
+}
+
+// NewIteratorWithRange returns an iterator over the keys in [start, limit).
+func (db *BoltDatabase) NewIteratorWithRange(start []byte, limit []byte) Iterator {
+	it := &boltIterator{db: db.db, next: boltKey(start), index: -1}
+	if limit != nil {
+		it.limit = boltKey(limit)
+	}
+	return it
+}
+
+// Compact does nothing, bolt reuses the pages freed by deletions instead of
+// compacting.
+func (db *BoltDatabase) Compact(start []byte, limit []byte) error {
+	return nil
+}
+
+// NewBatch creates a batch written in a single bolt transaction.
+func (db *BoltDatabase) NewBatch() Batch {
+	return &boltBatch{db: db.db}
+}
+
+type boltBatch struct {
+	db     *bolt.DB
+	writes []kv
+	size   int
+}
+
+func (b *boltBatch) Put(key, value []byte) error {
+	b.writes = append(b.writes, kv{boltKey(key), common.CopyBytes(value), false})
+	b.size += len(value)
+	return nil
+}
+
+func (b *boltBatch) Delete(key []byte) error {
+	b.writes = append(b.writes, kv{boltKey(key), nil, true})
+	b.size += 1
+	return nil
+}
+
+func (b *boltBatch) Write() error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(boltBucket)
+		for _, kv := range b.writes {
+			if kv.del {
+				if err := bucket.Delete(kv.k); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := bucket.Put(kv.k, kv.v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (b *boltBatch) ValueSize() int {
+	return b.size
+}
+
+func (b *boltBatch) Reset() {
+	b.writes = b.writes[:0]
+	b.size = 0
+}
+
+// boltIterator iterates over a bolt database in chunks, each read in its own
+// transaction, as a long running read transaction would block the writes which
+// grow the database. Unlike with the other databases, pairs written during the
+// iteration past the current chunk are seen by the iterator.
+type boltIterator struct {
+	db    *bolt.DB
+	next  []byte // bolt key to read the next chunk from
+	limit []byte // bolt key to stop before, nil for no limit
+	done  bool   // whether the last chunk was read
+
+	keys   [][]byte
+	values [][]byte
+	index  int
+	err    error
+}
+
+func (it *boltIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if it.index+1 < len(it.keys) {
+		it.index++
+		return true
+	}
+	if it.done {
+		it.index = len(it.keys)
+		return false
+	}
+	it.keys, it.values, it.index = it.keys[:0], it.values[:0], 0
+	it.err = it.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(boltBucket).Cursor()
+		k, v := c.Seek(it.next)
+		for ; k != nil && len(it.keys) < boltIteratorChunk; k, v = c.Next() {
+			if it.limit != nil && bytes.Compare(k, it.limit) >= 0 {
+				k = nil
+				break
+			}
+			it.keys = append(it.keys, common.CopyBytes(k[1:]))
+			it.values = append(it.values, append([]byte{}, v...))
+		}
+		it.done = k == nil
+		return nil
+	})
+	if len(it.keys) > 0 {
+		// Continue after the last key of the chunk
+		it.next = append(boltKey(it.keys[len(it.keys)-1]), 0)
+	}
+	return it.err == nil && len(it.keys) > 0
+}
+
+func (it *boltIterator) Error() error {
+	return it.err
+}
+
+func (it *boltIterator) Key() []byte {
+	if it.index < 0 || it.index >= len(it.keys) {
+		return nil
+	}
+	return it.keys[it.index]
+}
+
+func (it *boltIterator) Value() []byte {
+	if it.index < 0 || it.index >= len(it.keys) {
+		return nil
+	}
+	return it.values[it.index]
+}
+
+func (it *boltIterator) Release() {
+	it.keys, it.values, it.done = nil, nil, true
+}
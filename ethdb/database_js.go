@@ -59,6 +59,22 @@ func (db *LDBDatabase) Delete(key []byte) error {
 func (db *LDBDatabase) Close() {
 }
 
+func (db *LDBDatabase) NewIterator() Iterator {
+	return nil
+}
+
+func (db *LDBDatabase) NewIteratorWithPrefix(prefix []byte) Iterator {
+	return nil
+}
+
+func (db *LDBDatabase) NewIteratorWithRange(start []byte, limit []byte) Iterator {
+	return nil
+}
+
+func (db *LDBDatabase) Compact(start []byte, limit []byte) error {
+	return errNotSupported
+}
+
 // Meter configures the database metrics collectors and
 func (db *LDBDatabase) Meter(prefix string) {
 }
@@ -0,0 +1,97 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package ethdb
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DefaultEngine is the storage engine of the databases opened without an
+// explicitly selected one.
+const DefaultEngine = "leveldb"
+
+// Engine is a storage engine which persistent databases can be opened with.
+type Engine struct {
+	// Open opens the database in the directory, creating it if it doesn't
+	// exist yet. The cache (MB) and handles are hints the engine may ignore.
+	Open func(file string, cache int, handles int) (Database, error)
+
+	// Exists reports whether the directory holds a database of the engine.
+	Exists func(file string) bool
+}
+
+var (
+	enginesLock sync.RWMutex
+	engines     = make(map[string]Engine)
+)
+
+// RegisterEngine makes a storage engine selectable by name.
+func RegisterEngine(name string, engine Engine) {
+	enginesLock.Lock()
+	defer enginesLock.Unlock()
+
+	if _, ok := engines[name]; ok {
+		panic(fmt.Sprintf("database engine %s registered twice", name))
+	}
+	engines[name] = engine
+}
+
+// Engines returns the names of the registered storage engines, sorted.
+func Engines() []string {
+	enginesLock.RLock()
+	defer enginesLock.RUnlock()
+
+	return engineNames()
+}
+
+// engineNames returns the names of the registered storage engines, sorted. The
+// engines lock must be held.
+func engineNames() []string {
+	names := make([]string, 0, len(engines))
+	for name := range engines {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Open opens the database in the directory with the named storage engine, the
+// default one if the name is empty. Databases created by another engine are
+// refused instead of being shadowed by a new, empty one.
+func Open(engine string, file string, cache int, handles int) (Database, error) {
+	if engine == "" {
+		engine = DefaultEngine
+	}
+	enginesLock.RLock()
+	defer enginesLock.RUnlock()
+
+	e, ok := engines[engine]
+	if !ok {
+		return nil, fmt.Errorf("unknown database engine %q, available: %s", engine, strings.Join(engineNames(), ", "))
+	}
+	if !e.Exists(file) {
+		for name, other := range engines {
+			if name != engine && other.Exists(file) {
+				return nil, fmt.Errorf("database %s was created by the %s engine, not %s", file, name, engine)
+			}
+		}
+	}
+	return e.Open(file, cache, handles)
+}
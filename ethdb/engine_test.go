@@ -0,0 +1,317 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// +build !js
+
+package ethdb_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/quorum/ethdb"
+)
+
+// newTestEngineDB opens a database of the engine in a temporary directory.
+func newTestEngineDB(t *testing.T, engine string) (ethdb.Database, string, func()) {
+	dirname, err := ioutil.TempDir(os.TempDir(), "ethdb_test_")
+	if err != nil {
+		t.Fatalf("failed to create test directory: %v", err)
+	}
+	db, err := ethdb.Open(engine, dirname, 0, 0)
+	if err != nil {
+		os.RemoveAll(dirname)
+		t.Fatalf("failed to open %s database: %v", engine, err)
+	}
+	return db, dirname, func() {
+		db.Close()
+		os.RemoveAll(dirname)
+	}
+}
+
+// Tests that every registered engine and the memory database pass the
+// conformance suite.
+func TestDatabaseSuite(t *testing.T) {
+	t.Run("memory", func(t *testing.T) {
+		testDatabaseSuite(t, func(t *testing.T) (ethdb.Database, func()) {
+			return ethdb.NewMemDatabase(), func() {}
+		})
+	})
+	for _, engine := range ethdb.Engines() {
+		engine := engine
+		t.Run(engine, func(t *testing.T) {
+			testDatabaseSuite(t, func(t *testing.T) (ethdb.Database, func()) {
+				db, _, remove := newTestEngineDB(t, engine)
+				return db, remove
+			})
+			t.Run("Reopen", func(t *testing.T) { testReopen(t, engine) })
+		})
+	}
+}
+
+// testDatabaseSuite runs the tests every database implementation must pass.
+func testDatabaseSuite(t *testing.T, newDB func(t *testing.T) (ethdb.Database, func())) {
+	t.Run("PutGet", func(t *testing.T) {
+		db, remove := newDB(t)
+		defer remove()
+		testPutGet(db, t)
+	})
+	t.Run("ParallelPutGet", func(t *testing.T) {
+		db, remove := newDB(t)
+		defer remove()
+		testParallelPutGet(db, t)
+	})
+	t.Run("Has", func(t *testing.T) {
+		db, remove := newDB(t)
+		defer remove()
+		testHas(db, t)
+	})
+	t.Run("Batch", func(t *testing.T) {
+		db, remove := newDB(t)
+		defer remove()
+		testBatch(db, t)
+	})
+	t.Run("Iterator", func(t *testing.T) {
+		db, remove := newDB(t)
+		defer remove()
+		testIterator(db, t)
+	})
+	t.Run("TableIterator", func(t *testing.T) {
+		db, remove := newDB(t)
+		defer remove()
+		db.Put([]byte("t"), []byte("outside"))
+		db.Put([]byte("t.\xff"), []byte("outside"))
+		db.Put([]byte("u-a"), []byte("outside"))
+		testIterator(ethdb.NewTable(db, "t-"), t)
+	})
+	t.Run("DeleteRange", func(t *testing.T) {
+		db, remove := newDB(t)
+		defer remove()
+		testDeleteRange(db, t)
+	})
+}
+
+func testHas(db ethdb.Database, t *testing.T) {
+	for _, v := range test_values {
+		if has, err := db.Has([]byte(v)); err != nil || has {
+			t.Fatalf("has %q before put: have %v, %v, want false", v, has, err)
+		}
+		if err := db.Put([]byte(v), nil); err != nil {
+			t.Fatalf("put failed: %v", err)
+		}
+		if has, err := db.Has([]byte(v)); err != nil || !has {
+			t.Fatalf("has %q after put: have %v, %v, want true", v, has, err)
+		}
+	}
+	for _, v := range test_values {
+		if err := db.Delete([]byte(v)); err != nil {
+			t.Fatalf("delete failed: %v", err)
+		}
+		if has, err := db.Has([]byte(v)); err != nil || has {
+			t.Fatalf("has %q after delete: have %v, %v, want false", v, has, err)
+		}
+	}
+	// Deleting missing keys is not an error
+	if err := db.Delete([]byte("non-exist-key")); err != nil {
+		t.Fatalf("delete of missing key failed: %v", err)
+	}
+}
+
+func testBatch(db ethdb.Database, t *testing.T) {
+	if err := db.Put([]byte("deleted"), []byte("value")); err != nil {
+		t.Fatalf("put failed: %v", err)
+	}
+	batch := db.NewBatch()
+	value := []byte("value")
+	for _, k := range test_values {
+		if err := batch.Put([]byte(k), value); err != nil {
+			t.Fatalf("batch put failed: %v", err)
+		}
+	}
+	if err := batch.Delete([]byte("deleted")); err != nil {
+		t.Fatalf("batch delete failed: %v", err)
+	}
+	// Batches copy their values and write nothing before Write
+	value[0] = '?'
+	if size := batch.ValueSize(); size != len(test_values)*len(value)+1 {
+		t.Fatalf("batch value size mismatch: have %d, want %d", size, len(test_values)*len(value)+1)
+	}
+	if has, _ := db.Has([]byte(test_values[1])); has {
+		t.Fatalf("batch written before Write")
+	}
+	if err := batch.Write(); err != nil {
+		t.Fatalf("batch write failed: %v", err)
+	}
+	for _, k := range test_values {
+		if data, err := db.Get([]byte(k)); err != nil || !bytes.Equal(data, []byte("value")) {
+			t.Fatalf("get %q after batch: have %q, %v, want %q", k, data, err, "value")
+		}
+	}
+	if has, _ := db.Has([]byte("deleted")); has {
+		t.Fatalf("batch delete not written")
+	}
+	// A reset batch is empty and reusable
+	batch.Reset()
+	if size := batch.ValueSize(); size != 0 {
+		t.Fatalf("reset batch value size: have %d, want 0", size)
+	}
+	if err := batch.Put([]byte("reused"), []byte("batch")); err != nil {
+		t.Fatalf("batch put failed: %v", err)
+	}
+	if err := batch.Write(); err != nil {
+		t.Fatalf("batch write failed: %v", err)
+	}
+	if data, err := db.Get([]byte("reused")); err != nil || !bytes.Equal(data, []byte("batch")) {
+		t.Fatalf("get after reused batch: have %q, %v, want %q", data, err, "batch")
+	}
+	if data, err := db.Get([]byte(test_values[1])); err != nil || !bytes.Equal(data, []byte("value")) {
+		t.Fatalf("reset batch rewrote old data: have %q, %v", data, err)
+	}
+}
+
+// iteratorKeys are the keys written by testIterator, in ascending order.
+var iteratorKeys = []string{"", "a", "aa", "ab", "b", "b\xff", "b\xff\xff", "c"}
+
+// collect returns the keys of the iterator and checks its values.
+func collect(t *testing.T, it ethdb.Iterator) []string {
+	defer it.Release()
+
+	keys := []string{}
+	for it.Next() {
+		if !bytes.Equal(it.Value(), []byte("v"+string(it.Key()))) {
+			t.Fatalf("value of %q mismatch: have %q, want %q", it.Key(), it.Value(), "v"+string(it.Key()))
+		}
+		keys = append(keys, string(it.Key()))
+	}
+	if err := it.Error(); err != nil {
+		t.Fatalf("iteration failed: %v", err)
+	}
+	if it.Next() {
+		t.Fatalf("exhausted iterator moved on")
+	}
+	return keys
+}
+
+func testIterator(db ethdb.Database, t *testing.T) {
+	for _, k := range iteratorKeys {
+		if err := db.Put([]byte(k), []byte("v"+k)); err != nil {
+			t.Fatalf("put failed: %v", err)
+		}
+	}
+	tests := []struct {
+		name string
+		it   ethdb.Iterator
+		want []string
+	}{
+		{"all", db.NewIterator(), iteratorKeys},
+		{"nil prefix", db.NewIteratorWithPrefix(nil), iteratorKeys},
+		{"prefix a", db.NewIteratorWithPrefix([]byte("a")), []string{"a", "aa", "ab"}},
+		{"prefix b\xff", db.NewIteratorWithPrefix([]byte("b\xff")), []string{"b\xff", "b\xff\xff"}},
+		{"prefix d", db.NewIteratorWithPrefix([]byte("d")), []string{}},
+		{"range aa-b", db.NewIteratorWithRange([]byte("aa"), []byte("b")), []string{"aa", "ab"}},
+		{"range to a", db.NewIteratorWithRange(nil, []byte("a")), []string{""}},
+		{"range from b", db.NewIteratorWithRange([]byte("b"), nil), []string{"b", "b\xff", "b\xff\xff", "c"}},
+		{"empty range", db.NewIteratorWithRange([]byte("b"), []byte("b")), []string{}},
+	}
+	for _, test := range tests {
+		if keys := collect(t, test.it); !reflect.DeepEqual(keys, test.want) {
+			t.Errorf("%s: keys mismatch: have %q, want %q", test.name, keys, test.want)
+		}
+	}
+	// Many keys, to cross the chunks of chunked iterators
+	for i := 0; i < 1000; i++ {
+		k := fmt.Sprintf("n%04d", i)
+		if err := db.Put([]byte(k), []byte("v"+k)); err != nil {
+			t.Fatalf("put failed: %v", err)
+		}
+	}
+	if keys := collect(t, db.NewIteratorWithPrefix([]byte("n"))); len(keys) != 1000 || keys[999] != "n0999" {
+		t.Errorf("have %d keys, want 1000", len(keys))
+	}
+	if err := db.Compact(nil, nil); err != nil {
+		t.Fatalf("compaction failed: %v", err)
+	}
+	if err := db.Compact([]byte("a"), []byte("b")); err != nil {
+		t.Fatalf("range compaction failed: %v", err)
+	}
+	if keys := collect(t, db.NewIteratorWithRange(nil, []byte("n"))); !reflect.DeepEqual(keys, iteratorKeys) {
+		t.Errorf("keys mismatch after compaction: have %q", keys)
+	}
+}
+
+func testDeleteRange(db ethdb.Database, t *testing.T) {
+	for _, k := range iteratorKeys {
+		if err := db.Put([]byte(k), []byte("v"+k)); err != nil {
+			t.Fatalf("put failed: %v", err)
+		}
+	}
+	deleted, err := ethdb.DeleteRange(db, []byte("a"), []byte("b\xff"))
+	if err != nil {
+		t.Fatalf("range deletion failed: %v", err)
+	}
+	if deleted != 4 {
+		t.Errorf("have %d deleted keys, want 4", deleted)
+	}
+	if keys := collect(t, db.NewIterator()); !reflect.DeepEqual(keys, []string{"", "b\xff", "b\xff\xff", "c"}) {
+		t.Errorf("keys mismatch after range deletion: have %q", keys)
+	}
+	if _, err := ethdb.DeleteRange(db, nil, nil); err != nil {
+		t.Fatalf("range deletion failed: %v", err)
+	}
+	if keys := collect(t, db.NewIterator()); len(keys) != 0 {
+		t.Errorf("keys left after deleting everything: %q", keys)
+	}
+}
+
+// testReopen tests that the data of the engine persists across restarts, and
+// that other engines refuse to open it.
+func testReopen(t *testing.T, engine string) {
+	db, dirname, _ := newTestEngineDB(t, engine)
+	defer os.RemoveAll(dirname)
+
+	if err := db.Put([]byte("key"), []byte("value")); err != nil {
+		t.Fatalf("put failed: %v", err)
+	}
+	db.Close()
+
+	for _, other := range ethdb.Engines() {
+		if other == engine {
+			continue
+		}
+		if db, err := ethdb.Open(other, dirname, 0, 0); err == nil {
+			db.Close()
+			t.Errorf("%s database opened by the %s engine", engine, other)
+		}
+	}
+	db, err := ethdb.Open(engine, dirname, 0, 0)
+	if err != nil {
+		t.Fatalf("failed to reopen database: %v", err)
+	}
+	if data, err := db.Get([]byte("key")); err != nil || !bytes.Equal(data, []byte("value")) {
+		t.Fatalf("get after reopen: have %q, %v, want %q", data, err, "value")
+	}
+	db.Close()
+}
+
+func TestOpenUnknownEngine(t *testing.T) {
+	if _, err := ethdb.Open("unknown", os.TempDir(), 0, 0); err == nil {
+		t.Fatalf("database opened with an unknown engine")
+	}
+}
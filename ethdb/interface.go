@@ -30,10 +30,58 @@ type Deleter interface {
 	Delete(key []byte) error
 }
 
+// Iterator iterates over the key/value pairs of a database in ascending key
+// order. It must be released after use. Iterator cannot be used concurrently.
+type Iterator interface {
+	// Next moves the iterator to the next pair, it reports whether there is one.
+	Next() bool
+
+	// Error returns any accumulated error. Exhausting all the pairs is not
+	// considered to be an error.
+	Error() error
+
+	// Key returns the key of the current pair. The caller should not modify the
+	// contents of the returned slice, and its contents may change on the next
+	// call to Next.
+	Key() []byte
+
+	// Value returns the value of the current pair. The caller should not modify
+	// the contents of the returned slice, and its contents may change on the
+	// next call to Next.
+	Value() []byte
+
+	// Release releases the associated resources.
+	Release()
+}
+
+// Iteratee wraps the iteration operations of a database.
+type Iteratee interface {
+	// NewIterator creates an iterator over the entire key space.
+	NewIterator() Iterator
+
+	// NewIteratorWithPrefix creates an iterator over the keys with the prefix.
+	NewIteratorWithPrefix(prefix []byte) Iterator
+
+	// NewIteratorWithRange creates an iterator over the keys in [start, limit).
+	// A nil start means the first key, a nil limit means no upper bound.
+	NewIteratorWithRange(start []byte, limit []byte) Iterator
+}
+
+// Compacter wraps the compaction operation of a database.
+type Compacter interface {
+	// Compact flattens the underlying data store for the keys in [start, limit),
+	// discarding deleted and overwritten versions. A nil start means the first
+	// key, a nil limit means no upper bound. Stores which don't compact do
+	// nothing.
+	Compact(start []byte, limit []byte) error
+}
+
 // Database wraps all database operations. All methods are safe for concurrent use.
 type Database interface {
 	Putter
 	Deleter
+	Iteratee
+	Compacter
 	Get(key []byte) ([]byte, error)
 	Has(key []byte) (bool, error)
 	Close()
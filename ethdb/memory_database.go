@@ -17,7 +17,9 @@
 package ethdb
 
 import (
+	"bytes"
 	"errors"
+	"sort"
 	"sync"
 
 	"github.com/ethereum/quorum/common"
@@ -90,6 +92,42 @@ func (db *MemDatabase) Delete(key []byte) error {
 
 func (db *MemDatabase) Close() {}
 
+// NewIterator returns an iterator over a snapshot of the entire database.
+func (db *MemDatabase) NewIterator() Iterator {
+	return db.NewIteratorWithRange(nil, nil)
+}
+
+// NewIteratorWithPrefix returns an iterator over a snapshot of the keys with
+// the prefix.
+func (db *MemDatabase) NewIteratorWithPrefix(prefix []byte) Iterator {
+	return db.NewIteratorWithRange(prefix, prefixLimit(prefix))
+}
+
+// NewIteratorWithRange returns an iterator over a snapshot of the keys in
+// [start, limit).
+func (db *MemDatabase) NewIteratorWithRange(start []byte, limit []byte) Iterator {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	it := &memIterator{index: -1}
+	for key := range db.db {
+		if bytes.Compare([]byte(key), start) < 0 || (limit != nil && bytes.Compare([]byte(key), limit) >= 0) {
+			continue
+		}
+		it.keys = append(it.keys, key)
+	}
+	sort.Strings(it.keys)
+	for _, key := range it.keys {
+		it.values = append(it.values, db.db[key])
+	}
+	return it
+}
+
+// Compact does nothing, there is nothing to compact in memory.
+func (db *MemDatabase) Compact(start []byte, limit []byte) error {
+	return nil
+}
+
 func (db *MemDatabase) NewBatch() Batch {
 	return &memBatch{db: db}
 }
@@ -141,3 +179,40 @@ func (b *memBatch) Reset() {
 	b.writes = b.writes[:0]
 	b.size = 0
 }
+
+// memIterator iterates over a snapshot of a memory database.
+type memIterator struct {
+	keys   []string
+	values [][]byte
+	index  int
+}
+
+func (it *memIterator) Next() bool {
+	if it.index >= len(it.keys) {
+		return false
+	}
+	it.index++
+	return it.index < len(it.keys)
+}
+
+func (it *memIterator) Error() error {
+	return nil
+}
+
+func (it *memIterator) Key() []byte {
+	if it.index < 0 || it.index >= len(it.keys) {
+		return nil
+	}
+	return []byte(it.keys[it.index])
+}
+
+func (it *memIterator) Value() []byte {
+	if it.index < 0 || it.index >= len(it.keys) {
+		return nil
+	}
+	return it.values[it.index]
+}
+
+func (it *memIterator) Release() {
+	it.keys, it.values = nil, nil
+}
@@ -49,3 +49,73 @@ func (dt *table) Delete(key []byte) error {
 func (dt *table) Close() {
 	// Do nothing; don't close the underlying DB.
 }
+
+// NewIterator returns an iterator over the keys of the table, with the table
+// prefix removed.
+func (dt *table) NewIterator() Iterator {
+	return dt.NewIteratorWithPrefix(nil)
+}
+
+// NewIteratorWithPrefix returns an iterator over the keys of the table with the
+// prefix, with the table prefix removed.
+func (dt *table) NewIteratorWithPrefix(prefix []byte) Iterator {
+	return &tableIterator{
+		it:     dt.db.NewIteratorWithPrefix(append([]byte(dt.prefix), prefix...)),
+		prefix: len(dt.prefix),
+	}
+}
+
+// NewIteratorWithRange returns an iterator over the keys of the table in
+// [start, limit), with the table prefix removed.
+func (dt *table) NewIteratorWithRange(start []byte, limit []byte) Iterator {
+	start, limit = dt.keyRange(start, limit)
+	return &tableIterator{
+		it:     dt.db.NewIteratorWithRange(start, limit),
+		prefix: len(dt.prefix),
+	}
+}
+
+// Compact compacts the keys of the table in [start, limit).
+func (dt *table) Compact(start []byte, limit []byte) error {
+	start, limit = dt.keyRange(start, limit)
+	return dt.db.Compact(start, limit)
+}
+
+// keyRange returns the range of the underlying database for a range of the
+// table.
+func (dt *table) keyRange(start []byte, limit []byte) ([]byte, []byte) {
+	start = append([]byte(dt.prefix), start...)
+	if limit == nil {
+		return start, prefixLimit([]byte(dt.prefix))
+	}
+	return start, append([]byte(dt.prefix), limit...)
+}
+
+// tableIterator removes the table prefix from the keys of an iterator.
+type tableIterator struct {
+	it     Iterator
+	prefix int
+}
+
+func (it *tableIterator) Next() bool {
+	return it.it.Next()
+}
+
+func (it *tableIterator) Error() error {
+	return it.it.Error()
+}
+
+func (it *tableIterator) Key() []byte {
+	if key := it.it.Key(); key != nil {
+		return key[it.prefix:]
+	}
+	return nil
+}
+
+func (it *tableIterator) Value() []byte {
+	return it.it.Value()
+}
+
+func (it *tableIterator) Release() {
+	it.it.Release()
+}
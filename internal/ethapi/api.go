@@ -51,7 +51,6 @@ import (
 	"github.com/ethereum/quorum/rlp"
 	"github.com/ethereum/quorum/rpc"
 	"github.com/syndtr/goleveldb/leveldb"
-	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
 const (
@@ -899,6 +898,10 @@ type StructLogRes struct {
 	Stack   *[]string          `json:"stack,omitempty"`
 	Memory  *[]string          `json:"memory,omitempty"`
 	Storage *map[string]string `json:"storage,omitempty"`
+
+	// Quorum
+	State          string `json:"state"`
+	QuorumReadOnly bool   `json:"quorumReadOnly,omitempty"`
 }
 
 // formatLogs formats EVM returned structured logs for json output
@@ -912,6 +915,9 @@ func FormatLogs(logs []vm.StructLog) []StructLogRes {
 			GasCost: trace.GasCost,
 			Depth:   trace.Depth,
 			Error:   trace.Err,
+
+			State:          trace.State,
+			QuorumReadOnly: trace.QuorumReadOnly,
 		}
 		if trace.Stack != nil {
 			stack := make([]string, len(trace.Stack))
@@ -1671,7 +1677,7 @@ func (api *PrivateDebugAPI) ChaindbProperty(property string) (string, error) {
 		LDB() *leveldb.DB
 	})
 	if !ok {
-		return "", fmt.Errorf("chaindbProperty does not work for memory databases")
+		return "", fmt.Errorf("chaindbProperty only works for LevelDB databases")
 	}
 	if property == "" {
 		property = "leveldb.stats"
@@ -1682,15 +1688,10 @@ func (api *PrivateDebugAPI) ChaindbProperty(property string) (string, error) {
 }
 
 func (api *PrivateDebugAPI) ChaindbCompact() error {
-	ldb, ok := api.b.ChainDb().(interface {
-		LDB() *leveldb.DB
-	})
-	if !ok {
-		return fmt.Errorf("chaindbCompact does not work for memory databases")
-	}
+	db := api.b.ChainDb()
 	for b := byte(0); b < 255; b++ {
 		log.Info("Compacting chain database", "range", fmt.Sprintf("0x%0.2X-0x%0.2X", b, b+1))
-		err := ldb.LDB().CompactRange(util.Range{Start: []byte{b}, Limit: []byte{b + 1}})
+		err := db.Compact([]byte{b}, []byte{b + 1})
 		if err != nil {
 			log.Error("Database compaction failed", "err", err)
 			return err
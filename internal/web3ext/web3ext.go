@@ -813,6 +813,18 @@ web3._extend({
                        params: 3,
                        inputFormatter: [null,null,web3._extend.formatters.inputTransactionFormatter]
                }),
+               new web3._extend.Method({
+                       name: 'addRolePolicy',
+                       call: 'quorumPermission_addRolePolicy',
+                       params: 5,
+                       inputFormatter: [null,null,web3._extend.formatters.inputAddressFormatter,null,web3._extend.formatters.inputTransactionFormatter]
+               }),
+               new web3._extend.Method({
+                       name: 'removeRolePolicy',
+                       call: 'quorumPermission_removeRolePolicy',
+                       params: 5,
+                       inputFormatter: [null,null,web3._extend.formatters.inputAddressFormatter,null,web3._extend.formatters.inputTransactionFormatter]
+               }),
                new web3._extend.Method({
                        name: 'addAccountToOrg',
                        call: 'quorumPermission_addAccountToOrg',
@@ -830,6 +842,12 @@ web3._extend({
                        call: 'quorumPermission_updateAccountStatus',
                        params: 4,
                        inputFormatter: [null, web3._extend.formatters.inputAddressFormatter,null,web3._extend.formatters.inputTransactionFormatter]
+               }),
+               new web3._extend.Method({
+                       name: 'setAccountExpiry',
+                       call: 'quorumPermission_setAccountExpiry',
+                       params: 5,
+                       inputFormatter: [null, web3._extend.formatters.inputAddressFormatter,null,null,web3._extend.formatters.inputTransactionFormatter]
                }),
 			   new web3._extend.Method({
                        name: 'recoverBlackListedNode',
@@ -861,6 +879,29 @@ web3._extend({
                        params: 1,
                        inputFormatter: [null]
                }),
+               new web3._extend.Method({
+                       name: 'getHistory',
+                       call: 'quorumPermission_getHistory',
+                       params: 1,
+                       inputFormatter: [null]
+               }),
+               new web3._extend.Method({
+                       name: 'applyPlan',
+                       call: 'quorumPermission_applyPlan',
+                       params: 3,
+                       inputFormatter: [null,null,web3._extend.formatters.inputTransactionFormatter]
+               }),
+               new web3._extend.Method({
+                       name: 'upgradeImplementation',
+                       call: 'quorumPermission_upgradeImplementation',
+                       params: 2,
+                       inputFormatter: [web3._extend.formatters.inputAddressFormatter,web3._extend.formatters.inputTransactionFormatter]
+               }),
+               new web3._extend.Method({
+                       name: 'checkCaches',
+                       call: 'quorumPermission_checkCaches',
+                       params: 0
+               }),
 
        ],
        properties:
@@ -921,6 +962,24 @@ web3._extend({
 			name: 'discard',
 			call: 'istanbul_discard',
 			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'getEvidence',
+			call: 'istanbul_getEvidence',
+			params: 1,
+			inputFormatter: [null]
+		}),
+		new web3._extend.Method({
+			name: 'getCheckpoint',
+			call: 'istanbul_getCheckpoint',
+			params: 1,
+			inputFormatter: [null]
+		}),
+		new web3._extend.Method({
+			name: 'getFinalityProof',
+			call: 'istanbul_getFinalityProof',
+			params: 2,
+			inputFormatter: [null, web3._extend.formatters.inputBlockNumberFormatter]
 		})
 	],
 	properties:
@@ -23,6 +23,7 @@ import (
 
 	"github.com/ethereum/quorum/common"
 	"github.com/ethereum/quorum/core/state"
+	"github.com/ethereum/quorum/core/state/snapshot"
 	"github.com/ethereum/quorum/core/types"
 	"github.com/ethereum/quorum/crypto"
 	"github.com/ethereum/quorum/ethdb"
@@ -89,6 +90,10 @@ func (db *odrDatabase) TrieDB() *trie.Database {
 	return nil
 }
 
+func (db *odrDatabase) Snapshots() *snapshot.Tree {
+	return nil
+}
+
 type odrTrie struct {
 	db   *odrDatabase
 	id   *TrieID
@@ -95,6 +95,8 @@ type environment struct {
 	privateReceipts []*types.Receipt
 	// Leave this publicState named state, add privateState which most code paths can just ignore
 	privateState *state.StateDB
+
+	hooks *vm.HookRecorder // EVM events of the block, nil if the chain has no hooks
 }
 
 // task contains all information for consensus engine sealing and result submitting.
@@ -107,6 +109,8 @@ type task struct {
 	privateReceipts []*types.Receipt
 	// Leave this publicState named state, add privateState which most code paths can just ignore
 	privateState *state.StateDB
+
+	hooks *vm.HookRecorder // EVM events of the block, nil if the chain has no hooks
 }
 
 const (
@@ -614,6 +618,9 @@ func (w *worker) resultLoop() {
 				log.Error("Failed writing private block bloom", "err", err)
 				continue
 			}
+			if task.hooks != nil {
+				task.hooks.BlockCommitted(block)
+			}
 			log.Info("Successfully sealed new block", "number", block.Number(), "sealhash", sealhash, "hash", hash,
 				"elapsed", common.PrettyDuration(time.Since(task.createdAt)))
 
@@ -675,6 +682,9 @@ func (w *worker) makeCurrent(parent *types.Block, header *types.Header) error {
 		header:       header,
 		privateState: privateState,
 	}
+	if hooks := w.chain.Hooks(); hooks != nil {
+		env.hooks = vm.NewHookRecorder(hooks)
+	}
 
 	// when 08 is processed ancestors contain 07 (quick block)
 	for _, ancestor := range w.chain.GetBlocksFromHash(parent.Hash(), 7) {
@@ -747,10 +757,20 @@ func (w *worker) commitTransaction(tx *types.Transaction, coinbase common.Addres
 	snap := w.current.state.Snapshot()
 	privateSnap := w.current.privateState.Snapshot()
 
-	receipt, privateReceipt, _, err := core.ApplyTransaction(w.config, w.chain, &coinbase, w.current.gasPool, w.current.state, w.current.privateState, w.current.header, tx, &w.current.header.GasUsed, vm.Config{})
+	var (
+		vmConfig  vm.Config
+		hooksSnap int
+	)
+	if w.current.hooks != nil {
+		vmConfig.Hooks, hooksSnap = w.current.hooks, w.current.hooks.Snapshot()
+	}
+	receipt, privateReceipt, _, err := core.ApplyTransaction(w.config, w.chain, &coinbase, w.current.gasPool, w.current.state, w.current.privateState, w.current.header, tx, &w.current.header.GasUsed, vmConfig)
 	if err != nil {
 		w.current.state.RevertToSnapshot(snap)
 		w.current.privateState.RevertToSnapshot(privateSnap)
+		if w.current.hooks != nil {
+			w.current.hooks.RevertToSnapshot(hooksSnap)
+		}
 		return nil, err
 	}
 	w.current.txs = append(w.current.txs, tx)
@@ -820,6 +840,13 @@ func (w *worker) commitTransactions(txs *types.TransactionsByPriceAndNonce, coin
 			txs.Pop()
 			continue
 		}
+		// Skip the sender if it isn't permissioned for the transaction
+		if err := core.CheckAccountAccess(w.config, types.CachedPermissions, w.current.header.Number, core.AccessTime(w.current.header), from, tx); err != nil {
+			log.Trace("Ignoring unauthorized transaction", "hash", tx.Hash(), "sender", from, "err", err)
+
+			txs.Pop()
+			continue
+		}
 		// Start executing the transaction
 		w.current.state.Prepare(tx.Hash(), common.Hash{}, w.current.tcount)
 		w.current.privateState.Prepare(tx.Hash(), common.Hash{}, w.current.tcount)
@@ -982,8 +1009,18 @@ func (w *worker) commitNewWork(interrupt *int32, noempty bool, timestamp int64)
 		w.updateSnapshot()
 		return
 	}
-	// Split the pending transactions into locals and remotes
-	localTxs, remoteTxs := make(map[common.Address]types.Transactions), pending
+	// Fill the block with the transactions of the priority lanes first
+	lanes := w.eth.TxPool().SplitLanes(pending)
+	for _, laneTxs := range lanes[:len(lanes)-1] {
+		if len(laneTxs) > 0 {
+			txs := types.NewTransactionsByPriceAndNonce(w.current.signer, laneTxs)
+			if w.commitTransactions(txs, w.coinbase, interrupt) {
+				return
+			}
+		}
+	}
+	// Split the pending transactions of the default lane into locals and remotes
+	localTxs, remoteTxs := make(map[common.Address]types.Transactions), lanes[len(lanes)-1]
 	for _, account := range w.eth.TxPool().Locals() {
 		if txs := remoteTxs[account]; len(txs) > 0 {
 			delete(remoteTxs, account)
@@ -1027,12 +1064,16 @@ func (w *worker) commit(uncles []*types.Header, interval func(), update bool, st
 	if err != nil {
 		return err
 	}
+	var hooks *vm.HookRecorder
+	if w.current.hooks != nil {
+		hooks = w.current.hooks.Copy()
+	}
 	if w.isRunning() {
 		if interval != nil {
 			interval()
 		}
 		select {
-		case w.taskCh <- &task{receipts: receipts, privateReceipts: privateReceipts, state: s, privateState: ps, block: block, createdAt: time.Now()}:
+		case w.taskCh <- &task{receipts: receipts, privateReceipts: privateReceipts, state: s, privateState: ps, block: block, createdAt: time.Now(), hooks: hooks}:
 			w.unconfirmed.Shift(block.NumberU64() - 1)
 
 			feesWei := new(big.Int)
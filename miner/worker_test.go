@@ -77,7 +77,7 @@ type testWorkerBackend struct {
 	uncleBlock *types.Block
 }
 
-func newTestWorkerBackend(t *testing.T, chainConfig *params.ChainConfig, engine consensus.Engine, n int) *testWorkerBackend {
+func newTestWorkerBackend(t *testing.T, chainConfig *params.ChainConfig, engine consensus.Engine, n int, hooks vm.Hooks) *testWorkerBackend {
 	var (
 		db    = ethdb.NewMemDatabase()
 		gspec = core.Genesis{
@@ -96,7 +96,7 @@ func newTestWorkerBackend(t *testing.T, chainConfig *params.ChainConfig, engine
 	}
 	genesis := gspec.MustCommit(db)
 
-	chain, _ := core.NewBlockChain(db, nil, gspec.Config, engine, vm.Config{}, nil)
+	chain, _ := core.NewBlockChain(db, nil, gspec.Config, engine, vm.Config{Hooks: hooks}, nil)
 	txpool := core.NewTxPool(testTxPoolConfig, chainConfig, chain)
 
 	// Generate a small n-block chain and an uncle block for it
@@ -132,7 +132,7 @@ func (b *testWorkerBackend) PostChainEvents(events []interface{}) {
 }
 
 func newTestWorker(t *testing.T, chainConfig *params.ChainConfig, engine consensus.Engine, blocks int) (*worker, *testWorkerBackend) {
-	backend := newTestWorkerBackend(t, chainConfig, engine, blocks)
+	backend := newTestWorkerBackend(t, chainConfig, engine, blocks, nil)
 	backend.txPool.AddLocals(pendingTxs)
 	w := newWorker(chainConfig, engine, backend, new(event.TypeMux), time.Second, params.GenesisGasLimit, params.GenesisGasLimit, nil)
 	w.setEtherbase(testBankAddress)
@@ -453,3 +453,57 @@ func testAdjustInterval(t *testing.T, chainConfig *params.ChainConfig, engine co
 		t.Error("interval reset timeout")
 	}
 }
+
+// sealedBlock is a block committed to the hooks with the calls executed in it.
+type sealedBlock struct {
+	block *types.Block
+	calls []vm.HookScope
+}
+
+// sealHooks reports the first block with transactions committed to the hooks.
+type sealHooks struct {
+	vm.NoopHooks
+	calls  []vm.HookScope
+	sealed chan sealedBlock
+}
+
+func (h *sealHooks) CallEnter(scope vm.HookScope, typ vm.OpCode, from, to common.Address, input []byte, gas uint64, value *big.Int) {
+	h.calls = append(h.calls, scope)
+}
+
+func (h *sealHooks) BlockCommitted(block *types.Block) {
+	if len(block.Transactions()) > 0 {
+		select {
+		case h.sealed <- sealedBlock{block, h.calls}:
+		default:
+		}
+	}
+	h.calls = nil
+}
+
+// Tests that the hooks of the chain receive the EVM events of the blocks the
+// worker seals itself.
+func TestSealedBlockHooks(t *testing.T) {
+	engine := ethash.NewFaker()
+	defer engine.Close()
+
+	hooks := &sealHooks{sealed: make(chan sealedBlock, 1)}
+	backend := newTestWorkerBackend(t, ethashChainConfig, engine, 0, hooks)
+	backend.txPool.AddLocals(pendingTxs)
+	w := newWorker(ethashChainConfig, engine, backend, new(event.TypeMux), time.Second, params.GenesisGasLimit, params.GenesisGasLimit, nil)
+	w.setEtherbase(testBankAddress)
+	defer w.close()
+
+	w.start()
+	select {
+	case sealed := <-hooks.sealed:
+		if len(sealed.calls) != 1 {
+			t.Fatalf("call count mismatch: have %d, want %d", len(sealed.calls), 1)
+		}
+		if scope := sealed.calls[0]; scope.BlockHash != sealed.block.Hash() || scope.TxHash != pendingTxs[0].Hash() || scope.TxIndex != 0 {
+			t.Errorf("call scope mismatch: have %+v", scope)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatalf("sealed block not committed to the hooks")
+	}
+}
@@ -69,6 +69,10 @@ type Config struct {
 	// in memory.
 	DataDir string
 
+	// DBEngine is the storage engine of the databases in the data directory, the
+	// default engine if empty. See ethdb.Engines for the available ones.
+	DBEngine string `toml:",omitempty"`
+
 	// Configuration of peer-to-peer networking.
 	P2P p2p.Config
 
@@ -152,6 +156,11 @@ type Config struct {
 	// private APIs to untrusted users is a major security risk.
 	WSExposeAll bool `toml:",omitempty"`
 
+	// RPCAuthFile is the JSON config authenticating the clients of the HTTP and
+	// websocket RPC endpoints, see rpc.AuthConfig. The endpoints serve every
+	// client if empty.
+	RPCAuthFile string `toml:",omitempty"`
+
 	EnableNodePermission bool `toml:",omitempty"`
 	// Logger is a custom logger to use with the p2p.Server.
 	Logger log.Logger `toml:",omitempty"`
@@ -28,6 +28,8 @@ import (
 	"sync"
 
 	"github.com/ethereum/quorum/accounts"
+	"github.com/ethereum/quorum/core/rawdb"
+	"github.com/ethereum/quorum/core/vm"
 	"github.com/ethereum/quorum/ethdb"
 	"github.com/ethereum/quorum/event"
 	"github.com/ethereum/quorum/internal/debug"
@@ -39,7 +41,8 @@ import (
 
 // Node is a container on which services can be registered.
 type Node struct {
-	eventmux *event.TypeMux // Event multiplexer used between the services of a stack
+	eventmux *event.TypeMux   // Event multiplexer used between the services of a stack
+	evmHooks *vm.HookRegistry // Hooks of the instrumentation plugins into the block processing
 	config   *Config
 	accman   *accounts.Manager
 
@@ -118,6 +121,7 @@ func New(conf *Config) (*Node, error) {
 		httpEndpoint:      conf.HTTPEndpoint(),
 		wsEndpoint:        conf.WSEndpoint(),
 		eventmux:          new(event.TypeMux),
+		evmHooks:          vm.NewHookRegistry(),
 		log:               conf.Logger,
 	}, nil
 }
@@ -176,6 +180,7 @@ func (n *Node) Start() error {
 			config:         n.config,
 			services:       make(map[reflect.Type]Service),
 			EventMux:       n.eventmux,
+			EVMHooks:       n.evmHooks,
 			AccountManager: n.accman,
 		}
 		for kind, s := range services { // copy needed for threaded access
@@ -333,17 +338,30 @@ func (n *Node) stopIPC() {
 	}
 }
 
+// rpcAuth loads the authentication config of the HTTP and websocket endpoints,
+// nil if they serve every client.
+func (n *Node) rpcAuth() (*rpc.AuthConfig, error) {
+	if n.config.RPCAuthFile == "" {
+		return nil, nil
+	}
+	return rpc.LoadAuthConfig(n.config.RPCAuthFile)
+}
+
 // startHTTP initializes and starts the HTTP RPC endpoint.
 func (n *Node) startHTTP(endpoint string, apis []rpc.API, modules []string, cors []string, vhosts []string, timeouts rpc.HTTPTimeouts) error {
 	// Short circuit if the HTTP endpoint isn't being exposed
 	if endpoint == "" {
 		return nil
 	}
-	listener, handler, err := rpc.StartHTTPEndpoint(endpoint, apis, modules, cors, vhosts, timeouts)
+	auth, err := n.rpcAuth()
+	if err != nil {
+		return err
+	}
+	listener, handler, err := rpc.StartHTTPEndpoint(endpoint, apis, modules, cors, vhosts, timeouts, auth)
 	if err != nil {
 		return err
 	}
-	n.log.Info("HTTP endpoint opened", "url", fmt.Sprintf("http://%s", endpoint), "cors", strings.Join(cors, ","), "vhosts", strings.Join(vhosts, ","))
+	n.log.Info("HTTP endpoint opened", "url", fmt.Sprintf("http://%s", endpoint), "cors", strings.Join(cors, ","), "vhosts", strings.Join(vhosts, ","), "auth", auth != nil)
 	// All listeners booted successfully
 	n.httpEndpoint = endpoint
 	n.httpListener = listener
@@ -372,11 +390,15 @@ func (n *Node) startWS(endpoint string, apis []rpc.API, modules []string, wsOrig
 	if endpoint == "" {
 		return nil
 	}
-	listener, handler, err := rpc.StartWSEndpoint(endpoint, apis, modules, wsOrigins, exposeAll)
+	auth, err := n.rpcAuth()
 	if err != nil {
 		return err
 	}
-	n.log.Info("WebSocket endpoint opened", "url", fmt.Sprintf("ws://%s", listener.Addr()))
+	listener, handler, err := rpc.StartWSEndpoint(endpoint, apis, modules, wsOrigins, exposeAll, auth)
+	if err != nil {
+		return err
+	}
+	n.log.Info("WebSocket endpoint opened", "url", fmt.Sprintf("ws://%s", listener.Addr()), "auth", auth != nil)
 	// All listeners booted successfully
 	n.wsEndpoint = endpoint
 	n.wsListener = listener
@@ -599,7 +621,46 @@ func (n *Node) OpenDatabase(name string, cache, handles int) (ethdb.Database, er
 	if n.config.DataDir == "" {
 		return ethdb.NewMemDatabase(), nil
 	}
-	return ethdb.NewLDBDatabase(n.config.ResolvePath(name), cache, handles)
+	return ethdb.Open(n.config.DBEngine, n.config.ResolvePath(name), cache, handles)
+}
+
+// OpenDatabaseWithFreezer opens an existing database with the given name (or
+// creates one if no previous can be found) from within the node's data directory,
+// also attaching a chain freezer to it that moves ancient chain data from the
+// database to immutable append-only files. If the freezer directory is empty, the
+// ancient directory of the database is used, a relative one is resolved in the
+// data directory. If the node is an ephemeral one, a memory database is returned.
+func (n *Node) OpenDatabaseWithFreezer(name string, cache, handles int, freezer, namespace string) (ethdb.Database, error) {
+	if n.config.DataDir == "" {
+		return ethdb.NewMemDatabase(), nil
+	}
+	root := n.config.ResolvePath(name)
+	switch {
+	case freezer == "":
+		freezer = filepath.Join(root, "ancient")
+	case !filepath.IsAbs(freezer):
+		freezer = n.config.ResolvePath(freezer)
+	}
+	kvdb, err := ethdb.Open(n.config.DBEngine, root, cache, handles)
+	if err != nil {
+		return nil, err
+	}
+	if ldb, ok := kvdb.(*ethdb.LDBDatabase); ok && namespace != "" {
+		ldb.Meter(namespace)
+	}
+	db, err := rawdb.NewDatabaseWithFreezer(kvdb, freezer)
+	if err != nil {
+		kvdb.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// EVMHooks retrieves the registry of the EVM hooks of the node, through which
+// instrumentation plugins receive the execution events of the blocks written to
+// the chain, whether imported or sealed by the node.
+func (n *Node) EVMHooks() *vm.HookRegistry {
+	return n.evmHooks
 }
 
 // ResolvePath returns the absolute path of a resource in the instance directory.
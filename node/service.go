@@ -18,9 +18,12 @@ package node
 
 import (
 	"crypto/ecdsa"
+	"path/filepath"
 	"reflect"
 
 	"github.com/ethereum/quorum/accounts"
+	"github.com/ethereum/quorum/core/rawdb"
+	"github.com/ethereum/quorum/core/vm"
 	"github.com/ethereum/quorum/ethdb"
 	"github.com/ethereum/quorum/event"
 	"github.com/ethereum/quorum/p2p"
@@ -34,6 +37,7 @@ type ServiceContext struct {
 	config         *Config
 	services       map[reflect.Type]Service // Index of the already constructed services
 	EventMux       *event.TypeMux           // Event multiplexer used for decoupled notifications
+	EVMHooks       *vm.HookRegistry         // Registry of the EVM hooks into the block processing
 	AccountManager *accounts.Manager        // Account manager created by the node.
 }
 
@@ -44,8 +48,36 @@ func (ctx *ServiceContext) OpenDatabase(name string, cache int, handles int) (et
 	if ctx.config.DataDir == "" {
 		return ethdb.NewMemDatabase(), nil
 	}
-	db, err := ethdb.NewLDBDatabase(ctx.config.ResolvePath(name), cache, handles)
+	return ethdb.Open(ctx.config.DBEngine, ctx.config.ResolvePath(name), cache, handles)
+}
+
+// OpenDatabaseWithFreezer opens an existing database with the given name (or
+// creates one if no previous can be found) from within the node's data directory,
+// also attaching a chain freezer to it that moves ancient chain data from the
+// database to immutable append-only files. If the freezer directory is empty, the
+// ancient directory of the database is used, a relative one is resolved in the
+// data directory. If the node is an ephemeral one, a memory database is returned.
+func (ctx *ServiceContext) OpenDatabaseWithFreezer(name string, cache, handles int, freezer, namespace string) (ethdb.Database, error) {
+	if ctx.config.DataDir == "" {
+		return ethdb.NewMemDatabase(), nil
+	}
+	root := ctx.config.ResolvePath(name)
+	switch {
+	case freezer == "":
+		freezer = filepath.Join(root, "ancient")
+	case !filepath.IsAbs(freezer):
+		freezer = ctx.config.ResolvePath(freezer)
+	}
+	kvdb, err := ethdb.Open(ctx.config.DBEngine, root, cache, handles)
+	if err != nil {
+		return nil, err
+	}
+	if ldb, ok := kvdb.(*ethdb.LDBDatabase); ok && namespace != "" {
+		ldb.Meter(namespace)
+	}
+	db, err := rawdb.NewDatabaseWithFreezer(kvdb, freezer)
 	if err != nil {
+		kvdb.Close()
 		return nil, err
 	}
 	return db, nil
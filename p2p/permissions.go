@@ -5,7 +5,8 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
-	"strings"
+	"sync"
+	"time"
 
 	"github.com/ethereum/quorum/log"
 	"github.com/ethereum/quorum/p2p/enode"
@@ -14,32 +15,190 @@ import (
 
 const (
 	NODE_NAME_LENGTH = 32
+
+	// interval at which the permission files are checked for changes
+	permissionFileCheckInterval = time.Second
 )
 
-//TODO update this based on permission changes
+// NodePermissioner decides whether the server may connect to a remote node.
+type NodePermissioner interface {
+	IsNodePermissioned(id enode.ID) bool
+}
+
+// SetNodePermissioner replaces the permissioner consulted for new connections
+// when node permissioning is enabled. It stops following the permission files
+// of the data directory.
+func (srv *Server) SetNodePermissioner(permissioner NodePermissioner) {
+	srv.permissionLock.Lock()
+	defer srv.permissionLock.Unlock()
+
+	if srv.permissionWatcher != nil {
+		srv.permissionWatcher.close()
+		srv.permissionWatcher = nil
+	}
+	srv.permissioner = permissioner
+}
+
 // check if a given node is permissioned to connect to the change
-func isNodePermissioned(nodename string, currentNode string, datadir string, direction string) bool {
-	var permissionedList []string
-	nodes := ParsePermissionedNodes(datadir)
-	for _, v := range nodes {
-		permissionedList = append(permissionedList, v.ID().String())
-	}
-
-	log.Debug("isNodePermissioned", "permissionedList", permissionedList)
-	for _, v := range permissionedList {
-		if v == nodename {
-			log.Debug("isNodePermissioned", "connection", direction, "nodename", nodename[:NODE_NAME_LENGTH], "ALLOWED-BY", currentNode[:NODE_NAME_LENGTH])
-			// check if the node is blacklisted
-			if isNodeBlackListed(nodename, datadir) {
-				return false
-			}
-			return true
-		}
+func (srv *Server) isNodePermissioned(id enode.ID, currentNode string, direction string) bool {
+	srv.permissionLock.RLock()
+	permissioner := srv.permissioner
+	srv.permissionLock.RUnlock()
+
+	nodename := id.String()
+	if permissioner != nil && permissioner.IsNodePermissioned(id) {
+		log.Debug("isNodePermissioned", "connection", direction, "nodename", nodename[:NODE_NAME_LENGTH], "ALLOWED-BY", currentNode[:NODE_NAME_LENGTH])
+		return true
 	}
 	log.Debug("isNodePermissioned", "connection", direction, "nodename", nodename[:NODE_NAME_LENGTH], "DENIED-BY", currentNode[:NODE_NAME_LENGTH])
 	return false
 }
 
+// NodeAllowlist is an in-memory NodePermissioner. A node is permissioned if it is
+// allowed and not disallowed, so a disallowed node stays rejected until it is
+// explicitly readmitted.
+type NodeAllowlist struct {
+	lock       sync.RWMutex
+	allowed    map[enode.ID]struct{}
+	disallowed map[enode.ID]struct{}
+}
+
+// NewNodeAllowlist creates an empty allowlist, rejecting every node.
+func NewNodeAllowlist() *NodeAllowlist {
+	return &NodeAllowlist{
+		allowed:    make(map[enode.ID]struct{}),
+		disallowed: make(map[enode.ID]struct{}),
+	}
+}
+
+// IsNodePermissioned implements NodePermissioner.
+func (l *NodeAllowlist) IsNodePermissioned(id enode.ID) bool {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	_, allowed := l.allowed[id]
+	_, disallowed := l.disallowed[id]
+	return allowed && !disallowed
+}
+
+// Allow adds the node to the allowed nodes.
+func (l *NodeAllowlist) Allow(id enode.ID) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.allowed[id] = struct{}{}
+}
+
+// Revoke removes the node from the allowed nodes.
+func (l *NodeAllowlist) Revoke(id enode.ID) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	delete(l.allowed, id)
+}
+
+// Disallow adds the node to the disallowed nodes.
+func (l *NodeAllowlist) Disallow(id enode.ID) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.disallowed[id] = struct{}{}
+}
+
+// Readmit removes the node from the disallowed nodes.
+func (l *NodeAllowlist) Readmit(id enode.ID) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	delete(l.disallowed, id)
+}
+
+// Reset replaces the allowed and disallowed nodes.
+func (l *NodeAllowlist) Reset(allowed, disallowed []enode.ID) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.allowed = make(map[enode.ID]struct{}, len(allowed))
+	for _, id := range allowed {
+		l.allowed[id] = struct{}{}
+	}
+	l.disallowed = make(map[enode.ID]struct{}, len(disallowed))
+	for _, id := range disallowed {
+		l.disallowed[id] = struct{}{}
+	}
+}
+
+// permissionFileWatcher keeps an allowlist in sync with the permissioned-nodes.json
+// and disallowed-nodes.json files of the data directory. The files are only
+// parsed again after they changed.
+type permissionFileWatcher struct {
+	*NodeAllowlist
+	dataDir  string
+	modTimes map[string]time.Time
+	quit     chan struct{}
+	quitOnce sync.Once
+}
+
+func newPermissionFileWatcher(dataDir string) *permissionFileWatcher {
+	w := &permissionFileWatcher{
+		NodeAllowlist: NewNodeAllowlist(),
+		dataDir:       dataDir,
+		modTimes:      make(map[string]time.Time),
+		quit:          make(chan struct{}),
+	}
+	w.reload()
+	return w
+}
+
+// loop reloads the files whenever they are modified, until either the watcher
+// or the server is stopped.
+func (w *permissionFileWatcher) loop(stop <-chan struct{}) {
+	ticker := time.NewTicker(permissionFileCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.reload()
+		case <-w.quit:
+			return
+		case <-stop:
+			return
+		}
+	}
+}
+
+func (w *permissionFileWatcher) close() {
+	w.quitOnce.Do(func() { close(w.quit) })
+}
+
+// reload parses the permission files if any of them was created, modified or
+// removed since the last check.
+func (w *permissionFileWatcher) reload() {
+	changed := false
+	for _, file := range []string{params.PERMISSIONED_CONFIG, params.BLACKLIST_CONFIG} {
+		var modTime time.Time
+		if info, err := os.Stat(filepath.Join(w.dataDir, file)); err == nil {
+			modTime = info.ModTime()
+		}
+		if last, ok := w.modTimes[file]; !ok || !last.Equal(modTime) {
+			w.modTimes[file] = modTime
+			changed = true
+		}
+	}
+	if !changed {
+		return
+	}
+	disallowed, err := parseDisallowedNodes(w.dataDir)
+	if err != nil {
+		// Keep the previous lists rather than readmitting disallowed nodes
+		log.Error("Failed to load disallowed nodes, keeping previous node permissions", "err", err)
+		return
+	}
+	var allowed []enode.ID
+	for _, node := range ParsePermissionedNodes(w.dataDir) {
+		allowed = append(allowed, node.ID())
+	}
+	w.Reset(allowed, disallowed)
+	log.Debug("Reloaded node permissions", "allowed", len(allowed), "disallowed", len(disallowed))
+}
+
 //this is a shameless copy from the config.go. It is a duplication of the code
 //for the timebeing to allow reload of the permissioned nodes while the server is running
 
@@ -81,32 +240,34 @@ func ParsePermissionedNodes(DataDir string) []*enode.Node {
 	return nodes
 }
 
-// This function checks if the node is black-listed
-func isNodeBlackListed(nodeName, dataDir string) bool {
-	log.Debug("isNodeBlackListed", "DataDir", dataDir, "file", params.BLACKLIST_CONFIG)
+// parseDisallowedNodes returns the black-listed nodes of disallowed-nodes.json.
+// A missing file means no node is black-listed.
+func parseDisallowedNodes(dataDir string) ([]enode.ID, error) {
+	log.Debug("parseDisallowedNodes", "DataDir", dataDir, "file", params.BLACKLIST_CONFIG)
 
 	path := filepath.Join(dataDir, params.BLACKLIST_CONFIG)
-	if _, err := os.Stat(path); err != nil {
-		log.Debug("Read Error for disallowed-nodes.json file. disallowed-nodes.json file is not present.", "err", err)
-		return false
-	}
-	// Load the nodes from the config file
 	blob, err := ioutil.ReadFile(path)
-	if err != nil {
-		log.Debug("isNodeBlackListed: Failed to access nodes", "err", err)
-		return true
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	// The file may have been created without any content yet
+	if len(blob) == 0 {
+		return nil, nil
 	}
-
 	nodelist := []string{}
 	if err := json.Unmarshal(blob, &nodelist); err != nil {
-		log.Debug("parsePermissionedNodes: Failed to load nodes", "err", err)
-		return true
+		return nil, err
 	}
-
-	for _, v := range nodelist {
-		if strings.Contains(v, nodeName) {
-			return true
+	var ids []enode.ID
+	for _, url := range nodelist {
+		node, err := enode.ParseV4(url)
+		if err != nil {
+			log.Error("parseDisallowedNodes: Node URL", "url", url, "err", err)
+			continue
 		}
+		ids = append(ids, node.ID())
 	}
-	return false
+	return ids, nil
 }
@@ -0,0 +1,83 @@
+package p2p
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/quorum/p2p/enode"
+	"github.com/ethereum/quorum/params"
+)
+
+func TestNodeAllowlist(t *testing.T) {
+	list := NewNodeAllowlist()
+	id := randomID()
+
+	if list.IsNodePermissioned(id) {
+		t.Fatal("unknown node is permissioned")
+	}
+	list.Allow(id)
+	if !list.IsNodePermissioned(id) {
+		t.Fatal("allowed node is not permissioned")
+	}
+	list.Disallow(id)
+	if list.IsNodePermissioned(id) {
+		t.Fatal("disallowed node is permissioned")
+	}
+	list.Readmit(id)
+	if !list.IsNodePermissioned(id) {
+		t.Fatal("readmitted node is not permissioned")
+	}
+	list.Revoke(id)
+	if list.IsNodePermissioned(id) {
+		t.Fatal("revoked node is permissioned")
+	}
+}
+
+func TestPermissionFileWatcher(t *testing.T) {
+	dir, err := ioutil.TempDir("", "p2p-permissions")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	writeNodes := func(file string, nodes ...*enode.Node) {
+		urls := make([]string, len(nodes))
+		for i, node := range nodes {
+			urls[i] = node.String()
+		}
+		blob, _ := json.Marshal(urls)
+		path := filepath.Join(dir, file)
+		if err := ioutil.WriteFile(path, blob, 0644); err != nil {
+			t.Fatal(err)
+		}
+		// make sure the modification is noticed regardless of the timestamp resolution
+		modTime := time.Now().Add(time.Duration(len(nodes)) * time.Second)
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	a := enode.NewV4(&newkey().PublicKey, nil, 30303, 30303, 0)
+	b := enode.NewV4(&newkey().PublicKey, nil, 30303, 30303, 0)
+
+	writeNodes(params.PERMISSIONED_CONFIG, a)
+	w := newPermissionFileWatcher(dir)
+	if !w.IsNodePermissioned(a.ID()) || w.IsNodePermissioned(b.ID()) {
+		t.Fatal("permissions mismatch after initial load")
+	}
+
+	writeNodes(params.PERMISSIONED_CONFIG, a, b)
+	w.reload()
+	if !w.IsNodePermissioned(a.ID()) || !w.IsNodePermissioned(b.ID()) {
+		t.Fatal("permissions mismatch after adding a node")
+	}
+
+	writeNodes(params.BLACKLIST_CONFIG, a)
+	w.reload()
+	if w.IsNodePermissioned(a.ID()) || !w.IsNodePermissioned(b.ID()) {
+		t.Fatal("permissions mismatch after black-listing a node")
+	}
+}
@@ -167,6 +167,10 @@ type Server struct {
 	lock    sync.Mutex // protects running
 	running bool
 
+	permissionLock    sync.RWMutex // protects permissioner and permissionWatcher
+	permissioner      NodePermissioner
+	permissionWatcher *permissionFileWatcher
+
 	nodedb       *enode.DB
 	localnode    *enode.LocalNode
 	ntab         discoverTable
@@ -179,17 +183,19 @@ type Server struct {
 	peerOp     chan peerOpFunc
 	peerOpDone chan struct{}
 
-	quit          chan struct{}
-	addstatic     chan *enode.Node
-	removestatic  chan *enode.Node
-	addtrusted    chan *enode.Node
-	removetrusted chan *enode.Node
-	posthandshake chan *conn
-	addpeer       chan *conn
-	delpeer       chan peerDrop
-	loopWG        sync.WaitGroup // loop, listenLoop
-	peerFeed      event.Feed
-	log           log.Logger
+	quit            chan struct{}
+	addstatic       chan *enode.Node
+	removestatic    chan *enode.Node
+	addtrusted      chan *enode.Node
+	removetrusted   chan *enode.Node
+	addvalidator    chan *enode.Node
+	removevalidator chan *enode.Node
+	posthandshake   chan *conn
+	addpeer         chan *conn
+	delpeer         chan peerDrop
+	loopWG          sync.WaitGroup // loop, listenLoop
+	peerFeed        event.Feed
+	log             log.Logger
 }
 
 type peerOpFunc func(map[enode.ID]*Peer)
@@ -207,6 +213,7 @@ const (
 	staticDialedConn
 	inboundConn
 	trustedConn
+	validatorConn
 )
 
 // conn wraps a network connection with information gathered
@@ -249,6 +256,9 @@ func (f connFlag) String() string {
 	if f&trustedConn != 0 {
 		s += "-trusted"
 	}
+	if f&validatorConn != 0 {
+		s += "-validator"
+	}
 	if f&dynDialedConn != 0 {
 		s += "-dyndial"
 	}
@@ -348,6 +358,24 @@ func (srv *Server) RemoveTrustedPeer(node *enode.Node) {
 	}
 }
 
+// AddValidatorPeer adds the given node to the validator pool. The server keeps
+// a direct connection to validators, which does not count against MaxPeers.
+func (srv *Server) AddValidatorPeer(node *enode.Node) {
+	select {
+	case srv.addvalidator <- node:
+	case <-srv.quit:
+	}
+}
+
+// RemoveValidatorPeer removes the given node from the validator pool. The
+// connection is kept only if the node is a regular peer as well.
+func (srv *Server) RemoveValidatorPeer(node *enode.Node) {
+	select {
+	case srv.removevalidator <- node:
+	case <-srv.quit:
+	}
+}
+
 // SubscribePeers subscribes the given channel to peer events
 func (srv *Server) SubscribeEvents(ch chan *PeerEvent) event.Subscription {
 	return srv.peerFeed.Subscribe(ch)
@@ -437,6 +465,16 @@ func (srv *Server) Start() (err error) {
 		srv.Dialer = TCPDialer{&net.Dialer{Timeout: defaultDialTimeout}}
 	}
 	srv.quit = make(chan struct{})
+	if srv.EnableNodePermission {
+		srv.permissionLock.Lock()
+		if srv.permissioner == nil {
+			// Legacy mode, follow the permission files of the data directory
+			srv.permissionWatcher = newPermissionFileWatcher(srv.DataDir)
+			srv.permissioner = srv.permissionWatcher
+			go srv.permissionWatcher.loop(srv.quit)
+		}
+		srv.permissionLock.Unlock()
+	}
 	srv.addpeer = make(chan *conn)
 	srv.delpeer = make(chan peerDrop)
 	srv.posthandshake = make(chan *conn)
@@ -444,6 +482,8 @@ func (srv *Server) Start() (err error) {
 	srv.removestatic = make(chan *enode.Node)
 	srv.addtrusted = make(chan *enode.Node)
 	srv.removetrusted = make(chan *enode.Node)
+	srv.addvalidator = make(chan *enode.Node)
+	srv.removevalidator = make(chan *enode.Node)
 	srv.peerOp = make(chan peerOpFunc)
 	srv.peerOpDone = make(chan struct{})
 
@@ -614,6 +654,8 @@ func (srv *Server) run(dialstate dialer) {
 		peers        = make(map[enode.ID]*Peer)
 		inboundCount = 0
 		trusted      = make(map[enode.ID]bool, len(srv.TrustedNodes))
+		validators   = make(map[enode.ID]bool)
+		static       = make(map[enode.ID]bool, len(srv.StaticNodes))
 		taskdone     = make(chan task, maxActiveDialTasks)
 		runningTasks []task
 		queuedTasks  []task // tasks that can't run yet
@@ -623,6 +665,11 @@ func (srv *Server) run(dialstate dialer) {
 	for _, n := range srv.TrustedNodes {
 		trusted[n.ID()] = true
 	}
+	// Remember the configured static nodes, so that dropping a validator
+	// from the pool does not stop dialing them.
+	for _, n := range srv.StaticNodes {
+		static[n.ID()] = true
+	}
 
 	// removes t from runningTasks
 	delTask := func(t task) {
@@ -667,12 +714,14 @@ running:
 			// ephemeral static peer list. Add it to the dialer,
 			// it will keep the node connected.
 			srv.log.Trace("Adding static node", "node", n)
+			static[n.ID()] = true
 			dialstate.addStatic(n)
 		case n := <-srv.removestatic:
 			// This channel is used by RemovePeer to send a
 			// disconnect request to a peer and begin the
 			// stop keeping the node connected.
 			srv.log.Trace("Removing static node", "node", n)
+			delete(static, n.ID())
 			dialstate.removeStatic(n)
 			if p, ok := peers[n.ID()]; ok {
 				p.Disconnect(DiscRequested)
@@ -697,6 +746,28 @@ running:
 			if p, ok := peers[n.ID()]; ok {
 				p.rw.set(trustedConn, false)
 			}
+		case n := <-srv.addvalidator:
+			// This channel is used by AddValidatorPeer to add an enode
+			// to the validator pool. The dialer keeps it connected.
+			srv.log.Trace("Adding validator node", "node", n)
+			validators[n.ID()] = true
+			dialstate.addStatic(n)
+			// Mark any already-connected peer as validator
+			if p, ok := peers[n.ID()]; ok {
+				p.rw.set(validatorConn, true)
+			}
+		case n := <-srv.removevalidator:
+			// This channel is used by RemoveValidatorPeer to remove an
+			// enode from the validator pool.
+			srv.log.Trace("Removing validator node", "node", n)
+			delete(validators, n.ID())
+			if !static[n.ID()] {
+				dialstate.removeStatic(n)
+			}
+			// Unmark any already-connected peer as validator
+			if p, ok := peers[n.ID()]; ok {
+				p.rw.set(validatorConn, false)
+			}
 		case op := <-srv.peerOp:
 			// This channel is used by Peers and PeerCount.
 			op(peers)
@@ -715,6 +786,10 @@ running:
 				// Ensure that the trusted flag is set before checking against MaxPeers.
 				c.flags |= trustedConn
 			}
+			if validators[c.node.ID()] {
+				// Validator connections have their own pool, outside of MaxPeers.
+				c.flags |= validatorConn
+			}
 			// TODO: track in-progress inbound node IDs (pre-Peer) to avoid dialing them.
 			select {
 			case c.cont <- srv.encHandshakeChecks(peers, inboundCount, c):
@@ -793,11 +868,27 @@ func (srv *Server) protoHandshakeChecks(peers map[enode.ID]*Peer, inboundCount i
 	return srv.encHandshakeChecks(peers, inboundCount, c)
 }
 
+// countValidatorPeers returns the number of peers in the validator pool and
+// how many of them are inbound connections.
+func countValidatorPeers(peers map[enode.ID]*Peer) (validators int, inbound int) {
+	for _, p := range peers {
+		if p.rw.is(validatorConn) {
+			validators++
+			if p.rw.is(inboundConn) {
+				inbound++
+			}
+		}
+	}
+	return validators, inbound
+}
+
 func (srv *Server) encHandshakeChecks(peers map[enode.ID]*Peer, inboundCount int, c *conn) error {
+	// Validator connections do not take up regular slots
+	validators, inboundValidators := countValidatorPeers(peers)
 	switch {
-	case !c.is(trustedConn|staticDialedConn) && len(peers) >= srv.MaxPeers:
+	case !c.is(trustedConn|staticDialedConn|validatorConn) && len(peers)-validators >= srv.MaxPeers:
 		return DiscTooManyPeers
-	case !c.is(trustedConn) && c.is(inboundConn) && inboundCount >= srv.maxInboundConns():
+	case !c.is(trustedConn|validatorConn) && c.is(inboundConn) && inboundCount-inboundValidators >= srv.maxInboundConns():
 		return DiscTooManyPeers
 	case peers[c.node.ID()] != nil:
 		return DiscAlreadyConnected
@@ -941,15 +1032,15 @@ func (srv *Server) setupConn(c *conn, flags connFlag, dialDest *enode.Node) erro
 
 	if srv.EnableNodePermission {
 		clog.Trace("Node Permissioning is Enabled.")
-		node := c.node.ID().String()
+		node := c.node.ID()
 		direction := "INCOMING"
 		if dialDest != nil {
-			node = dialDest.ID().String()
+			node = dialDest.ID()
 			direction = "OUTGOING"
 			log.Trace("Node Permissioning", "Connection Direction", direction)
 		}
 
-		if !isNodePermissioned(node, currentNode, srv.DataDir, direction) {
+		if !srv.isNodePermissioned(node, currentNode, direction) {
 			return nil
 		}
 	} else {
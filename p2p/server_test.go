@@ -414,6 +414,71 @@ func TestServerAtCap(t *testing.T) {
 	}
 }
 
+// This test checks that validator connections have their own pool: they are
+// accepted when the server is at capacity and do not count against MaxPeers.
+func TestServerValidatorPool(t *testing.T) {
+	validatorKey := newkey()
+	validatorID := enode.PubkeyToIDV4(&validatorKey.PublicKey)
+	srv := &Server{
+		Config: Config{
+			PrivateKey: newkey(),
+			MaxPeers:   2,
+			NoDial:     true,
+		},
+	}
+	if err := srv.Start(); err != nil {
+		t.Fatalf("could not start: %v", err)
+	}
+	defer srv.Stop()
+
+	newconn := func(id enode.ID) *conn {
+		fd, _ := net.Pipe()
+		tx := newTestTransport(&validatorKey.PublicKey, fd)
+		node := enode.SignNull(new(enr.Record), id)
+		return &conn{fd: fd, transport: tx, flags: inboundConn, node: node, cont: make(chan error)}
+	}
+
+	// Add the validator first, it must not use up a regular slot.
+	srv.AddValidatorPeer(enode.NewV4(&validatorKey.PublicKey, net.IP{127, 0, 0, 1}, 1, 0, 0))
+	c := newconn(validatorID)
+	if err := srv.checkpoint(c, srv.posthandshake); err != nil {
+		t.Fatalf("unexpected error for validator conn @posthandshake: %v", err)
+	}
+	if err := srv.checkpoint(c, srv.addpeer); err != nil {
+		t.Fatalf("could not add validator conn: %v", err)
+	}
+	if !c.is(validatorConn) {
+		t.Error("Server did not set validator flag")
+	}
+	for i := 0; i < 2; i++ {
+		c := newconn(randomID())
+		if err := srv.checkpoint(c, srv.addpeer); err != nil {
+			t.Fatalf("could not add conn %d: %v", i, err)
+		}
+	}
+	// The regular slots are full now.
+	c = newconn(randomID())
+	if err := srv.checkpoint(c, srv.posthandshake); err != DiscTooManyPeers {
+		t.Error("wrong error for insert:", err)
+	}
+
+	// Another validator is still accepted.
+	anotherKey := newkey()
+	anotherNode := enode.NewV4(&anotherKey.PublicKey, net.IP{127, 0, 0, 1}, 1, 0, 0)
+	srv.AddValidatorPeer(anotherNode)
+	c = newconn(anotherNode.ID())
+	if err := srv.checkpoint(c, srv.posthandshake); err != nil {
+		t.Error("unexpected error for validator conn @posthandshake:", err)
+	}
+
+	// Once removed from the pool, it competes for the regular slots again.
+	srv.RemoveValidatorPeer(anotherNode)
+	c = newconn(anotherNode.ID())
+	if err := srv.checkpoint(c, srv.posthandshake); err != DiscTooManyPeers {
+		t.Error("wrong error for insert:", err)
+	}
+}
+
 func TestServerPeerLimits(t *testing.T) {
 	srvkey := newkey()
 	clientkey := newkey()
@@ -133,19 +133,19 @@ var (
 	//
 	// This configuration is intentionally not using keyed fields to force anyone
 	// adding flags to the config to also have to set these fields.
-	AllEthashProtocolChanges = &ChainConfig{big.NewInt(1337), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, new(EthashConfig), nil, nil, false, 32, 50, big.NewInt(0)}
+	AllEthashProtocolChanges = &ChainConfig{big.NewInt(1337), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, new(EthashConfig), nil, nil, false, 32, 50, big.NewInt(0), nil, nil}
 
 	// AllCliqueProtocolChanges contains every protocol change (EIPs) introduced
 	// and accepted by the Ethereum core developers into the Clique consensus.
 	//
 	// This configuration is intentionally not using keyed fields to force anyone
 	// adding flags to the config to also have to set these fields.
-	AllCliqueProtocolChanges = &ChainConfig{big.NewInt(1337), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, &CliqueConfig{Period: 0, Epoch: 30000}, nil, false, 32, 32, big.NewInt(0)}
+	AllCliqueProtocolChanges = &ChainConfig{big.NewInt(1337), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, &CliqueConfig{Period: 0, Epoch: 30000}, nil, false, 32, 32, big.NewInt(0), nil, nil}
 
-	TestChainConfig = &ChainConfig{big.NewInt(10), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, new(EthashConfig), nil, nil, false, 32, 32, big.NewInt(0)}
+	TestChainConfig = &ChainConfig{big.NewInt(10), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, new(EthashConfig), nil, nil, false, 32, 32, big.NewInt(0), nil, nil}
 	TestRules       = TestChainConfig.Rules(new(big.Int))
 
-	QuorumTestChainConfig = &ChainConfig{big.NewInt(10), big.NewInt(0), nil, false, nil, common.Hash{}, nil, nil, nil, nil, nil, new(EthashConfig), nil, nil, true, 64, 32, big.NewInt(0)}
+	QuorumTestChainConfig = &ChainConfig{big.NewInt(10), big.NewInt(0), nil, false, nil, common.Hash{}, nil, nil, nil, nil, nil, new(EthashConfig), nil, nil, true, 64, 32, big.NewInt(0), nil, nil}
 )
 
 // TrustedCheckpoint represents a set of post-processed trie roots (CHT and
@@ -196,6 +196,10 @@ type ChainConfig struct {
 	//
 	// QIP714Block implements the permissions related changes
 	QIP714Block *big.Int `json:"qip714Block,omitempty"`
+	// IstanbulBlock switches a raft network over to Istanbul consensus, starting
+	// with IstanbulValidators as the validator set
+	IstanbulBlock      *big.Int         `json:"istanbulBlock,omitempty"`
+	IstanbulValidators []common.Address `json:"istanbulValidators,omitempty"`
 }
 
 // EthashConfig is the consensus engine configs for proof-of-work based sealing.
@@ -219,9 +223,18 @@ func (c *CliqueConfig) String() string {
 
 // IstanbulConfig is the consensus engine configs for Istanbul based sealing.
 type IstanbulConfig struct {
-	Epoch          uint64   `json:"epoch"`                    // Epoch length to reset votes and checkpoint
-	ProposerPolicy uint64   `json:"policy"`                   // The policy for proposer selection
-	Ceil2Nby3Block *big.Int `json:"ceil2Nby3Block,omitempty"` // Number of confirmations required to move from one state to next [2F + 1 to Ceil(2N/3)]
+	Epoch             uint64                `json:"epoch"`                       // Epoch length to reset votes and checkpoint
+	ProposerPolicy    uint64                `json:"policy"`                      // The policy for proposer selection
+	Ceil2Nby3Block    *big.Int              `json:"ceil2Nby3Block,omitempty"`    // Number of confirmations required to move from one state to next [2F + 1 to Ceil(2N/3)]
+	ValidatorSetBlock *big.Int              `json:"validatorSetBlock,omitempty"` // Block from which headers have to list the validators sealing them
+	BlockPeriods      []IstanbulBlockPeriod `json:"blockPeriods,omitempty"`      // Block period transitions, ordered by block number
+}
+
+// IstanbulBlockPeriod schedules the minimum number of seconds between two
+// Istanbul blocks from the given block on.
+type IstanbulBlockPeriod struct {
+	Block  *big.Int `json:"block"`
+	Period uint64   `json:"period"`
 }
 
 // String implements the stringer interface, returning the consensus engine details.
@@ -267,6 +280,32 @@ func (c *ChainConfig) IsValid() error {
 		return errors.New("Genesis max code size must be between 24 and 128")
 	}
 
+	if c.Istanbul != nil {
+		for i, transition := range c.Istanbul.BlockPeriods {
+			if transition.Block == nil {
+				return errors.New("Istanbul block period transition requires a block number")
+			}
+			if i == 0 && transition.Block.Sign() != 0 {
+				return errors.New("Istanbul block period transitions must start at block 0")
+			}
+			if i > 0 && transition.Block.Cmp(c.Istanbul.BlockPeriods[i-1].Block) <= 0 {
+				return errors.New("Istanbul block period transitions must be ordered by block number")
+			}
+		}
+	}
+
+	if c.IstanbulBlock != nil {
+		if c.Istanbul == nil {
+			return errors.New("Istanbul fork block requires an istanbul consensus config")
+		}
+		if c.IstanbulBlock.Sign() <= 0 {
+			return errors.New("Istanbul fork block must be greater than 0")
+		}
+		if len(c.IstanbulValidators) == 0 {
+			return errors.New("Istanbul fork block requires an initial validator list")
+		}
+	}
+
 	return nil
 }
 
@@ -317,6 +356,12 @@ func (c *ChainConfig) IsQIP714(num *big.Int) bool {
 	return isForked(c.QIP714Block, num)
 }
 
+// IsIstanbul returns whether num represents a block number where Istanbul took
+// over from raft consensus
+func (c *ChainConfig) IsIstanbul(num *big.Int) bool {
+	return isForked(c.IstanbulBlock, num)
+}
+
 // GasTable returns the gas table corresponding to the current phase (homestead or homestead reprice).
 //
 // The returned GasTable's fields shouldn't, under any circumstances, be changed.
@@ -391,12 +436,47 @@ func (c *ChainConfig) checkCompatible(newcfg *ChainConfig, head *big.Int, isQuor
 	if c.Istanbul != nil && newcfg.Istanbul != nil && isForkIncompatible(c.Istanbul.Ceil2Nby3Block, newcfg.Istanbul.Ceil2Nby3Block, head) {
 		return newCompatError("Ceil 2N/3 fork block", c.Istanbul.Ceil2Nby3Block, newcfg.Istanbul.Ceil2Nby3Block)
 	}
+	if c.Istanbul != nil && newcfg.Istanbul != nil && isForkIncompatible(c.Istanbul.ValidatorSetBlock, newcfg.Istanbul.ValidatorSetBlock, head) {
+		return newCompatError("Istanbul validator set fork block", c.Istanbul.ValidatorSetBlock, newcfg.Istanbul.ValidatorSetBlock)
+	}
+	if c.Istanbul != nil && newcfg.Istanbul != nil {
+		if stored, updated := blockPeriodChange(c.Istanbul.BlockPeriods, newcfg.Istanbul.BlockPeriods); isForkIncompatible(stored, updated, head) {
+			return newCompatError("Istanbul block period", stored, updated)
+		}
+	}
 	if isForkIncompatible(c.QIP714Block, newcfg.QIP714Block, head) {
 		return newCompatError("permissions fork block", c.QIP714Block, newcfg.QIP714Block)
 	}
+	if isForkIncompatible(c.IstanbulBlock, newcfg.IstanbulBlock, head) {
+		return newCompatError("Istanbul fork block", c.IstanbulBlock, newcfg.IstanbulBlock)
+	}
 	return nil
 }
 
+// blockPeriodChange returns the block numbers of the first block period
+// transition that differs between the stored and the new schedule. A chain
+// without a schedule may adopt one starting with the period it ran at from
+// block 0 on.
+func blockPeriodChange(stored, updated []IstanbulBlockPeriod) (*big.Int, *big.Int) {
+	if len(stored) == 0 && len(updated) > 0 && updated[0].Block != nil && updated[0].Block.Sign() == 0 {
+		updated = updated[1:]
+	}
+	for i := 0; i < len(stored) || i < len(updated); i++ {
+		switch {
+		case i >= len(stored):
+			return nil, updated[i].Block
+		case i >= len(updated):
+			return stored[i].Block, nil
+		case !configNumEqual(stored[i].Block, updated[i].Block):
+			return stored[i].Block, updated[i].Block
+		case stored[i].Period != updated[i].Period:
+			// Pretend the transition moved, as the period changed from its block on
+			return stored[i].Block, nil
+		}
+	}
+	return nil, nil
+}
+
 // isForkIncompatible returns true if a fork scheduled at s1 cannot be rescheduled to
 // block s2 because head is already past the fork.
 func isForkIncompatible(s1, s2, head *big.Int) bool {
@@ -87,6 +87,40 @@ func TestCheckCompatible(t *testing.T) {
 				RewindTo:     9,
 			},
 		},
+		{
+			stored:  &ChainConfig{Istanbul: &IstanbulConfig{BlockPeriods: []IstanbulBlockPeriod{{Block: big.NewInt(10), Period: 5}}}},
+			new:     &ChainConfig{Istanbul: &IstanbulConfig{BlockPeriods: []IstanbulBlockPeriod{{Block: big.NewInt(10), Period: 5}, {Block: big.NewInt(50), Period: 2}}}},
+			head:    30,
+			wantErr: nil,
+		},
+		{
+			stored:  &ChainConfig{Istanbul: &IstanbulConfig{}},
+			new:     &ChainConfig{Istanbul: &IstanbulConfig{BlockPeriods: []IstanbulBlockPeriod{{Block: big.NewInt(0), Period: 1}, {Block: big.NewInt(50), Period: 2}}}},
+			head:    30,
+			wantErr: nil,
+		},
+		{
+			stored: &ChainConfig{Istanbul: &IstanbulConfig{}},
+			new:    &ChainConfig{Istanbul: &IstanbulConfig{BlockPeriods: []IstanbulBlockPeriod{{Block: big.NewInt(0), Period: 1}, {Block: big.NewInt(20), Period: 2}}}},
+			head:   30,
+			wantErr: &ConfigCompatError{
+				What:         "Istanbul block period",
+				StoredConfig: nil,
+				NewConfig:    big.NewInt(20),
+				RewindTo:     19,
+			},
+		},
+		{
+			stored: &ChainConfig{Istanbul: &IstanbulConfig{BlockPeriods: []IstanbulBlockPeriod{{Block: big.NewInt(10), Period: 5}}}},
+			new:    &ChainConfig{Istanbul: &IstanbulConfig{BlockPeriods: []IstanbulBlockPeriod{{Block: big.NewInt(10), Period: 2}}}},
+			head:   30,
+			wantErr: &ConfigCompatError{
+				What:         "Istanbul block period",
+				StoredConfig: big.NewInt(10),
+				NewConfig:    nil,
+				RewindTo:     9,
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -96,3 +130,24 @@ func TestCheckCompatible(t *testing.T) {
 		}
 	}
 }
+
+func TestIstanbulBlockPeriodsValid(t *testing.T) {
+	config := func(periods ...IstanbulBlockPeriod) *ChainConfig {
+		return &ChainConfig{TransactionSizeLimit: 32, MaxCodeSize: 24, Istanbul: &IstanbulConfig{BlockPeriods: periods}}
+	}
+	tests := []struct {
+		config *ChainConfig
+		valid  bool
+	}{
+		{config(), true},
+		{config(IstanbulBlockPeriod{Block: big.NewInt(0), Period: 1}, IstanbulBlockPeriod{Block: big.NewInt(10), Period: 5}), true},
+		{config(IstanbulBlockPeriod{Block: big.NewInt(10), Period: 5}), false},
+		{config(IstanbulBlockPeriod{Block: big.NewInt(0), Period: 1}, IstanbulBlockPeriod{Block: big.NewInt(0), Period: 5}), false},
+		{config(IstanbulBlockPeriod{Period: 1}), false},
+	}
+	for i, test := range tests {
+		if err := test.config.IsValid(); (err == nil) != test.valid {
+			t.Errorf("test %d: validity mismatch: have %v, want valid %v", i, err, test.valid)
+		}
+	}
+}
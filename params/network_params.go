@@ -51,4 +51,9 @@ const (
 	// HelperTrieProcessConfirmations is the number of confirmations before a HelperTrie
 	// is generated
 	HelperTrieProcessConfirmations = 256
+
+	// ImmutabilityThreshold is the number of blocks after which a chain segment is
+	// considered immutable (i.e. soft finality). It is used by the chain freezer to
+	// move old blocks out of the key-value store into the ancient store.
+	ImmutabilityThreshold = 90000
 )
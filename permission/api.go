@@ -1,6 +1,8 @@
 package permission
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -9,6 +11,7 @@ import (
 	"github.com/ethereum/quorum/accounts"
 	"github.com/ethereum/quorum/accounts/abi/bind"
 	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/common/hexutil"
 	"github.com/ethereum/quorum/core/types"
 	"github.com/ethereum/quorum/internal/ethapi"
 	"github.com/ethereum/quorum/log"
@@ -46,6 +49,10 @@ const (
 	InitiateAccountRecovery
 	ApproveNodeRecovery
 	ApproveAccountRecovery
+	AddRolePolicy
+	RemoveRolePolicy
+	UpgradeImplementation
+	SetAccountExpiry
 )
 
 type AccountUpdateAction int
@@ -94,6 +101,7 @@ type txArgs struct {
 	voter      common.Address
 	morgId     string
 	tmKey      string
+	policy     types.RolePolicy
 	txa        ethapi.SendTxArgs
 }
 
@@ -145,6 +153,13 @@ var (
 	ErrInvalidRole        = ExecStatus{false, "Invalid role"}
 	ErrInvalidInput       = ExecStatus{false, "Invalid input"}
 	ErrNotMasterOrg       = ExecStatus{false, "Org is not a master org"}
+	ErrInvalidSelector    = ExecStatus{false, "Method selector must be 4 bytes"}
+	ErrPolicyExists       = ExecStatus{false, "Policy exists for the role"}
+	ErrPolicyNotFound     = ExecStatus{false, "Policy does not exist for the role"}
+	ErrNotGuardian        = ExecStatus{false, "Operation can be performed by guardian only. Account not the guardian."}
+	ErrInvalidImpl        = ExecStatus{false, "No contract deployed at the implementation address"}
+	ErrImplUnchanged      = ExecStatus{false, "Implementation is already in use"}
+	ErrOrgAdminExpiry     = ExecStatus{false, "Org admin accounts cannot expire"}
 
 	ExecSuccess = ExecStatus{true, "Action completed successfully"}
 )
@@ -170,6 +185,14 @@ func (q *QuorumControlsAPI) AcctList() []types.AccountInfo {
 	return types.AcctInfoMap.GetAcctList()
 }
 
+// GetHistory returns the page of permission contract events matching the filter
+func (q *QuorumControlsAPI) GetHistory(filter HistoryFilter) (HistoryPage, error) {
+	if q.permCtrl.history == nil {
+		return HistoryPage{}, errHistoryUnavailable
+	}
+	return q.permCtrl.history.query(filter)
+}
+
 func (q *QuorumControlsAPI) GetOrgDetails(orgId string) (types.OrgDetailInfo, error) {
 	if o := types.OrgInfoMap.GetOrg(orgId); o == nil {
 		return types.OrgDetailInfo{}, errors.New("org does not exist")
@@ -411,6 +434,50 @@ func (q *QuorumControlsAPI) RemoveRole(orgId string, roleId string, txa ethapi.S
 	return ExecSuccess.OpStatus()
 }
 
+// AddRolePolicy allows the accounts of the role to call the contract method
+// with the given 4 byte selector, a zero selector allows any method. Once a
+// role has policies its accounts can only call the contracts they allow.
+func (q *QuorumControlsAPI) AddRolePolicy(orgId string, roleId string, contract common.Address, selector hexutil.Bytes, txa ethapi.SendTxArgs) (string, error) {
+	pinterf, execStatus := q.initOp(txa)
+	if execStatus != ExecSuccess {
+		return execStatus.OpStatus()
+	}
+	args := txArgs{orgId: orgId, roleId: roleId, policy: types.RolePolicy{Contract: contract, Selector: selector}, txa: txa}
+
+	if execStatus := q.valAddRolePolicy(args, pinterf); execStatus != ExecSuccess {
+		return execStatus.OpStatus()
+	}
+	var sel [4]byte
+	copy(sel[:], selector)
+	tx, err := pinterf.AddRolePolicy(args.roleId, args.orgId, contract, sel)
+	if err != nil {
+		return reportExecError(AddRolePolicy, err)
+	}
+	log.Debug("executed permission action", "action", AddRolePolicy, "tx", tx)
+	return ExecSuccess.OpStatus()
+}
+
+// RemoveRolePolicy removes a policy added with AddRolePolicy.
+func (q *QuorumControlsAPI) RemoveRolePolicy(orgId string, roleId string, contract common.Address, selector hexutil.Bytes, txa ethapi.SendTxArgs) (string, error) {
+	pinterf, execStatus := q.initOp(txa)
+	if execStatus != ExecSuccess {
+		return execStatus.OpStatus()
+	}
+	args := txArgs{orgId: orgId, roleId: roleId, policy: types.RolePolicy{Contract: contract, Selector: selector}, txa: txa}
+
+	if execStatus := q.valRemoveRolePolicy(args, pinterf); execStatus != ExecSuccess {
+		return execStatus.OpStatus()
+	}
+	var sel [4]byte
+	copy(sel[:], selector)
+	tx, err := pinterf.RemoveRolePolicy(args.roleId, args.orgId, contract, sel)
+	if err != nil {
+		return reportExecError(RemoveRolePolicy, err)
+	}
+	log.Debug("executed permission action", "action", RemoveRolePolicy, "tx", tx)
+	return ExecSuccess.OpStatus()
+}
+
 func (q *QuorumControlsAPI) AddAccountToOrg(acct common.Address, orgId string, roleId string, txa ethapi.SendTxArgs) (string, error) {
 	pinterf, execStatus := q.initOp(txa)
 	if execStatus != ExecSuccess {
@@ -464,6 +531,27 @@ func (q *QuorumControlsAPI) UpdateAccountStatus(orgId string, acct common.Addres
 	return ExecSuccess.OpStatus()
 }
 
+// SetAccountExpiry sets the block number and unix time from which the role of
+// the account lapses, zero for none. The expiry is cleared when the account is
+// assigned a new role.
+func (q *QuorumControlsAPI) SetAccountExpiry(orgId string, acct common.Address, expiryBlock uint64, expiryTime uint64, txa ethapi.SendTxArgs) (string, error) {
+	pinterf, execStatus := q.initOp(txa)
+	if execStatus != ExecSuccess {
+		return execStatus.OpStatus()
+	}
+	args := txArgs{orgId: orgId, acctId: acct, txa: txa}
+
+	if execStatus := q.valSetAccountExpiry(args, pinterf); execStatus != ExecSuccess {
+		return execStatus.OpStatus()
+	}
+	tx, err := pinterf.SetAccountExpiry(args.acctId, args.orgId, new(big.Int).SetUint64(expiryBlock), new(big.Int).SetUint64(expiryTime))
+	if err != nil {
+		return reportExecError(SetAccountExpiry, err)
+	}
+	log.Debug("executed permission action", "action", SetAccountExpiry, "tx", tx)
+	return ExecSuccess.OpStatus()
+}
+
 func (q *QuorumControlsAPI) RecoverBlackListedNode(orgId string, enodeId string, txa ethapi.SendTxArgs) (string, error) {
 	pinterf, execStatus := q.initOp(txa)
 	if execStatus != ExecSuccess {
@@ -536,6 +624,46 @@ func (q *QuorumControlsAPI) ApproveBlackListedAccountRecovery(orgId string, acct
 	return ExecSuccess.OpStatus()
 }
 
+// UpgradeImplementation switches the permissions implementation to the
+// contract at impl, carrying the network admin policy forward. Only the
+// guardian of the upgradable contract can switch implementations. The node
+// rebinds the implementation once the change is mined and reports the cache
+// entries inconsistent with the contracts.
+func (q *QuorumControlsAPI) UpgradeImplementation(impl common.Address, txa ethapi.SendTxArgs) (string, error) {
+	w, err := q.validateAccount(txa.From)
+	if err != nil {
+		return ErrInvalidAccount.OpStatus()
+	}
+	if execStatus := q.valUpgradeImplementation(impl, txa); execStatus != ExecSuccess {
+		return execStatus.OpStatus()
+	}
+	frmAcct, transactOpts, gasLimit, gasPrice := q.getTxParams(txa, w)
+	session := &pbind.PermUpgrSession{
+		Contract: q.permCtrl.permUpgr,
+		CallOpts: bind.CallOpts{
+			Pending: true,
+		},
+		TransactOpts: bind.TransactOpts{
+			From:     frmAcct.Address,
+			GasLimit: gasLimit,
+			GasPrice: gasPrice,
+			Signer:   transactOpts.Signer,
+		},
+	}
+	tx, err := session.ConfirmImplChange(impl)
+	if err != nil {
+		return reportExecError(UpgradeImplementation, err)
+	}
+	log.Debug("executed permission action", "action", UpgradeImplementation, "tx", tx)
+	return ExecSuccess.OpStatus()
+}
+
+// CheckCaches returns the entries of the permission caches which differ from
+// the permission contracts, e.g. after the implementation was upgraded.
+func (q *QuorumControlsAPI) CheckCaches() ([]CacheMismatch, error) {
+	return q.permCtrl.checkCaches()
+}
+
 // check if the account is network admin
 func (q *QuorumControlsAPI) isNetworkAdmin(account common.Address) bool {
 	ac := types.AcctInfoMap.GetAccount(account)
@@ -978,6 +1106,52 @@ func (q *QuorumControlsAPI) valRemoveRole(args txArgs, pinterf *pbind.PermInterf
 	return ExecSuccess
 }
 
+// hasRolePolicy checks if the role of the org has the policy.
+func hasRolePolicy(r *types.RoleInfo, policy types.RolePolicy) bool {
+	for _, p := range r.Policies {
+		if p.Contract == policy.Contract && bytes.Equal(p.Selector, policy.Selector) {
+			return true
+		}
+	}
+	return false
+}
+
+func (q *QuorumControlsAPI) valAddRolePolicy(args txArgs, pinterf *pbind.PermInterfaceSession) ExecStatus {
+	if len(args.policy.Selector) != 4 {
+		return ErrInvalidSelector
+	}
+	if execStatus, er := q.isOrgAdmin(args.txa.From, args.orgId); er != nil {
+		return execStatus
+	}
+	r := types.RoleInfoMap.GetRole(args.orgId, args.roleId)
+	if r == nil {
+		return ErrInvalidRole
+	} else if !r.Active {
+		return ErrInactiveRole
+	}
+	if hasRolePolicy(r, args.policy) {
+		return ErrPolicyExists
+	}
+	return ExecSuccess
+}
+
+func (q *QuorumControlsAPI) valRemoveRolePolicy(args txArgs, pinterf *pbind.PermInterfaceSession) ExecStatus {
+	if len(args.policy.Selector) != 4 {
+		return ErrInvalidSelector
+	}
+	if execStatus, er := q.isOrgAdmin(args.txa.From, args.orgId); er != nil {
+		return execStatus
+	}
+	r := types.RoleInfoMap.GetRole(args.orgId, args.roleId)
+	if r == nil {
+		return ErrInvalidRole
+	}
+	if !hasRolePolicy(r, args.policy) {
+		return ErrPolicyNotFound
+	}
+	return ExecSuccess
+}
+
 func (q *QuorumControlsAPI) valAssignRole(args txArgs, pinterf *pbind.PermInterfaceSession) ExecStatus {
 	if args.acctId == (common.Address{0}) {
 		return ErrInvalidInput
@@ -1016,6 +1190,24 @@ func (q *QuorumControlsAPI) valUpdateAccountStatus(args txArgs, permAction PermA
 	return ExecSuccess
 }
 
+func (q *QuorumControlsAPI) valSetAccountExpiry(args txArgs, pinterf *pbind.PermInterfaceSession) ExecStatus {
+	// check if the caller is org admin
+	if execStatus, er := q.isOrgAdmin(args.txa.From, args.orgId); er != nil {
+		return execStatus
+	}
+	ac := types.AcctInfoMap.GetAccount(args.acctId)
+	if ac == nil {
+		return ErrAccountNotThere
+	}
+	if ac.OrgId != args.orgId {
+		return ErrOrgNotOwner
+	}
+	if ac.IsOrgAdmin {
+		return ErrOrgAdminExpiry
+	}
+	return ExecSuccess
+}
+
 func (q *QuorumControlsAPI) valRecoverNode(args txArgs, pinterf *pbind.PermInterfaceSession, action PermAction) ExecStatus {
 	// check if the caller is org admin
 	if !q.isNetworkAdmin(args.txa.From) {
@@ -1078,6 +1270,27 @@ func (q *QuorumControlsAPI) valRecoverAccount(args txArgs, pinterf *pbind.PermIn
 	return ExecSuccess
 }
 
+func (q *QuorumControlsAPI) valUpgradeImplementation(impl common.Address, txa ethapi.SendTxArgs) ExecStatus {
+	guardian, err := q.permCtrl.permUpgr.GetGuardian(&bind.CallOpts{Pending: true})
+	if err != nil {
+		return ExecStatus{false, err.Error()}
+	}
+	if guardian != txa.From {
+		return ErrNotGuardian
+	}
+	if current, _ := q.permCtrl.implementation(); current == impl {
+		return ErrImplUnchanged
+	}
+	code, err := q.permCtrl.ethClnt.PendingCodeAt(context.Background(), impl)
+	if err != nil {
+		return ExecStatus{false, err.Error()}
+	}
+	if len(code) == 0 {
+		return ErrInvalidImpl
+	}
+	return ExecSuccess
+}
+
 // validateAccount validates the account and returns the wallet associated with that for signing the transaction
 func (q *QuorumControlsAPI) validateAccount(from common.Address) (accounts.Wallet, error) {
 	acct := accounts.Account{Address: from}
@@ -0,0 +1,123 @@
+package permission
+
+import (
+	"math/big"
+
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/core/types"
+	"github.com/ethereum/quorum/log"
+	pbind "github.com/ethereum/quorum/permission/bind"
+)
+
+// setCacheLookups makes misses of the permission caches fall back to calling
+// the permission contracts at the current head. The transaction pool and block
+// production only trigger the lookups, which run in the background.
+func (p *PermissionCtrl) setCacheLookups() {
+	orgSession := &pbind.OrgManagerCallerSession{Contract: &p.permOrg.OrgManagerCaller}
+	nodeSession := &pbind.NodeManagerCallerSession{Contract: &p.permNode.NodeManagerCaller}
+	roleSession := &pbind.RoleManagerCallerSession{Contract: &p.permRole.RoleManagerCaller}
+	acctSession := &pbind.AcctManagerCallerSession{Contract: &p.permAcct.AcctManagerCaller}
+
+	types.OrgInfoMap.PopulateCacheFunc(func(orgId string) (*types.OrgInfo, error) {
+		return lookupOrg(orgSession, orgId)
+	})
+	types.NodeInfoMap.PopulateCacheFunc(func(url string) (*types.NodeInfo, error) {
+		return lookupNode(nodeSession, url)
+	})
+	types.RoleInfoMap.PopulateCacheFunc(func(orgId, roleId string) (*types.RoleInfo, error) {
+		return lookupRole(roleSession, orgId, roleId)
+	})
+	types.AcctInfoMap.PopulateCacheFunc(func(acct common.Address) (*types.AccountInfo, error) {
+		return lookupAccount(acctSession, acct)
+	})
+}
+
+// clearCacheLookups stops calling the permission contracts on cache misses.
+func clearCacheLookups() {
+	types.OrgInfoMap.PopulateCacheFunc(nil)
+	types.NodeInfoMap.PopulateCacheFunc(nil)
+	types.RoleInfoMap.PopulateCacheFunc(nil)
+	types.AcctInfoMap.PopulateCacheFunc(nil)
+	types.SetPermissionsAt(nil)
+}
+
+// lookupNode reads the node from the contract, nil if it does not exist.
+func lookupNode(session *pbind.NodeManagerCallerSession, url string) (*types.NodeInfo, error) {
+	// unknown nodes revert the call
+	node, err := session.GetNodeDetails(url)
+	if err != nil || node.EnodeId == "" {
+		return nil, err
+	}
+	return &types.NodeInfo{OrgId: node.OrgId, Url: node.EnodeId, Status: types.NodeStatus(node.NodeStatus.Int64())}, nil
+}
+
+// lookupRole reads the role from the contract, nil if it does not exist.
+func lookupRole(session *pbind.RoleManagerCallerSession, orgId, roleId string) (*types.RoleInfo, error) {
+	role, err := session.GetRoleDetails(roleId, orgId)
+	if err != nil || role.OrgId == "" {
+		return nil, err
+	}
+	return &types.RoleInfo{OrgId: role.OrgId, RoleId: role.RoleId, IsVoter: role.Voter, IsAdmin: role.Admin,
+		Access: types.AccessType(role.AccessType.Int64()), Active: role.Active, Policies: lookupRolePolicies(session, orgId, roleId)}, nil
+}
+
+// lookupAccount reads the account from the contract, nil if it does not exist.
+func lookupAccount(session *pbind.AcctManagerCallerSession, acct common.Address) (*types.AccountInfo, error) {
+	addr, orgId, roleId, status, orgAdmin, err := session.GetAccountDetails(acct)
+	if err != nil || orgId == "NONE" {
+		return nil, err
+	}
+	expiryBlock, expiryTime := lookupAccountExpiry(session, acct)
+	return &types.AccountInfo{OrgId: orgId, RoleId: roleId, AcctId: addr, IsOrgAdmin: orgAdmin, Status: types.AcctStatus(status.Int64()),
+		ExpiryBlock: expiryBlock, ExpiryTime: expiryTime}, nil
+}
+
+// lookupAccountExpiry reads the expiry of the role of the account from the
+// contract. The account manager contracts deployed before role expiries were
+// added have none.
+func lookupAccountExpiry(session *pbind.AcctManagerCallerSession, acct common.Address) (uint64, uint64) {
+	expiryBlock, expiryTime, err := session.GetAccountExpiry(acct)
+	if err != nil {
+		log.Debug("Account expiry not available", "account", acct, "err", err)
+		return 0, 0
+	}
+	return expiryBlock.Uint64(), expiryTime.Uint64()
+}
+
+// lookupRolePolicies reads the policies of the role from the contract. The
+// role manager contracts deployed before role policies were added have none.
+func lookupRolePolicies(session *pbind.RoleManagerCallerSession, orgId, roleId string) []types.RolePolicy {
+	count, err := session.GetNumberOfRolePolicies(roleId, orgId)
+	if err != nil {
+		log.Debug("Role policies not available", "org", orgId, "role", roleId, "err", err)
+		return nil
+	}
+	var policies []types.RolePolicy
+	for i := int64(0); i < count.Int64(); i++ {
+		contract, selector, err := session.GetRolePolicy(roleId, orgId, big.NewInt(i))
+		if err != nil {
+			log.Debug("Role policy not available", "org", orgId, "role", roleId, "index", i, "err", err)
+			return policies
+		}
+		policies = append(policies, types.RolePolicy{Contract: contract, Selector: selector[:]})
+	}
+	return policies
+}
+
+// lookupOrg reads the org from the contract, nil if it does not exist. Its sub
+// orgs are filled in by the org cache.
+func lookupOrg(session *pbind.OrgManagerCallerSession, orgId string) (*types.OrgInfo, error) {
+	if exists, err := session.CheckOrgExists(orgId); err != nil || !exists {
+		return nil, err
+	}
+	index, err := session.GetOrgIndex(orgId)
+	if err != nil {
+		return nil, err
+	}
+	id, parentId, ultParent, level, status, err := session.GetOrgInfo(index)
+	if err != nil {
+		return nil, err
+	}
+	return &types.OrgInfo{OrgId: id, FullOrgId: orgId, ParentOrgId: parentId, UltimateParent: ultParent,
+		Level: level, Status: types.OrgStatus(status.Int64())}, nil
+}
@@ -0,0 +1,188 @@
+package permission
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/quorum/accounts/abi/bind"
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/core/types"
+	pbind "github.com/ethereum/quorum/permission/bind"
+)
+
+// sane upper bounds of the sub org breadth and depth
+var (
+	maxSubOrgBreadth = big.NewInt(1024)
+	maxSubOrgDepth   = big.NewInt(32)
+)
+
+// ConfigErrors are the problems found in a permission config.
+type ConfigErrors []error
+
+func (e ConfigErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// configContracts returns the contract addresses of the config by their name
+// in permission-config.json.
+func configContracts(config *types.PermissionConfig) []struct {
+	name string
+	addr common.Address
+} {
+	return []struct {
+		name string
+		addr common.Address
+	}{
+		{"upgrdableAddress", config.UpgrdAddress},
+		{"interfaceAddress", config.InterfAddress},
+		{"implAddress", config.ImplAddress},
+		{"nodeMgrAddress", config.NodeAddress},
+		{"accountMgrAddress", config.AccountAddress},
+		{"roleMgrAddress", config.RoleAddress},
+		{"voterMgrAddress", config.VoterAddress},
+		{"orgMgrAddress", config.OrgAddress},
+	}
+}
+
+// ValidatePermissionConfig checks the contract addresses, the network admin
+// org and roles, the initial accounts and the sub org breadth and depth of
+// the config, and returns every problem found.
+func ValidatePermissionConfig(config *types.PermissionConfig) ConfigErrors {
+	var errs ConfigErrors
+
+	seen := make(map[common.Address]string)
+	for _, c := range configContracts(config) {
+		if c.addr == (common.Address{}) {
+			errs = append(errs, fmt.Errorf("%s is not set", c.name))
+			continue
+		}
+		if name, ok := seen[c.addr]; ok {
+			errs = append(errs, fmt.Errorf("%s and %s are both %s", name, c.name, c.addr.Hex()))
+		}
+		seen[c.addr] = c.name
+	}
+
+	for _, id := range []struct{ name, value string }{
+		{"nwAdminOrg", config.NwAdminOrg},
+		{"nwAdminRole", config.NwAdminRole},
+		{"orgAdminRole", config.OrgAdminRole},
+	} {
+		if id.value == "" {
+			errs = append(errs, fmt.Errorf("%s is not set", id.name))
+		} else if !isStringAlphaNumeric(id.value) {
+			errs = append(errs, fmt.Errorf("%s %q contains special characters", id.name, id.value))
+		}
+	}
+	if config.NwAdminRole != "" && config.NwAdminRole == config.OrgAdminRole {
+		errs = append(errs, fmt.Errorf("nwAdminRole and orgAdminRole are both %q", config.NwAdminRole))
+	}
+
+	if len(config.Accounts) == 0 {
+		errs = append(errs, fmt.Errorf("no accounts given, the network cannot boot up"))
+	}
+	accounts := make(map[common.Address]bool)
+	for _, acct := range config.Accounts {
+		switch {
+		case acct == (common.Address{}):
+			errs = append(errs, fmt.Errorf("account %s is the zero address", acct.Hex()))
+		case accounts[acct]:
+			errs = append(errs, fmt.Errorf("account %s is given twice", acct.Hex()))
+		}
+		accounts[acct] = true
+	}
+
+	for _, limit := range []struct {
+		name       string
+		value, max *big.Int
+	}{
+		{"subOrgBreadth", config.SubOrgBreadth, maxSubOrgBreadth},
+		{"subOrgDepth", config.SubOrgDepth, maxSubOrgDepth},
+	} {
+		switch {
+		case limit.value == nil || limit.value.Sign() == 0:
+			errs = append(errs, fmt.Errorf("%s is not set", limit.name))
+		case limit.value.Sign() < 0 || limit.value.Cmp(limit.max) > 0:
+			errs = append(errs, fmt.Errorf("%s %v is not between 1 and %v", limit.name, limit.value, limit.max))
+		}
+	}
+	return errs
+}
+
+// CheckPermissionContracts checks the contracts of the config against the
+// pending state of the chain: code is deployed at every address, the
+// upgradable contract points to the interface and, once the network booted,
+// the implementation has the network admin org and roles of the config.
+func CheckPermissionContracts(config *types.PermissionConfig, backend bind.ContractBackend) ConfigErrors {
+	var errs ConfigErrors
+
+	missing := make(map[common.Address]bool)
+	for _, c := range configContracts(config) {
+		code, err := backend.PendingCodeAt(context.Background(), c.addr)
+		if err != nil {
+			return append(errs, fmt.Errorf("failed to read the code of %s: %v", c.name, err))
+		}
+		if len(code) == 0 {
+			errs = append(errs, fmt.Errorf("no contract deployed at %s %s", c.name, c.addr.Hex()))
+			missing[c.addr] = true
+		}
+	}
+	if missing[config.UpgrdAddress] || missing[config.InterfAddress] {
+		return errs
+	}
+
+	opts := &bind.CallOpts{Pending: true}
+	upgr, err := pbind.NewPermUpgrCaller(config.UpgrdAddress, backend)
+	if err != nil {
+		return append(errs, err)
+	}
+	interf, err := upgr.GetPermInterface(opts)
+	if err != nil {
+		return append(errs, fmt.Errorf("failed to read the interface of the upgradable contract: %v", err))
+	}
+	if interf != config.InterfAddress {
+		errs = append(errs, fmt.Errorf("upgradable contract %s points to interface %s, not to interfaceAddress %s",
+			config.UpgrdAddress.Hex(), interf.Hex(), config.InterfAddress.Hex()))
+		return errs
+	}
+
+	permInterf, err := pbind.NewPermInterfaceCaller(config.InterfAddress, backend)
+	if err != nil {
+		return append(errs, err)
+	}
+	booted, err := permInterf.GetNetworkBootStatus(opts)
+	if err != nil {
+		return append(errs, fmt.Errorf("failed to read the network boot status: %v", err))
+	}
+	if !booted {
+		return errs
+	}
+	// the implementation may have been upgraded since the config was written
+	impl, err := upgr.GetPermImpl(opts)
+	if err != nil {
+		return append(errs, fmt.Errorf("failed to read the implementation of the upgradable contract: %v", err))
+	}
+	permImpl, err := pbind.NewPermImplCaller(impl, backend)
+	if err != nil {
+		return append(errs, err)
+	}
+	nwAdminOrg, nwAdminRole, orgAdminRole, _, err := permImpl.GetPolicyDetails(opts)
+	if err != nil {
+		return append(errs, fmt.Errorf("failed to read the network admin policy: %v", err))
+	}
+	for _, id := range []struct{ name, config, contract string }{
+		{"nwAdminOrg", config.NwAdminOrg, nwAdminOrg},
+		{"nwAdminRole", config.NwAdminRole, nwAdminRole},
+		{"orgAdminRole", config.OrgAdminRole, orgAdminRole},
+	} {
+		if id.config != id.contract {
+			errs = append(errs, fmt.Errorf("%s is %q but the network booted with %q", id.name, id.config, id.contract))
+		}
+	}
+	return errs
+}
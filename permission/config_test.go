@@ -0,0 +1,86 @@
+package permission
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func typicalPermissionConfig() *types.PermissionConfig {
+	return &types.PermissionConfig{
+		UpgrdAddress:   permUpgrAddress,
+		InterfAddress:  permInterfaceAddress,
+		ImplAddress:    permImplAddress,
+		NodeAddress:    nodeManagerAddress,
+		AccountAddress: accountManagerAddress,
+		RoleAddress:    roleManagerAddress,
+		VoterAddress:   voterManagerAddress,
+		OrgAddress:     orgManagerAddress,
+		NwAdminOrg:     arbitraryNetworkAdminOrg,
+		NwAdminRole:    arbitraryNetworkAdminRole,
+		OrgAdminRole:   arbitraryOrgAdminRole,
+		Accounts:       []common.Address{guardianAddress},
+		SubOrgDepth:    big.NewInt(3),
+		SubOrgBreadth:  big.NewInt(3),
+	}
+}
+
+func TestValidatePermissionConfig(t *testing.T) {
+	assert.Empty(t, ValidatePermissionConfig(typicalPermissionConfig()))
+
+	config := typicalPermissionConfig()
+	config.VoterAddress = common.Address{}
+	config.OrgAddress = config.RoleAddress
+	config.NwAdminOrg = "NETWORK.ADMIN"
+	config.OrgAdminRole = config.NwAdminRole
+	config.Accounts = append(config.Accounts, guardianAddress, common.Address{})
+	config.SubOrgBreadth = nil
+	config.SubOrgDepth = big.NewInt(-1)
+
+	var msgs []string
+	for _, err := range ValidatePermissionConfig(config) {
+		msgs = append(msgs, err.Error())
+	}
+	assert.Equal(t, []string{
+		"voterMgrAddress is not set",
+		"roleMgrAddress and orgMgrAddress are both " + roleManagerAddress.Hex(),
+		`nwAdminOrg "NETWORK.ADMIN" contains special characters`,
+		`nwAdminRole and orgAdminRole are both "NETWORK_ADMIN_ROLE"`,
+		"account " + guardianAddress.Hex() + " is given twice",
+		"account 0x0000000000000000000000000000000000000000 is the zero address",
+		"subOrgBreadth is not set",
+		"subOrgDepth -1 is not between 1 and 32",
+	}, msgs)
+
+	config = typicalPermissionConfig()
+	config.Accounts = nil
+	config.SubOrgBreadth = big.NewInt(2000)
+	assert.Len(t, ValidatePermissionConfig(config), 2)
+}
+
+func TestCheckPermissionContracts(t *testing.T) {
+	// boot the network
+	typicalQuorumControlsAPI(t)
+
+	config := typicalPermissionConfig()
+	assert.Empty(t, CheckPermissionContracts(config, backend))
+
+	config.NwAdminRole = "OTHER_ROLE"
+	config.VoterAddress = getArbitraryAccount()
+	errs := CheckPermissionContracts(config, backend)
+	if assert.Len(t, errs, 2) {
+		assert.Equal(t, "no contract deployed at voterMgrAddress "+config.VoterAddress.Hex(), errs[0].Error())
+		assert.Equal(t, `nwAdminRole is "OTHER_ROLE" but the network booted with "NETWORK_ADMIN_ROLE"`, errs[1].Error())
+	}
+
+	config = typicalPermissionConfig()
+	config.InterfAddress = nodeManagerAddress
+	config.NodeAddress = permInterfaceAddress
+	errs = CheckPermissionContracts(config, backend)
+	if assert.Len(t, errs, 1) {
+		assert.Contains(t, errs[0].Error(), "points to interface "+permInterfaceAddress.Hex())
+	}
+}
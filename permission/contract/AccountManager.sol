@@ -43,10 +43,20 @@ contract AccountManager {
 
     mapping(bytes32 => address) private orgAdminIndex;
 
+    // optional expiry of the role assigned to an account. the account falls
+    // back to the default access once either of them is reached, 0 means no
+    // expiry
+    struct AccountExpiry {
+        uint expiryBlock;
+        uint expiryTime;
+    }
+    mapping(address => AccountExpiry) private accountExpiry;
+
     // account permission events
     event AccountAccessModified(address _account, string _orgId, string _roleId, bool _orgAdmin, uint _status);
     event AccountAccessRevoked(address _account, string _orgId, string _roleId, bool _orgAdmin);
     event AccountStatusChanged(address _account, string _orgId, uint _status);
+    event AccountExpirySet(address _account, string _orgId, uint _expiryBlock, uint _expiryTime);
 
     /** @notice confirms that the caller is the address of implementation
         contract
@@ -106,6 +116,15 @@ contract AccountManager {
         accountAccessList[_aIndex].status, accountAccessList[_aIndex].orgAdmin);
     }
 
+    /** @notice returns the expiry of the role assigned to a given account
+      * @param _account account id
+      * @return block number from which the role lapses, 0 if none
+      * @return timestamp from which the role lapses, 0 if none
+      */
+    function getAccountExpiry(address _account) external view returns (uint, uint) {
+        return (accountExpiry[_account].expiryBlock, accountExpiry[_account].expiryTime);
+    }
+
     /** @notice returns the total number of accounts
       * @return total number accounts
       */
@@ -252,6 +271,23 @@ contract AccountManager {
         emit AccountStatusChanged(_account, _orgId, newStatus);
     }
 
+    /** @notice sets the expiry of the role assigned to the account. the
+        expiry is cleared whenever a new role is assigned to the account
+      * @param _orgId - org id
+      * @param _account - account id
+      * @param _expiryBlock - block number from which the role lapses, 0 for none
+      * @param _expiryTime - timestamp from which the role lapses, 0 for none
+      */
+    function setAccountExpiry(string calldata _orgId, address _account,
+        uint _expiryBlock, uint _expiryTime) external
+    onlyImplementation
+    accountExists(_orgId, _account) {
+        // org admin accounts cannot expire, the org would be left without one
+        require(checkOrgAdmin(_account, _orgId, "") != true, "expiry not allowed for org admin accounts");
+        accountExpiry[_account] = AccountExpiry(_expiryBlock, _expiryTime);
+        emit AccountExpirySet(_account, _orgId, _expiryBlock, _expiryTime);
+    }
+
     /** @notice checks if the passed account exists and if exists does it
         belong to the passed organization.
       * @param _account - account id
@@ -350,6 +386,10 @@ contract AccountManager {
             accountAccessList[aIndex].role = _roleId;
             accountAccessList[aIndex].status = _status;
             accountAccessList[aIndex].orgAdmin = _oAdmin;
+            if (accountExpiry[_account].expiryBlock != 0 || accountExpiry[_account].expiryTime != 0) {
+                delete accountExpiry[_account];
+                emit AccountExpirySet(_account, _orgId, 0, 0);
+            }
         }
         else {
             numAccounts ++;
@@ -336,6 +336,32 @@ contract PermissionsImplementation {
         roleManager.removeRole(_roleId, _orgId);
     }
 
+    /** @notice function to allow the accounts of a role to call a contract
+        method. can be executed by the org admin account only
+      * @param _roleId unique id for the role
+      * @param _orgId unique id of the organization to which the role belongs
+      * @param _contract contract address
+      * @param _selector 4 byte method selector, zero for any method
+      */
+    function addRolePolicy(string calldata _roleId, string calldata _orgId,
+        address _contract, bytes4 _selector, address _caller) external
+    onlyInterface orgApproved(_orgId) orgAdmin(_caller, _orgId) {
+        roleManager.addRolePolicy(_roleId, _orgId, _contract, _selector);
+    }
+
+    /** @notice function to remove a policy of a role. can be executed by the
+        org admin account only
+      * @param _roleId unique id for the role
+      * @param _orgId unique id of the organization to which the role belongs
+      * @param _contract contract address
+      * @param _selector 4 byte method selector
+      */
+    function removeRolePolicy(string calldata _roleId, string calldata _orgId,
+        address _contract, bytes4 _selector, address _caller) external
+    onlyInterface orgApproved(_orgId) orgAdmin(_caller, _orgId) {
+        roleManager.removeRolePolicy(_roleId, _orgId, _contract, _selector);
+    }
+
     // Account related functions
     /** @notice function to assign network admin/org admin role to an account
         this can be executed by network admin accounts only. it assigns
@@ -520,6 +546,21 @@ contract PermissionsImplementation {
         accountManager.assignAccountRole(_account, _orgId, _roleId, admin);
     }
 
+    /** @notice function to set the expiry of the role assigned to the account.
+        can be executed by org admin account only
+      * @param _account account id
+      * @param _orgId organization id to which the account belongs
+      * @param _expiryBlock block number from which the role lapses, 0 for none
+      * @param _expiryTime timestamp from which the role lapses, 0 for none
+      */
+    function setAccountExpiry(address _account, string memory _orgId,
+        uint256 _expiryBlock, uint256 _expiryTime, address _caller) public
+    onlyInterface
+    orgAdmin(_caller, _orgId)
+    orgApproved(_orgId) {
+        accountManager.setAccountExpiry(_orgId, _account, _expiryBlock, _expiryTime);
+    }
+
     /** @notice function to check if passed account is an network admin account
       * @param _account account id
       * @return true/false
@@ -148,6 +148,29 @@ contract PermissionsInterface {
         permImplementation.removeRole(_roleId, _orgId, msg.sender);
     }
 
+    /** @notice interface to allow the accounts of a role to call a contract
+        method
+      * @param _roleId unique id for the role
+      * @param _orgId unique id of the organization to which the role belongs
+      * @param _contract contract address
+      * @param _selector 4 byte method selector, zero for any method
+      */
+    function addRolePolicy(string calldata _roleId, string calldata _orgId,
+        address _contract, bytes4 _selector) external {
+        permImplementation.addRolePolicy(_roleId, _orgId, _contract, _selector, msg.sender);
+    }
+
+    /** @notice interface to remove a policy of a role
+      * @param _roleId unique id for the role
+      * @param _orgId unique id of the organization to which the role belongs
+      * @param _contract contract address
+      * @param _selector 4 byte method selector
+      */
+    function removeRolePolicy(string calldata _roleId, string calldata _orgId,
+        address _contract, bytes4 _selector) external {
+        permImplementation.removeRolePolicy(_roleId, _orgId, _contract, _selector, msg.sender);
+    }
+
     /** @notice interface to assign network admin/org admin role to an account
         this can be executed by network admin accounts only
       * @param _orgId unique id of the organization to which the account belongs
@@ -271,6 +294,17 @@ contract PermissionsInterface {
 
     }
 
+    /** @notice interface to set the expiry of the role assigned to the account
+      * @param _account account id
+      * @param _orgId organization id to which the account belongs
+      * @param _expiryBlock block number from which the role lapses, 0 for none
+      * @param _expiryTime timestamp from which the role lapses, 0 for none
+      */
+    function setAccountExpiry(address _account, string calldata _orgId,
+        uint256 _expiryBlock, uint256 _expiryTime) external {
+        permImplementation.setAccountExpiry(_account, _orgId, _expiryBlock, _expiryTime, msg.sender);
+    }
+
     /** @notice interface to check if passed account is an network admin account
       * @param _account account id
       * @return true/false
@@ -16,6 +16,8 @@ contract PermissionsUpgradable {
     // initDone ensures that init can be called only once
     bool private initDone;
 
+    event ImplementationChanged(address _oldImpl, address _newImpl);
+
     /** @notice constructor
       * @param _guardian account address
       */
@@ -56,8 +58,10 @@ contract PermissionsUpgradable {
         // implementation and then updated in new implementation
         (string memory adminOrg, string memory adminRole, string memory orgAdminRole, bool bootStatus) = PermissionsImplementation(permImpl).getPolicyDetails();
         _setPolicy(_proposedImpl, adminOrg, adminRole, orgAdminRole, bootStatus);
+        address oldImpl = permImpl;
         permImpl = _proposedImpl;
         _setImpl(permImpl);
+        emit ImplementationChanged(oldImpl, _proposedImpl);
     }
 
     /** @notice function to fetch the guardian account address
@@ -24,9 +24,20 @@ contract RoleManager {
     mapping(bytes32 => uint256) private roleIndex;
     uint256 private numberOfRoles;
 
+    // contract methods the accounts of a role are restricted to. a role
+    // without policies can call any contract. a zero selector allows any
+    // method of the contract
+    struct RolePolicy {
+        address contractAddress;
+        bytes4 selector;
+    }
+    mapping(bytes32 => RolePolicy[]) private rolePolicies;
+
     event RoleCreated(string _roleId, string _orgId, uint256 _baseAccess,
         bool _isVoter, bool _isAdmin);
     event RoleRevoked(string _roleId, string _orgId);
+    event RolePolicyAdded(string _roleId, string _orgId, address _contract, bytes4 _selector);
+    event RolePolicyRemoved(string _roleId, string _orgId, address _contract, bytes4 _selector);
 
     /** @notice confirms that the caller is the address of implementation
          contract
@@ -77,6 +88,62 @@ contract RoleManager {
         emit RoleRevoked(_roleId, _orgId);
     }
 
+    /** @notice function to allow the accounts of a role to call a contract
+        method. once a role has a policy its accounts can only call the
+        contracts and methods allowed by its policies
+      * @param _roleId - role id
+      * @param _orgId - org id to which the role belongs
+      * @param _contract - contract address
+      * @param _selector - 4 byte method selector, zero for any method
+      */
+    function addRolePolicy(string calldata _roleId, string calldata _orgId,
+        address _contract, bytes4 _selector) external onlyImplementation {
+        bytes32 key = keccak256(abi.encode(_roleId, _orgId));
+        require(roleIndex[key] != 0, "role does not exist");
+        require(_rolePolicyIndex(key, _contract, _selector) == rolePolicies[key].length, "policy exists for the role");
+        rolePolicies[key].push(RolePolicy(_contract, _selector));
+        emit RolePolicyAdded(_roleId, _orgId, _contract, _selector);
+    }
+
+    /** @notice function to remove a policy of a role
+      * @param _roleId - role id
+      * @param _orgId - org id to which the role belongs
+      * @param _contract - contract address
+      * @param _selector - 4 byte method selector
+      */
+    function removeRolePolicy(string calldata _roleId, string calldata _orgId,
+        address _contract, bytes4 _selector) external onlyImplementation {
+        bytes32 key = keccak256(abi.encode(_roleId, _orgId));
+        uint256 pIndex = _rolePolicyIndex(key, _contract, _selector);
+        require(pIndex < rolePolicies[key].length, "policy does not exist");
+        rolePolicies[key][pIndex] = rolePolicies[key][rolePolicies[key].length - 1];
+        rolePolicies[key].length --;
+        emit RolePolicyRemoved(_roleId, _orgId, _contract, _selector);
+    }
+
+    /** @notice returns the number of policies of a role
+      * @param _roleId - role id
+      * @param _orgId - org id to which the role belongs
+      * @return number of policies
+      */
+    function getNumberOfRolePolicies(string calldata _roleId, string calldata _orgId)
+    external view returns (uint256) {
+        return rolePolicies[keccak256(abi.encode(_roleId, _orgId))].length;
+    }
+
+    /** @notice returns a policy of a role
+      * @param _roleId - role id
+      * @param _orgId - org id to which the role belongs
+      * @param _pIndex - policy index
+      * @return contract address
+      * @return method selector
+      */
+    function getRolePolicy(string calldata _roleId, string calldata _orgId,
+        uint256 _pIndex) external view returns (address, bytes4) {
+        RolePolicy storage policy = rolePolicies[keccak256(abi.encode(_roleId, _orgId))][_pIndex];
+        return (policy.contractAddress, policy.selector);
+    }
+
     /** @notice checks if the role is a voter role or not
       * @param _roleId - unique identifier for the role being added
       * @param _orgId - org id to which the role belongs
@@ -185,6 +252,23 @@ contract RoleManager {
         return false;
     }
 
+    /** @notice returns the index of a role policy
+      * @param _key - role key
+      * @param _contract - contract address
+      * @param _selector - method selector
+      * @return policy index, the number of policies if not found
+      */
+    function _rolePolicyIndex(bytes32 _key, address _contract, bytes4 _selector)
+    internal view returns (uint256) {
+        for (uint256 i = 0; i < rolePolicies[_key].length; i++) {
+            if (rolePolicies[_key][i].contractAddress == _contract &&
+                rolePolicies[_key][i].selector == _selector) {
+                return i;
+            }
+        }
+        return rolePolicies[_key].length;
+    }
+
     /** @notice returns the role index based on role id and org id
       * @param _roleId - role id
       * @param _orgId - org id
@@ -0,0 +1,263 @@
+package permission
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/core/types"
+	"github.com/ethereum/quorum/ethdb"
+	pbind "github.com/ethereum/quorum/permission/bind"
+)
+
+// kinds of permission contract events recorded in the history
+const (
+	HistoryOrg            = "org"
+	HistoryNode           = "node"
+	HistoryRole           = "role"
+	HistoryAccount        = "account"
+	HistoryImplementation = "implementation"
+)
+
+const (
+	// default and maximum number of entries returned per page
+	defaultHistoryPageSize = 100
+	maxHistoryPageSize     = 1000
+)
+
+var (
+	historyReplayedKey = []byte("permission-history-replayed") // first block not replayed yet (uint64 big endian)
+	historyEntryPrefix = []byte("permission-history-e")        // historyEntryPrefix + block number + log index -> entry
+
+	errHistoryUnavailable = errors.New("permission history not available yet")
+	errInvalidPageSize    = errors.New("invalid page size")
+)
+
+// HistoryEntry is a permission contract event, with the transaction that
+// emitted it.
+type HistoryEntry struct {
+	Kind        string          `json:"kind"`
+	Event       string          `json:"event"`
+	OrgId       string          `json:"orgId"`
+	EnodeId     string          `json:"enodeId,omitempty"`
+	RoleId      string          `json:"roleId,omitempty"`
+	Account     *common.Address `json:"account,omitempty"`
+	Status      uint64          `json:"status,omitempty"`
+	Contract    *common.Address `json:"contract,omitempty"`
+	Caller      common.Address  `json:"caller"`
+	BlockNumber uint64          `json:"blockNumber"`
+	TxHash      common.Hash     `json:"txHash"`
+	LogIndex    uint            `json:"logIndex"`
+}
+
+// HistoryFilter selects history entries. Empty fields match every entry,
+// Offset and Limit select the page of matching entries.
+type HistoryFilter struct {
+	Kind      string          `json:"kind"`
+	OrgId     string          `json:"orgId"`
+	EnodeId   string          `json:"enodeId"`
+	RoleId    string          `json:"roleId"`
+	Account   *common.Address `json:"account"`
+	Caller    *common.Address `json:"caller"`
+	FromBlock *uint64         `json:"fromBlock"`
+	ToBlock   *uint64         `json:"toBlock"`
+	Offset    uint64          `json:"offset"`
+	Limit     uint64          `json:"limit"`
+}
+
+// HistoryPage is a page of history entries, ordered by block number and log
+// index. Next is the offset of the next page, or nil on the last page.
+type HistoryPage struct {
+	Entries []HistoryEntry `json:"entries"`
+	Next    *uint64        `json:"next"`
+}
+
+func (f *HistoryFilter) matches(e *HistoryEntry) bool {
+	switch {
+	case f.Kind != "" && f.Kind != e.Kind:
+		return false
+	case f.OrgId != "" && f.OrgId != e.OrgId:
+		return false
+	case f.EnodeId != "" && f.EnodeId != e.EnodeId:
+		return false
+	case f.RoleId != "" && f.RoleId != e.RoleId:
+		return false
+	case f.Account != nil && (e.Account == nil || *f.Account != *e.Account):
+		return false
+	case f.Caller != nil && *f.Caller != e.Caller:
+		return false
+	case f.FromBlock != nil && e.BlockNumber < *f.FromBlock:
+		return false
+	case f.ToBlock != nil && e.BlockNumber > *f.ToBlock:
+		return false
+	}
+	return true
+}
+
+// history is the audit log of permission contract events. The events of new
+// blocks arrive from the contract watches, the ones emitted while the node was
+// not running are replayed from the logs, so entries are keyed by their log
+// to be recorded only once.
+type history struct {
+	db ethdb.Database
+	mu sync.RWMutex
+}
+
+func newHistory(db ethdb.Database) *history {
+	return &history{db: db}
+}
+
+func historyEntryKey(blockNumber uint64, logIndex uint) []byte {
+	return append(append(append([]byte{}, historyEntryPrefix...), encodeUint64(blockNumber)...), encodeUint64(uint64(logIndex))...)
+}
+
+// historyBlockKey is the first key of the entries of the block, the end of
+// the entries if the block number overflows.
+func historyBlockKey(blockNumber uint64, overflow bool) []byte {
+	if overflow {
+		limit := append([]byte{}, historyEntryPrefix...)
+		limit[len(limit)-1]++
+		return limit
+	}
+	return append(append([]byte{}, historyEntryPrefix...), encodeUint64(blockNumber)...)
+}
+
+func encodeUint64(n uint64) []byte {
+	enc := make([]byte, 8)
+	binary.BigEndian.PutUint64(enc, n)
+	return enc
+}
+
+// replayed returns the first block whose events were not replayed yet.
+func (h *history) replayed() uint64 {
+	if blob, err := h.db.Get(historyReplayedKey); err == nil && len(blob) == 8 {
+		return binary.BigEndian.Uint64(blob)
+	}
+	return 0
+}
+
+// setReplayed marks the events up to the block as recorded.
+func (h *history) setReplayed(blockNumber uint64) error {
+	return h.db.Put(historyReplayedKey, encodeUint64(blockNumber+1))
+}
+
+// add records the entry, unless its log was already recorded.
+func (h *history) add(entry *HistoryEntry) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	key := historyEntryKey(entry.BlockNumber, entry.LogIndex)
+	if ok, _ := h.db.Has(key); ok {
+		return nil
+	}
+	blob, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	return h.db.Put(key, blob)
+}
+
+// query returns the page of entries matching the filter. Only the entries in
+// the block range of the filter are read, up to the end of the page.
+func (h *history) query(filter HistoryFilter) (HistoryPage, error) {
+	limit := filter.Limit
+	if limit == 0 {
+		limit = defaultHistoryPageSize
+	}
+	if limit > maxHistoryPageSize {
+		return HistoryPage{}, errInvalidPageSize
+	}
+	start, end := historyBlockKey(0, false), historyBlockKey(0, true)
+	if filter.FromBlock != nil {
+		start = historyBlockKey(*filter.FromBlock, false)
+	}
+	if filter.ToBlock != nil {
+		end = historyBlockKey(*filter.ToBlock+1, *filter.ToBlock == math.MaxUint64)
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	it := h.db.NewIteratorWithRange(start, end)
+	defer it.Release()
+
+	page := HistoryPage{Entries: []HistoryEntry{}}
+	for skipped := uint64(0); it.Next(); {
+		var entry HistoryEntry
+		if err := json.Unmarshal(it.Value(), &entry); err != nil {
+			return HistoryPage{}, err
+		}
+		if !filter.matches(&entry) {
+			continue
+		}
+		if skipped < filter.Offset {
+			skipped++
+			continue
+		}
+		if uint64(len(page.Entries)) == limit {
+			next := filter.Offset + limit
+			page.Next = &next
+			break
+		}
+		page.Entries = append(page.Entries, entry)
+	}
+	return page, it.Error()
+}
+
+// historyEntryOf returns the history entry of a permission contract event and
+// the log it was emitted in.
+func historyEntryOf(evt interface{}) (HistoryEntry, types.Log) {
+	switch e := evt.(type) {
+	case *pbind.OrgManagerOrgPendingApproval:
+		return HistoryEntry{Kind: HistoryOrg, Event: "OrgPendingApproval", OrgId: e.OrgId, Status: e.Status.Uint64()}, e.Raw
+	case *pbind.OrgManagerOrgApproved:
+		return HistoryEntry{Kind: HistoryOrg, Event: "OrgApproved", OrgId: e.OrgId, Status: uint64(types.OrgApproved)}, e.Raw
+	case *pbind.OrgManagerOrgSuspended:
+		return HistoryEntry{Kind: HistoryOrg, Event: "OrgSuspended", OrgId: e.OrgId, Status: uint64(types.OrgSuspended)}, e.Raw
+	case *pbind.OrgManagerOrgSuspensionRevoked:
+		return HistoryEntry{Kind: HistoryOrg, Event: "OrgSuspensionRevoked", OrgId: e.OrgId, Status: uint64(types.OrgApproved)}, e.Raw
+
+	case *pbind.NodeManagerNodeApproved:
+		return HistoryEntry{Kind: HistoryNode, Event: "NodeApproved", OrgId: e.OrgId, EnodeId: e.EnodeId, Status: uint64(types.NodeApproved)}, e.Raw
+	case *pbind.NodeManagerNodeProposed:
+		return HistoryEntry{Kind: HistoryNode, Event: "NodeProposed", OrgId: e.OrgId, EnodeId: e.EnodeId, Status: uint64(types.NodePendingApproval)}, e.Raw
+	case *pbind.NodeManagerNodeDeactivated:
+		return HistoryEntry{Kind: HistoryNode, Event: "NodeDeactivated", OrgId: e.OrgId, EnodeId: e.EnodeId, Status: uint64(types.NodeDeactivated)}, e.Raw
+	case *pbind.NodeManagerNodeActivated:
+		return HistoryEntry{Kind: HistoryNode, Event: "NodeActivated", OrgId: e.OrgId, EnodeId: e.EnodeId, Status: uint64(types.NodeApproved)}, e.Raw
+	case *pbind.NodeManagerNodeBlacklisted:
+		return HistoryEntry{Kind: HistoryNode, Event: "NodeBlacklisted", OrgId: e.OrgId, EnodeId: e.EnodeId, Status: uint64(types.NodeBlackListed)}, e.Raw
+	case *pbind.NodeManagerNodeRecoveryInitiated:
+		return HistoryEntry{Kind: HistoryNode, Event: "NodeRecoveryInitiated", OrgId: e.OrgId, EnodeId: e.EnodeId, Status: uint64(types.NodeRecoveryInitiated)}, e.Raw
+	case *pbind.NodeManagerNodeRecoveryCompleted:
+		return HistoryEntry{Kind: HistoryNode, Event: "NodeRecoveryCompleted", OrgId: e.OrgId, EnodeId: e.EnodeId, Status: uint64(types.NodeApproved)}, e.Raw
+
+	case *pbind.RoleManagerRoleCreated:
+		return HistoryEntry{Kind: HistoryRole, Event: "RoleCreated", OrgId: e.OrgId, RoleId: e.RoleId}, e.Raw
+	case *pbind.RoleManagerRoleRevoked:
+		return HistoryEntry{Kind: HistoryRole, Event: "RoleRevoked", OrgId: e.OrgId, RoleId: e.RoleId}, e.Raw
+	case *pbind.RoleManagerRolePolicyAdded:
+		return HistoryEntry{Kind: HistoryRole, Event: "RolePolicyAdded", OrgId: e.OrgId, RoleId: e.RoleId}, e.Raw
+	case *pbind.RoleManagerRolePolicyRemoved:
+		return HistoryEntry{Kind: HistoryRole, Event: "RolePolicyRemoved", OrgId: e.OrgId, RoleId: e.RoleId}, e.Raw
+
+	case *pbind.AcctManagerAccountAccessModified:
+		return HistoryEntry{Kind: HistoryAccount, Event: "AccountAccessModified", OrgId: e.OrgId, RoleId: e.RoleId, Account: &e.Account, Status: e.Status.Uint64()}, e.Raw
+	case *pbind.AcctManagerAccountAccessRevoked:
+		return HistoryEntry{Kind: HistoryAccount, Event: "AccountAccessRevoked", OrgId: e.OrgId, RoleId: e.RoleId, Account: &e.Account, Status: uint64(types.AcctActive)}, e.Raw
+	case *pbind.AcctManagerAccountStatusChanged:
+		// the event does not name the role, replayed events can't take it
+		// from the cache
+		return HistoryEntry{Kind: HistoryAccount, Event: "AccountStatusChanged", OrgId: e.OrgId, Account: &e.Account, Status: e.Status.Uint64()}, e.Raw
+	case *pbind.AcctManagerAccountExpirySet:
+		return HistoryEntry{Kind: HistoryAccount, Event: "AccountExpirySet", OrgId: e.OrgId, Account: &e.Account}, e.Raw
+
+	case *pbind.PermUpgrImplementationChanged:
+		return HistoryEntry{Kind: HistoryImplementation, Event: "ImplementationChanged", Contract: &e.NewImpl}, e.Raw
+	}
+	panic(fmt.Sprintf("unknown permission event %T", evt))
+}
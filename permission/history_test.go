@@ -0,0 +1,73 @@
+package permission
+
+import (
+	"testing"
+
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/ethdb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistory(t *testing.T) {
+	db := ethdb.NewMemDatabase()
+	h := newHistory(db)
+	acct := common.HexToAddress("0x1")
+	caller := common.HexToAddress("0x2")
+
+	entries := []HistoryEntry{
+		{Kind: HistoryNode, Event: "NodeBlacklisted", OrgId: "ORG1", EnodeId: arbitraryNode1, BlockNumber: 5, LogIndex: 0},
+		{Kind: HistoryOrg, Event: "OrgApproved", OrgId: "ORG1", Caller: caller, BlockNumber: 2, LogIndex: 1},
+		{Kind: HistoryOrg, Event: "OrgPendingApproval", OrgId: "ORG1", BlockNumber: 2, LogIndex: 0},
+		{Kind: HistoryAccount, Event: "AccountAccessModified", OrgId: "ORG2", Account: &acct, BlockNumber: 3, LogIndex: 0},
+	}
+	for i := range entries {
+		assert.NoError(t, h.add(&entries[i]))
+	}
+	// events are delivered by the watches and the replay
+	assert.NoError(t, h.add(&entries[0]))
+
+	h = newHistory(db)
+	page, err := h.query(HistoryFilter{})
+	assert.NoError(t, err)
+	assert.Nil(t, page.Next)
+	assert.Equal(t, []HistoryEntry{entries[2], entries[1], entries[3], entries[0]}, page.Entries)
+
+	page, err = h.query(HistoryFilter{OrgId: "ORG1", Limit: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []HistoryEntry{entries[2], entries[1]}, page.Entries)
+	if assert.NotNil(t, page.Next) {
+		page, err = h.query(HistoryFilter{OrgId: "ORG1", Limit: 2, Offset: *page.Next})
+		assert.NoError(t, err)
+		assert.Nil(t, page.Next)
+		assert.Equal(t, []HistoryEntry{entries[0]}, page.Entries)
+	}
+
+	page, err = h.query(HistoryFilter{Account: &acct})
+	assert.NoError(t, err)
+	assert.Equal(t, []HistoryEntry{entries[3]}, page.Entries)
+
+	page, err = h.query(HistoryFilter{Caller: &caller})
+	assert.NoError(t, err)
+	assert.Equal(t, []HistoryEntry{entries[1]}, page.Entries)
+
+	from, to := uint64(3), uint64(4)
+	page, err = h.query(HistoryFilter{FromBlock: &from, ToBlock: &to})
+	assert.NoError(t, err)
+	assert.Equal(t, []HistoryEntry{entries[3]}, page.Entries)
+
+	page, err = h.query(HistoryFilter{FromBlock: &from})
+	assert.NoError(t, err)
+	assert.Equal(t, []HistoryEntry{entries[3], entries[0]}, page.Entries)
+
+	_, err = h.query(HistoryFilter{Limit: maxHistoryPageSize + 1})
+	assert.Equal(t, errInvalidPageSize, err)
+}
+
+func TestHistoryReplayed(t *testing.T) {
+	db := ethdb.NewMemDatabase()
+	h := newHistory(db)
+	assert.Equal(t, uint64(0), h.replayed())
+
+	assert.NoError(t, h.setReplayed(10))
+	assert.Equal(t, uint64(11), newHistory(db).replayed())
+}
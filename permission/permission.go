@@ -3,9 +3,10 @@ package permission
 import (
 	"crypto/ecdsa"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/ethereum/quorum/core"
-	"io/ioutil"
+	"github.com/ethereum/quorum/core/rawdb"
 	"math/big"
 	"os"
 	"path/filepath"
@@ -21,6 +22,7 @@ import (
 	"github.com/ethereum/quorum/rpc"
 
 	"github.com/ethereum/quorum/accounts/abi/bind"
+	"github.com/ethereum/quorum/common"
 	"github.com/ethereum/quorum/core/types"
 	"github.com/ethereum/quorum/eth"
 	"github.com/ethereum/quorum/log"
@@ -31,26 +33,23 @@ import (
 	pbind "github.com/ethereum/quorum/permission/bind"
 )
 
-type NodeOperation uint8
-
-const (
-	NodeAdd NodeOperation = iota
-	NodeDelete
-)
-
 type PermissionCtrl struct {
 	node       *node.Node
 	ethClnt    bind.ContractBackend
 	eth        *eth.Ethereum
 	key        *ecdsa.PrivateKey
-	dataDir    string
+	nodes      *p2p.NodeAllowlist // nodes permissioned to connect, following the node cache
 	permUpgr   *pbind.PermUpgr
 	permInterf *pbind.PermInterface
+	permImpl   *pbind.PermImpl // implementation the upgradable contract points to, guarded by mux
 	permNode   *pbind.NodeManager
 	permAcct   *pbind.AcctManager
 	permRole   *pbind.RoleManager
 	permOrg    *pbind.OrgManager
 	permConfig *types.PermissionConfig
+	history    *history // audit log of the permission contract events
+
+	implAddress common.Address // address of permImpl, guarded by mux
 
 	startWaitGroup *sync.WaitGroup // waitgroup to make sure all dependenies are ready before we start the service
 	stopFeed       event.Feed      // broadcasting stopEvent when service is being stopped
@@ -64,7 +63,9 @@ type stopEvent struct {
 }
 
 // function reads the permissions config file passed and populates the
-// config structure accordingly
+// config structure accordingly. Unknown fields and invalid values are
+// rejected, the problems found by ValidatePermissionConfig are returned as
+// ConfigErrors.
 func ParsePermissionConfig(dir string) (types.PermissionConfig, error) {
 	fullPath := filepath.Join(dir, params.PERMISSION_MODEL_CONFIG)
 	f, err := os.Open(fullPath)
@@ -77,24 +78,14 @@ func ParsePermissionConfig(dir string) (types.PermissionConfig, error) {
 	}()
 
 	var permConfig types.PermissionConfig
-	blob, err := ioutil.ReadFile(fullPath)
-	if err != nil {
-		log.Error("error reading file", "err", err, "file", fullPath)
-	}
-
-	err = json.Unmarshal(blob, &permConfig)
-	if err != nil {
+	decoder := json.NewDecoder(f)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&permConfig); err != nil {
 		log.Error("error unmarshalling the file", "err", err, "file", fullPath)
+		return types.PermissionConfig{}, fmt.Errorf("invalid %s: %v", params.PERMISSION_MODEL_CONFIG, err)
 	}
-
-	if len(permConfig.Accounts) == 0 {
-		return types.PermissionConfig{}, fmt.Errorf("no accounts given in %s. Network cannot boot up", params.PERMISSION_MODEL_CONFIG)
-	}
-	if permConfig.SubOrgDepth.Cmp(big.NewInt(0)) == 0 || permConfig.SubOrgBreadth.Cmp(big.NewInt(0)) == 0 {
-		return types.PermissionConfig{}, fmt.Errorf("sub org breadth depth not passed in %s. Network cannot boot up", params.PERMISSION_MODEL_CONFIG)
-	}
-	if permConfig.IsEmpty() {
-		return types.PermissionConfig{}, fmt.Errorf("missing contract addresses in %s", params.PERMISSION_MODEL_CONFIG)
+	if errs := ValidatePermissionConfig(&permConfig); len(errs) > 0 {
+		return types.PermissionConfig{}, errs
 	}
 
 	return permConfig, nil
@@ -112,7 +103,7 @@ func NewQuorumPermissionCtrl(stack *node.Node, pconfig *types.PermissionConfig)
 	p := &PermissionCtrl{
 		node:           stack,
 		key:            stack.GetNodeKey(),
-		dataDir:        stack.DataDir(),
+		nodes:          p2p.NewNodeAllowlist(),
 		permConfig:     pconfig,
 		startWaitGroup: wg,
 		errorChan:      make(chan error),
@@ -154,12 +145,19 @@ func (p *PermissionCtrl) AfterStart() error {
 	if err != nil {
 		return err
 	}
+	// refuse to start with contracts which do not match the config
+	if errs := CheckPermissionContracts(p.permConfig, p.ethClnt); len(errs) > 0 {
+		return fmt.Errorf("invalid %s: %v", params.PERMISSION_MODEL_CONFIG, errs)
+	}
 	if err := p.bindContract(&p.permUpgr, func() (interface{}, error) { return pbind.NewPermUpgr(p.permConfig.UpgrdAddress, p.ethClnt) }); err != nil {
 		return err
 	}
 	if err := p.bindContract(&p.permInterf, func() (interface{}, error) { return pbind.NewPermInterface(p.permConfig.InterfAddress, p.ethClnt) }); err != nil {
 		return err
 	}
+	if err := p.bindImplementation(); err != nil {
+		return err
+	}
 	if err := p.bindContract(&p.permAcct, func() (interface{}, error) { return pbind.NewAcctManager(p.permConfig.AccountAddress, p.ethClnt) }); err != nil {
 		return err
 	}
@@ -173,26 +171,49 @@ func (p *PermissionCtrl) AfterStart() error {
 		return err
 	}
 
+	// look up entries evicted from the permission caches in the contracts
+	p.setCacheLookups()
+
+	// open the audit log before watching the contract events
+	db, err := p.node.OpenDatabase("permissionhistory", 16, 16)
+	if err != nil {
+		return fmt.Errorf("failed to open permission history: %v", err)
+	}
+	p.history = newHistory(db)
+
 	// populate the initial list of permissioned nodes and account accesses
 	if err := p.populateInitPermissions(); err != nil {
 		return fmt.Errorf("populateInitPermissions failed: %v", err)
 	}
 
+	// serve the node permissions from the cache rather than the permission files
+	if server := p.node.Server(); server != nil {
+		server.SetNodePermissioner(p.nodes)
+	}
+
 	// set the default access to ReadOnly
 	types.SetDefaults(p.permConfig.NwAdminRole, p.permConfig.OrgAdminRole)
 
 	for _, f := range []func() error{
-		p.monitorQIP714Block,       // monitor block number to activate new permissions controls
-		p.manageOrgPermissions,     // monitor org management related events
-		p.manageNodePermissions,    // monitor org  level node management events
-		p.manageRolePermissions,    // monitor org level role management events
-		p.manageAccountPermissions, // monitor org level account management events
+		p.monitorQIP714Block,           // monitor block number to activate new permissions controls
+		p.manageOrgPermissions,         // monitor org management related events
+		p.manageNodePermissions,        // monitor org  level node management events
+		p.manageRolePermissions,        // monitor org level role management events
+		p.manageAccountPermissions,     // monitor org level account management events
+		p.manageImplementationUpgrades, // monitor permissions implementation changes
 	} {
 		if err := f(); err != nil {
 			return err
 		}
 	}
 
+	// record the events the watches missed while the node was not running
+	go func() {
+		if err := p.replayHistory(); err != nil {
+			log.Error("Failed to replay the permission history", "err", err)
+		}
+	}()
+
 	log.Info("permission service: is now ready")
 
 	return nil
@@ -209,6 +230,10 @@ func (p *PermissionCtrl) asyncStart() {
 	defer func() {
 		p.errorChan <- nil
 	}()
+	// blocks are checked against the permission contracts from the start,
+	// including the ones imported while syncing
+	p.setPermissionsAt(ethereum.BlockChain())
+
 	// for cases where the node is joining an existing network, permission service
 	// can be brought up only after block syncing is complete. This function
 	// waits for block syncing before the starting permissions
@@ -262,10 +287,25 @@ func (p *PermissionCtrl) APIs() []rpc.API {
 			Version:   "1.0",
 			Service:   NewQuorumControlsAPI(p),
 			Public:    true,
+			Authorize: p.authorizeRPC,
 		},
 	}
 }
 
+// authorizeRPC only lets the identities bound to an active org admin or
+// network admin account call the permission APIs over authenticated endpoints.
+func (p *PermissionCtrl) authorizeRPC(id *rpc.Identity, method string) error {
+	if id.Account == nil {
+		return errors.New("permission APIs need an identity bound to an org admin account")
+	}
+	a := types.AcctInfoMap.GetAccount(*id.Account)
+	if a == nil || a.Status != types.AcctActive ||
+		!(a.IsOrgAdmin || a.RoleId == p.permConfig.NwAdminRole || a.RoleId == p.permConfig.OrgAdminRole) {
+		return fmt.Errorf("account %x is not an active org admin", *id.Account)
+	}
+	return nil
+}
+
 func (p *PermissionCtrl) Protocols() []p2p.Protocol {
 	return []p2p.Protocol{}
 }
@@ -273,6 +313,10 @@ func (p *PermissionCtrl) Protocols() []p2p.Protocol {
 func (p *PermissionCtrl) Stop() error {
 	log.Info("permission service: stopping")
 	p.stopFeed.Send(stopEvent{})
+	clearCacheLookups()
+	if p.history != nil {
+		p.history.db.Close()
+	}
 	log.Info("permission service: stopped")
 	return nil
 }
@@ -342,15 +386,19 @@ func (p *PermissionCtrl) manageOrgPermissions() error {
 			select {
 			case evtPendingApproval := <-chPendingApproval:
 				types.OrgInfoMap.UpsertOrg(evtPendingApproval.OrgId, evtPendingApproval.PorgId, evtPendingApproval.UltParent, evtPendingApproval.Level, types.OrgStatus(evtPendingApproval.Status.Uint64()))
+				p.recordEvent(evtPendingApproval)
 
 			case evtOrgApproved := <-chOrgApproved:
 				types.OrgInfoMap.UpsertOrg(evtOrgApproved.OrgId, evtOrgApproved.PorgId, evtOrgApproved.UltParent, evtOrgApproved.Level, types.OrgApproved)
+				p.recordEvent(evtOrgApproved)
 
 			case evtOrgSuspended := <-chOrgSuspended:
 				types.OrgInfoMap.UpsertOrg(evtOrgSuspended.OrgId, evtOrgSuspended.PorgId, evtOrgSuspended.UltParent, evtOrgSuspended.Level, types.OrgSuspended)
+				p.recordEvent(evtOrgSuspended)
 
 			case evtOrgReactivated := <-chOrgReactivated:
 				types.OrgInfoMap.UpsertOrg(evtOrgReactivated.OrgId, evtOrgReactivated.PorgId, evtOrgReactivated.UltParent, evtOrgReactivated.Level, types.OrgApproved)
+				p.recordEvent(evtOrgReactivated)
 			case <-stopChan:
 				log.Info("quit org contract watch")
 				return
@@ -413,32 +461,34 @@ func (p *PermissionCtrl) manageNodePermissions() error {
 		for {
 			select {
 			case evtNodeApproved := <-chNodeApproved:
-				p.updatePermissionedNodes(evtNodeApproved.EnodeId, NodeAdd)
-				types.NodeInfoMap.UpsertNode(evtNodeApproved.OrgId, evtNodeApproved.EnodeId, types.NodeApproved)
+				p.updateNode(evtNodeApproved.OrgId, evtNodeApproved.EnodeId, types.NodeApproved)
+				p.recordEvent(evtNodeApproved)
 
 			case evtNodeProposed := <-chNodeProposed:
-				types.NodeInfoMap.UpsertNode(evtNodeProposed.OrgId, evtNodeProposed.EnodeId, types.NodePendingApproval)
+				p.updateNode(evtNodeProposed.OrgId, evtNodeProposed.EnodeId, types.NodePendingApproval)
+				p.recordEvent(evtNodeProposed)
 
 			case evtNodeDeactivated := <-chNodeDeactivated:
-				p.updatePermissionedNodes(evtNodeDeactivated.EnodeId, NodeDelete)
-				types.NodeInfoMap.UpsertNode(evtNodeDeactivated.OrgId, evtNodeDeactivated.EnodeId, types.NodeDeactivated)
+				p.updateNode(evtNodeDeactivated.OrgId, evtNodeDeactivated.EnodeId, types.NodeDeactivated)
+				p.recordEvent(evtNodeDeactivated)
+				p.disconnectNode(evtNodeDeactivated.EnodeId)
 
 			case evtNodeActivated := <-chNodeActivated:
-				p.updatePermissionedNodes(evtNodeActivated.EnodeId, NodeAdd)
-				types.NodeInfoMap.UpsertNode(evtNodeActivated.OrgId, evtNodeActivated.EnodeId, types.NodeApproved)
+				p.updateNode(evtNodeActivated.OrgId, evtNodeActivated.EnodeId, types.NodeApproved)
+				p.recordEvent(evtNodeActivated)
 
 			case evtNodeBlacklisted := <-chNodeBlacklisted:
-				types.NodeInfoMap.UpsertNode(evtNodeBlacklisted.OrgId, evtNodeBlacklisted.EnodeId, types.NodeBlackListed)
-				p.updateDisallowedNodes(evtNodeBlacklisted.EnodeId, NodeAdd)
-				p.updatePermissionedNodes(evtNodeBlacklisted.EnodeId, NodeDelete)
+				p.updateNode(evtNodeBlacklisted.OrgId, evtNodeBlacklisted.EnodeId, types.NodeBlackListed)
+				p.recordEvent(evtNodeBlacklisted)
+				p.disconnectNode(evtNodeBlacklisted.EnodeId)
 
 			case evtNodeRecoveryInit := <-chNodeRecoveryInit:
-				types.NodeInfoMap.UpsertNode(evtNodeRecoveryInit.OrgId, evtNodeRecoveryInit.EnodeId, types.NodeRecoveryInitiated)
+				p.updateNode(evtNodeRecoveryInit.OrgId, evtNodeRecoveryInit.EnodeId, types.NodeRecoveryInitiated)
+				p.recordEvent(evtNodeRecoveryInit)
 
 			case evtNodeRecoveryDone := <-chNodeRecoveryDone:
-				types.NodeInfoMap.UpsertNode(evtNodeRecoveryDone.OrgId, evtNodeRecoveryDone.EnodeId, types.NodeApproved)
-				p.updateDisallowedNodes(evtNodeRecoveryDone.EnodeId, NodeDelete)
-				p.updatePermissionedNodes(evtNodeRecoveryDone.EnodeId, NodeAdd)
+				p.updateNode(evtNodeRecoveryDone.OrgId, evtNodeRecoveryDone.EnodeId, types.NodeApproved)
+				p.recordEvent(evtNodeRecoveryDone)
 
 			case <-stopChan:
 				log.Info("quit node contract watch")
@@ -449,91 +499,127 @@ func (p *PermissionCtrl) manageNodePermissions() error {
 	return nil
 }
 
-// adds or deletes and entry from a given file
-func (p *PermissionCtrl) updateFile(fileName, enodeId string, operation NodeOperation, createFile bool) {
-	// Load the nodes from the config file
-	var nodeList []string
-	index := 0
-	// if createFile is false means the file is already existing. read the file
-	if !createFile {
-		blob, err := ioutil.ReadFile(fileName)
-		if err != nil && !createFile {
-			log.Error("Failed to access the file", "fileName", fileName, "err", err)
-			return
-		}
+// updates the node cache and the node permissions of the p2p server based on
+// node management activities in smart contract
+func (p *PermissionCtrl) updateNode(orgId, enodeId string, status types.NodeStatus) {
+	types.NodeInfoMap.UpsertNode(orgId, enodeId, status)
 
-		if err := json.Unmarshal(blob, &nodeList); err != nil {
-			log.Error("Failed to load nodes list from file", "fileName", fileName, "err", err)
-			return
-		}
-
-		// logic to update the permissioned-nodes.json file based on action
-
-		recExists := false
-		for i, eid := range nodeList {
-			if eid == enodeId {
-				index = i
-				recExists = true
-				break
-			}
-		}
-		if (operation == NodeAdd && recExists) || (operation == NodeDelete && !recExists) {
-			return
-		}
-	}
-	if operation == NodeAdd {
-		nodeList = append(nodeList, enodeId)
-	} else {
-		nodeList = append(nodeList[:index], nodeList[index+1:]...)
+	node, err := enode.ParseV4(enodeId)
+	if err != nil {
+		log.Error("failed parse node id", "err", err, "enodeId", enodeId)
+		return
 	}
-	blob, _ := json.Marshal(nodeList)
-
-	p.mux.Lock()
-	defer p.mux.Unlock()
-
-	if err := ioutil.WriteFile(fileName, blob, 0644); err != nil {
-		log.Error("Error writing new node info to file", "fileName", fileName, "err", err)
+	switch status {
+	case types.NodeApproved:
+		p.nodes.Readmit(node.ID())
+		p.nodes.Allow(node.ID())
+	case types.NodeDeactivated:
+		p.nodes.Revoke(node.ID())
+	case types.NodeBlackListed:
+		p.nodes.Disallow(node.ID())
+		p.nodes.Revoke(node.ID())
 	}
 }
 
-// updates node information in the permissioned-nodes.json file based on node
-// management activities in smart contract
-func (p *PermissionCtrl) updatePermissionedNodes(enodeId string, operation NodeOperation) {
-	log.Debug("updatePermissionedNodes", "DataDir", p.dataDir, "file", params.PERMISSIONED_CONFIG)
-
-	path := filepath.Join(p.dataDir, params.PERMISSIONED_CONFIG)
-	if _, err := os.Stat(path); err != nil {
-		log.Error("Read Error for permissioned-nodes.json file. This is because 'permissioned' flag is specified but no permissioned-nodes.json file is present", "err", err)
+// records a permission contract event, with the block, transaction and caller
+// it originates from, in the history
+func (p *PermissionCtrl) recordEvent(evt interface{}) {
+	entry, raw := historyEntryOf(evt)
+	if p.history == nil || raw.Removed {
 		return
 	}
-
-	p.updateFile(path, enodeId, operation, false)
-	if operation == NodeDelete {
-		p.disconnectNode(enodeId)
+	entry.BlockNumber, entry.TxHash, entry.LogIndex = raw.BlockNumber, raw.TxHash, raw.Index
+	if tx, _, number, _ := rawdb.ReadTransaction(p.eth.ChainDb(), raw.TxHash); tx != nil {
+		signer := types.MakeSigner(p.eth.ChainConfig(), new(big.Int).SetUint64(number))
+		if from, err := types.Sender(signer, tx); err == nil {
+			entry.Caller = from
+		}
+	}
+	if err := p.history.add(&entry); err != nil {
+		log.Error("failed to record permission event", "event", entry.Event, "tx", entry.TxHash, "err", err)
 	}
 }
 
-//this function populates the black listed node information into the disallowed-nodes.json file
-func (p *PermissionCtrl) updateDisallowedNodes(url string, operation NodeOperation) {
-	log.Debug("updateDisallowedNodes", "DataDir", p.dataDir, "file", params.BLACKLIST_CONFIG)
+// eventIterator is implemented by the log iterators of the contract bindings.
+type eventIterator interface {
+	Next() bool
+	Error() error
+	Close() error
+}
 
-	fileExists := true
-	path := filepath.Join(p.dataDir, params.BLACKLIST_CONFIG)
-	// Check if the file is existing. If the file is not existing create the file
-	if _, err := os.Stat(path); err != nil {
-		log.Error("Read Error for disallowed-nodes.json file", "err", err)
-		if _, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0644); err != nil {
-			log.Error("Failed to create disallowed-nodes.json file", "err", err)
-			return
-		}
-		fileExists = false
+// replayHistory records the permission contract events emitted while the node
+// was not running. The contract watches only deliver the events of new blocks,
+// so the logs from the first block not replayed yet up to the head are
+// filtered once the watches are running. Events delivered by both are
+// recorded once.
+func (p *PermissionCtrl) replayHistory() error {
+	head := p.eth.BlockChain().CurrentBlock().NumberU64()
+	start := p.history.replayed()
+	if start > head {
+		return nil
 	}
+	opts := &bind.FilterOpts{Start: start, End: &head}
 
-	if fileExists {
-		p.updateFile(path, url, operation, false)
-	} else {
-		p.updateFile(path, url, operation, true)
+	var errs []error
+	replay := func(it eventIterator, err error, event func() interface{}) {
+		if err != nil {
+			errs = append(errs, err)
+			return
+		}
+		defer it.Close()
+		for it.Next() {
+			p.recordEvent(event())
+		}
+		if err := it.Error(); err != nil {
+			errs = append(errs, err)
+		}
 	}
+	orgPendingApproval, err := p.permOrg.FilterOrgPendingApproval(opts)
+	replay(orgPendingApproval, err, func() interface{} { return orgPendingApproval.Event })
+	orgApproved, err := p.permOrg.FilterOrgApproved(opts)
+	replay(orgApproved, err, func() interface{} { return orgApproved.Event })
+	orgSuspended, err := p.permOrg.FilterOrgSuspended(opts)
+	replay(orgSuspended, err, func() interface{} { return orgSuspended.Event })
+	orgReactivated, err := p.permOrg.FilterOrgSuspensionRevoked(opts)
+	replay(orgReactivated, err, func() interface{} { return orgReactivated.Event })
+
+	nodeApproved, err := p.permNode.FilterNodeApproved(opts)
+	replay(nodeApproved, err, func() interface{} { return nodeApproved.Event })
+	nodeProposed, err := p.permNode.FilterNodeProposed(opts)
+	replay(nodeProposed, err, func() interface{} { return nodeProposed.Event })
+	nodeDeactivated, err := p.permNode.FilterNodeDeactivated(opts)
+	replay(nodeDeactivated, err, func() interface{} { return nodeDeactivated.Event })
+	nodeActivated, err := p.permNode.FilterNodeActivated(opts)
+	replay(nodeActivated, err, func() interface{} { return nodeActivated.Event })
+	nodeBlacklisted, err := p.permNode.FilterNodeBlacklisted(opts)
+	replay(nodeBlacklisted, err, func() interface{} { return nodeBlacklisted.Event })
+	nodeRecoveryInit, err := p.permNode.FilterNodeRecoveryInitiated(opts)
+	replay(nodeRecoveryInit, err, func() interface{} { return nodeRecoveryInit.Event })
+	nodeRecoveryDone, err := p.permNode.FilterNodeRecoveryCompleted(opts)
+	replay(nodeRecoveryDone, err, func() interface{} { return nodeRecoveryDone.Event })
+
+	roleCreated, err := p.permRole.FilterRoleCreated(opts)
+	replay(roleCreated, err, func() interface{} { return roleCreated.Event })
+	roleRevoked, err := p.permRole.FilterRoleRevoked(opts)
+	replay(roleRevoked, err, func() interface{} { return roleRevoked.Event })
+	policyAdded, err := p.permRole.FilterRolePolicyAdded(opts)
+	replay(policyAdded, err, func() interface{} { return policyAdded.Event })
+	policyRemoved, err := p.permRole.FilterRolePolicyRemoved(opts)
+	replay(policyRemoved, err, func() interface{} { return policyRemoved.Event })
+
+	accessModified, err := p.permAcct.FilterAccountAccessModified(opts)
+	replay(accessModified, err, func() interface{} { return accessModified.Event })
+	accessRevoked, err := p.permAcct.FilterAccountAccessRevoked(opts)
+	replay(accessRevoked, err, func() interface{} { return accessRevoked.Event })
+	statusChanged, err := p.permAcct.FilterAccountStatusChanged(opts)
+	replay(statusChanged, err, func() interface{} { return statusChanged.Event })
+	expirySet, err := p.permAcct.FilterAccountExpirySet(opts)
+	replay(expirySet, err, func() interface{} { return expirySet.Event })
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to replay the permission events: %v", errs)
+	}
+	return p.history.setReplayed(head)
 }
 
 // Monitors account access related events and updates the cache accordingly
@@ -541,6 +627,7 @@ func (p *PermissionCtrl) manageAccountPermissions() error {
 	chAccessModified := make(chan *pbind.AcctManagerAccountAccessModified)
 	chAccessRevoked := make(chan *pbind.AcctManagerAccountAccessRevoked)
 	chStatusChanged := make(chan *pbind.AcctManagerAccountStatusChanged)
+	chExpirySet := make(chan *pbind.AcctManagerAccountExpirySet)
 
 	opts := &bind.WatchOpts{}
 	var blockNumber uint64 = 1
@@ -558,6 +645,18 @@ func (p *PermissionCtrl) manageAccountPermissions() error {
 		return fmt.Errorf("failed AccountStatusChanged: %v", err)
 	}
 
+	if _, err := p.permAcct.AcctManagerFilterer.WatchAccountExpirySet(opts, chExpirySet); err != nil {
+		return fmt.Errorf("failed AccountExpirySet: %v", err)
+	}
+
+	// The events arrive on separate channels, the expiry is read from the
+	// contract whenever an account changes so that the order doesn't matter
+	acctSession := &pbind.AcctManagerCallerSession{Contract: &p.permAcct.AcctManagerCaller}
+	updateExpiry := func(acct common.Address) {
+		expiryBlock, expiryTime := lookupAccountExpiry(acctSession, acct)
+		types.AcctInfoMap.SetAccountExpiry(acct, expiryBlock, expiryTime)
+	}
+
 	go func() {
 		stopChan, stopSubscription := p.subscribeStopEvent()
 		defer stopSubscription.Unsubscribe()
@@ -565,13 +664,22 @@ func (p *PermissionCtrl) manageAccountPermissions() error {
 			select {
 			case evtAccessModified := <-chAccessModified:
 				types.AcctInfoMap.UpsertAccount(evtAccessModified.OrgId, evtAccessModified.RoleId, evtAccessModified.Account, evtAccessModified.OrgAdmin, types.AcctStatus(int(evtAccessModified.Status.Uint64())))
+				updateExpiry(evtAccessModified.Account)
+				p.recordEvent(evtAccessModified)
 
 			case evtAccessRevoked := <-chAccessRevoked:
 				types.AcctInfoMap.UpsertAccount(evtAccessRevoked.OrgId, evtAccessRevoked.RoleId, evtAccessRevoked.Account, evtAccessRevoked.OrgAdmin, types.AcctActive)
+				p.recordEvent(evtAccessRevoked)
 
 			case evtStatusChanged := <-chStatusChanged:
 				ac := types.AcctInfoMap.GetAccount(evtStatusChanged.Account)
 				types.AcctInfoMap.UpsertAccount(evtStatusChanged.OrgId, ac.RoleId, evtStatusChanged.Account, ac.IsOrgAdmin, types.AcctStatus(int(evtStatusChanged.Status.Uint64())))
+				p.recordEvent(evtStatusChanged)
+
+			case evtExpirySet := <-chExpirySet:
+				updateExpiry(evtExpirySet.Account)
+				p.recordEvent(evtExpirySet)
+
 			case <-stopChan:
 				log.Info("quit account contract watch")
 				return
@@ -689,8 +797,8 @@ func (p *PermissionCtrl) bootupNetwork(permInterfSession *pbind.PermInterfaceSes
 // populates the account access details from contract into cache
 func (p *PermissionCtrl) populateAccountsFromContract(auth *bind.TransactOpts) error {
 	//populate accounts
-	permAcctSession := &pbind.AcctManagerSession{
-		Contract: p.permAcct,
+	permAcctSession := &pbind.AcctManagerCallerSession{
+		Contract: &p.permAcct.AcctManagerCaller,
 		CallOpts: bind.CallOpts{
 			Pending: true,
 		},
@@ -701,6 +809,8 @@ func (p *PermissionCtrl) populateAccountsFromContract(auth *bind.TransactOpts) e
 		for k := uint64(0); k < iOrgNum; k++ {
 			if addr, org, role, status, orgAdmin, err := permAcctSession.GetAccountDetailsFromIndex(big.NewInt(int64(k))); err == nil {
 				types.AcctInfoMap.UpsertAccount(org, role, addr, orgAdmin, types.AcctStatus(int(status.Int64())))
+				expiryBlock, expiryTime := lookupAccountExpiry(permAcctSession, addr)
+				types.AcctInfoMap.SetAccountExpiry(addr, expiryBlock, expiryTime)
 			}
 		}
 	} else {
@@ -712,8 +822,8 @@ func (p *PermissionCtrl) populateAccountsFromContract(auth *bind.TransactOpts) e
 // populates the role details from contract into cache
 func (p *PermissionCtrl) populateRolesFromContract(auth *bind.TransactOpts) error {
 	//populate roles
-	permRoleSession := &pbind.RoleManagerSession{
-		Contract: p.permRole,
+	permRoleSession := &pbind.RoleManagerCallerSession{
+		Contract: &p.permRole.RoleManagerCaller,
 		CallOpts: bind.CallOpts{
 			Pending: true,
 		},
@@ -723,6 +833,7 @@ func (p *PermissionCtrl) populateRolesFromContract(auth *bind.TransactOpts) erro
 		for k := uint64(0); k < iOrgNum; k++ {
 			if roleStruct, err := permRoleSession.GetRoleDetailsFromIndex(big.NewInt(int64(k))); err == nil {
 				types.RoleInfoMap.UpsertRole(roleStruct.OrgId, roleStruct.RoleId, roleStruct.Voter, roleStruct.Admin, types.AccessType(int(roleStruct.AccessType.Int64())), roleStruct.Active)
+				types.RoleInfoMap.SetRolePolicies(roleStruct.OrgId, roleStruct.RoleId, lookupRolePolicies(permRoleSession, roleStruct.OrgId, roleStruct.RoleId))
 			}
 		}
 
@@ -745,7 +856,7 @@ func (p *PermissionCtrl) populateNodesFromContract(auth *bind.TransactOpts) erro
 		iOrgNum := numberOfNodes.Uint64()
 		for k := uint64(0); k < iOrgNum; k++ {
 			if nodeStruct, err := permNodeSession.GetNodeDetailsFromIndex(big.NewInt(int64(k))); err == nil {
-				types.NodeInfoMap.UpsertNode(nodeStruct.OrgId, nodeStruct.EnodeId, types.NodeStatus(int(nodeStruct.NodeStatus.Int64())))
+				p.updateNode(nodeStruct.OrgId, nodeStruct.EnodeId, types.NodeStatus(int(nodeStruct.NodeStatus.Int64())))
 			}
 		}
 	} else {
@@ -785,7 +896,7 @@ func (p *PermissionCtrl) populateStaticNodesToContract(permissionsSession *pbind
 			log.Warn("Failed to propose node", "err", err, "enode", node.EnodeID())
 			return err
 		}
-		types.NodeInfoMap.UpsertNode(p.permConfig.NwAdminOrg, node.String(), 2)
+		p.updateNode(p.permConfig.NwAdminOrg, node.String(), types.NodeApproved)
 	}
 	return nil
 }
@@ -818,6 +929,8 @@ func (p *PermissionCtrl) updateNetworkStatus(permissionsSession *pbind.PermInter
 func (p *PermissionCtrl) manageRolePermissions() error {
 	chRoleCreated := make(chan *pbind.RoleManagerRoleCreated, 1)
 	chRoleRevoked := make(chan *pbind.RoleManagerRoleRevoked, 1)
+	chPolicyAdded := make(chan *pbind.RoleManagerRolePolicyAdded, 1)
+	chPolicyRemoved := make(chan *pbind.RoleManagerRolePolicyRemoved, 1)
 
 	opts := &bind.WatchOpts{}
 	var blockNumber uint64 = 1
@@ -831,6 +944,21 @@ func (p *PermissionCtrl) manageRolePermissions() error {
 		return fmt.Errorf("failed WatchRoleRemoved: %v", err)
 	}
 
+	if _, err := p.permRole.RoleManagerFilterer.WatchRolePolicyAdded(opts, chPolicyAdded); err != nil {
+		return fmt.Errorf("failed WatchRolePolicyAdded: %v", err)
+	}
+
+	if _, err := p.permRole.RoleManagerFilterer.WatchRolePolicyRemoved(opts, chPolicyRemoved); err != nil {
+		return fmt.Errorf("failed WatchRolePolicyRemoved: %v", err)
+	}
+
+	// the policy events of a role are delivered on separate channels in no
+	// particular order, the policies are read back from the contract instead
+	roleSession := &pbind.RoleManagerCallerSession{Contract: &p.permRole.RoleManagerCaller}
+	updatePolicies := func(orgId, roleId string) {
+		types.RoleInfoMap.SetRolePolicies(orgId, roleId, lookupRolePolicies(roleSession, orgId, roleId))
+	}
+
 	go func() {
 		stopChan, stopSubscription := p.subscribeStopEvent()
 		defer stopSubscription.Unsubscribe()
@@ -838,13 +966,24 @@ func (p *PermissionCtrl) manageRolePermissions() error {
 			select {
 			case evtRoleCreated := <-chRoleCreated:
 				types.RoleInfoMap.UpsertRole(evtRoleCreated.OrgId, evtRoleCreated.RoleId, evtRoleCreated.IsVoter, evtRoleCreated.IsAdmin, types.AccessType(int(evtRoleCreated.BaseAccess.Uint64())), true)
+				p.recordEvent(evtRoleCreated)
 
 			case evtRoleRevoked := <-chRoleRevoked:
+				p.recordEvent(evtRoleRevoked)
 				if r := types.RoleInfoMap.GetRole(evtRoleRevoked.OrgId, evtRoleRevoked.RoleId); r != nil {
 					types.RoleInfoMap.UpsertRole(evtRoleRevoked.OrgId, evtRoleRevoked.RoleId, r.IsVoter, r.IsAdmin, r.Access, false)
 				} else {
 					log.Error("Revoke role - cache is missing role", "org", evtRoleRevoked.OrgId, "role", evtRoleRevoked.RoleId)
 				}
+
+			case evtPolicyAdded := <-chPolicyAdded:
+				updatePolicies(evtPolicyAdded.OrgId, evtPolicyAdded.RoleId)
+				p.recordEvent(evtPolicyAdded)
+
+			case evtPolicyRemoved := <-chPolicyRemoved:
+				updatePolicies(evtPolicyRemoved.OrgId, evtPolicyRemoved.RoleId)
+				p.recordEvent(evtPolicyRemoved)
+
 			case <-stopChan:
 				log.Info("quit role contract watch")
 				return
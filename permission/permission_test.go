@@ -9,6 +9,7 @@ import (
 	"log"
 	"math/big"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/ethereum/quorum/accounts"
@@ -20,6 +21,7 @@ import (
 	"github.com/ethereum/quorum/params"
 
 	"github.com/ethereum/quorum/p2p"
+	"github.com/ethereum/quorum/p2p/enode"
 
 	"github.com/ethereum/quorum/consensus/ethash"
 	"github.com/ethereum/quorum/eth"
@@ -29,8 +31,11 @@ import (
 	"github.com/ethereum/quorum/accounts/abi/bind"
 	"github.com/ethereum/quorum/accounts/abi/bind/backends"
 	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/common/hexutil"
 	"github.com/ethereum/quorum/core"
+	"github.com/ethereum/quorum/core/vm"
 	"github.com/ethereum/quorum/crypto"
+	"github.com/ethereum/quorum/ethdb"
 	"github.com/ethereum/quorum/node"
 	pbind "github.com/ethereum/quorum/permission/bind"
 )
@@ -129,48 +134,55 @@ func setup() {
 	}
 	backend = backends.NewSimulatedBackendFrom(ethereum)
 
-	var permUpgrInstance *pbind.PermUpgr
-
 	guardianTransactor := bind.NewKeyedTransactor(guardianKey)
-
-	permUpgrAddress, _, permUpgrInstance, err = pbind.DeployPermUpgr(guardianTransactor, backend, guardianAddress)
+	config, err := deployPermissionContracts(guardianTransactor, backend, guardianAddress)
 	if err != nil {
 		t.Fatal(err)
 	}
-	permInterfaceAddress, _, _, err = pbind.DeployPermInterface(guardianTransactor, backend, permUpgrAddress)
-	if err != nil {
-		t.Fatal(err)
+	permUpgrAddress, permInterfaceAddress, permImplAddress = config.UpgrdAddress, config.InterfAddress, config.ImplAddress
+	nodeManagerAddress, roleManagerAddress, accountManagerAddress = config.NodeAddress, config.RoleAddress, config.AccountAddress
+	orgManagerAddress, voterManagerAddress = config.OrgAddress, config.VoterAddress
+
+	fmt.Printf("current block is %v\n", ethereum.BlockChain().CurrentBlock().Number().Int64())
+}
+
+// deployPermissionContracts deploys the permission contracts owned by the
+// guardian and links them to the upgradable contract.
+func deployPermissionContracts(transactor *bind.TransactOpts, backend bind.ContractBackend, guardian common.Address) (*types.PermissionConfig, error) {
+	var (
+		config           = new(types.PermissionConfig)
+		permUpgrInstance *pbind.PermUpgr
+		err              error
+	)
+	if config.UpgrdAddress, _, permUpgrInstance, err = pbind.DeployPermUpgr(transactor, backend, guardian); err != nil {
+		return nil, err
 	}
-	nodeManagerAddress, _, _, err = pbind.DeployNodeManager(guardianTransactor, backend, permUpgrAddress)
-	if err != nil {
-		t.Fatal(err)
+	if config.InterfAddress, _, _, err = pbind.DeployPermInterface(transactor, backend, config.UpgrdAddress); err != nil {
+		return nil, err
 	}
-	roleManagerAddress, _, _, err = pbind.DeployRoleManager(guardianTransactor, backend, permUpgrAddress)
-	if err != nil {
-		t.Fatal(err)
+	if config.NodeAddress, _, _, err = pbind.DeployNodeManager(transactor, backend, config.UpgrdAddress); err != nil {
+		return nil, err
 	}
-	accountManagerAddress, _, _, err = pbind.DeployAcctManager(guardianTransactor, backend, permUpgrAddress)
-	if err != nil {
-		t.Fatal(err)
+	if config.RoleAddress, _, _, err = pbind.DeployRoleManager(transactor, backend, config.UpgrdAddress); err != nil {
+		return nil, err
 	}
-	orgManagerAddress, _, _, err = pbind.DeployOrgManager(guardianTransactor, backend, permUpgrAddress)
-	if err != nil {
-		t.Fatal(err)
+	if config.AccountAddress, _, _, err = pbind.DeployAcctManager(transactor, backend, config.UpgrdAddress); err != nil {
+		return nil, err
 	}
-	voterManagerAddress, _, _, err = pbind.DeployVoterManager(guardianTransactor, backend, permUpgrAddress)
-	if err != nil {
-		t.Fatal(err)
+	if config.OrgAddress, _, _, err = pbind.DeployOrgManager(transactor, backend, config.UpgrdAddress); err != nil {
+		return nil, err
 	}
-	permImplAddress, _, _, err = pbind.DeployPermImpl(guardianTransactor, backend, permUpgrAddress, orgManagerAddress, roleManagerAddress, accountManagerAddress, voterManagerAddress, nodeManagerAddress)
-	if err != nil {
-		t.Fatal(err)
+	if config.VoterAddress, _, _, err = pbind.DeployVoterManager(transactor, backend, config.UpgrdAddress); err != nil {
+		return nil, err
+	}
+	if config.ImplAddress, _, _, err = pbind.DeployPermImpl(transactor, backend, config.UpgrdAddress, config.OrgAddress, config.RoleAddress, config.AccountAddress, config.VoterAddress, config.NodeAddress); err != nil {
+		return nil, err
 	}
 	// call init
-	if _, err := permUpgrInstance.Init(guardianTransactor, permInterfaceAddress, permImplAddress); err != nil {
-		t.Fatal(err)
+	if _, err := permUpgrInstance.Init(transactor, config.InterfAddress, config.ImplAddress); err != nil {
+		return nil, err
 	}
-
-	fmt.Printf("current block is %v\n", ethereum.BlockChain().CurrentBlock().Number().Int64())
+	return config, nil
 }
 
 func teardown() {
@@ -459,6 +471,89 @@ func TestQuorumControlsAPI_RoleAndAccountsAPIs(t *testing.T) {
 
 }
 
+func TestQuorumControlsAPI_RolePolicyAPIs(t *testing.T) {
+	testObject := typicalQuorumControlsAPI(t)
+	invalidTxa := ethapi.SendTxArgs{From: getArbitraryAccount()}
+	txa := ethapi.SendTxArgs{From: guardianAddress}
+	contract := getArbitraryAccount()
+	selector := hexutil.Bytes{0xa9, 0x05, 0x9c, 0xbb}
+	role := "POLICY_ROLE"
+
+	_, err := testObject.AddRolePolicy(arbitraryNetworkAdminOrg, role, contract, selector, invalidTxa)
+	assert.Equal(t, err, errors.New("Invalid account id"))
+
+	_, err = testObject.AddRolePolicy(arbitraryNetworkAdminOrg, role, contract, hexutil.Bytes{0xa9}, txa)
+	assert.Equal(t, err, errors.New(ErrInvalidSelector.Msg))
+
+	_, err = testObject.AddRolePolicy(arbitraryNetworkAdminOrg, role, contract, selector, txa)
+	assert.Equal(t, err, errors.New(ErrInvalidRole.Msg))
+
+	types.RoleInfoMap.UpsertRole(arbitraryNetworkAdminOrg, role, false, false, types.Transact, true)
+	_, err = testObject.AddRolePolicy(arbitraryNetworkAdminOrg, role, contract, selector, txa)
+	assert.NoError(t, err)
+
+	_, err = testObject.RemoveRolePolicy(arbitraryNetworkAdminOrg, role, contract, selector, txa)
+	assert.Equal(t, err, errors.New(ErrPolicyNotFound.Msg))
+
+	types.RoleInfoMap.AddRolePolicy(arbitraryNetworkAdminOrg, role, types.RolePolicy{Contract: contract, Selector: selector})
+	_, err = testObject.AddRolePolicy(arbitraryNetworkAdminOrg, role, contract, selector, txa)
+	assert.Equal(t, err, errors.New(ErrPolicyExists.Msg))
+
+	_, err = testObject.RemoveRolePolicy(arbitraryNetworkAdminOrg, role, contract, selector, txa)
+	assert.NoError(t, err)
+}
+
+func TestQuorumControlsAPI_SetAccountExpiry(t *testing.T) {
+	testObject := typicalQuorumControlsAPI(t)
+	invalidTxa := ethapi.SendTxArgs{From: getArbitraryAccount()}
+	txa := ethapi.SendTxArgs{From: guardianAddress}
+	acct := getArbitraryAccount()
+	role := "EXPIRY_ROLE"
+
+	_, err := testObject.SetAccountExpiry(arbitraryNetworkAdminOrg, acct, 100, 1000, invalidTxa)
+	assert.Equal(t, err, errors.New("Invalid account id"))
+
+	_, err = testObject.SetAccountExpiry(arbitraryNetworkAdminOrg, acct, 100, 1000, txa)
+	assert.Equal(t, err, errors.New(ErrAccountNotThere.Msg))
+
+	_, err = testObject.SetAccountExpiry(arbitraryNetworkAdminOrg, guardianAddress, 100, 1000, txa)
+	assert.Equal(t, err, errors.New(ErrOrgAdminExpiry.Msg))
+
+	_, err = testObject.AddNewRole(arbitraryNetworkAdminOrg, role, uint8(types.Transact), false, false, txa)
+	assert.NoError(t, err)
+	types.RoleInfoMap.UpsertRole(arbitraryNetworkAdminOrg, role, false, false, types.Transact, true)
+
+	_, err = testObject.AddAccountToOrg(acct, arbitraryNetworkAdminOrg, role, txa)
+	assert.NoError(t, err)
+	types.AcctInfoMap.UpsertAccount(arbitraryNetworkAdminOrg, role, acct, false, types.AcctActive)
+
+	_, err = testObject.SetAccountExpiry(arbitraryNetworkAdminOrg, acct, 100, 1000, txa)
+	assert.NoError(t, err)
+
+	// the expiry is read back from the contract on cache misses and at start up
+	session := &pbind.AcctManagerCallerSession{Contract: &testObject.permCtrl.permAcct.AcctManagerCaller, CallOpts: bind.CallOpts{Pending: true}}
+	if _, _, err := session.GetAccountExpiry(acct); err != nil {
+		expiryBlock, expiryTime := lookupAccountExpiry(session, acct)
+		assert.Equal(t, uint64(0), expiryBlock)
+		assert.Equal(t, uint64(0), expiryTime)
+		t.Skipf("account manager contract without account expiries: %v", err)
+	}
+	ac, err := lookupAccount(session, acct)
+	assert.NoError(t, err)
+	if assert.NotNil(t, ac) {
+		assert.Equal(t, uint64(100), ac.ExpiryBlock)
+		assert.Equal(t, uint64(1000), ac.ExpiryTime)
+	}
+
+	types.AcctInfoMap.SetAccountExpiry(acct, 0, 0)
+	assert.NoError(t, testObject.permCtrl.populateAccountsFromContract(nil))
+	ac = types.AcctInfoMap.GetAccount(acct)
+	if assert.NotNil(t, ac) {
+		assert.Equal(t, uint64(100), ac.ExpiryBlock)
+		assert.Equal(t, uint64(1000), ac.ExpiryTime)
+	}
+}
+
 func getArbitraryAccount() common.Address {
 	acctKey, _ := crypto.GenerateKey()
 	return crypto.PubkeyToAddress(acctKey.PublicKey)
@@ -508,55 +603,27 @@ func tmpKeyStore(encrypted bool) (string, *keystore.KeyStore, error) {
 	return d, new(d), err
 }
 
-func TestPermissionCtrl_whenUpdateFile(t *testing.T) {
+func TestPermissionCtrl_updateNode(t *testing.T) {
 	testObject := typicalPermissionCtrl(t)
-	assert.NoError(t, testObject.AfterStart())
-
-	err := testObject.populateInitPermissions()
+	node, err := enode.ParseV4(arbitraryNode2)
 	assert.NoError(t, err)
 
-	d, _ := ioutil.TempDir("", "qdata")
-	defer os.RemoveAll(d)
-
-	testObject.dataDir = d
-	testObject.updatePermissionedNodes(arbitraryNode1, NodeAdd)
-
-	permFile, _ := os.Create(d + "/" + "permissioned-nodes.json")
-
-	testObject.updateFile("testFile", arbitraryNode2, NodeAdd, false)
-	testObject.updateFile(permFile.Name(), arbitraryNode2, NodeAdd, false)
-	testObject.updateFile(permFile.Name(), arbitraryNode2, NodeAdd, true)
-	testObject.updateFile(permFile.Name(), arbitraryNode2, NodeAdd, true)
-	testObject.updateFile(permFile.Name(), arbitraryNode1, NodeAdd, false)
-	testObject.updateFile(permFile.Name(), arbitraryNode1, NodeDelete, false)
-	testObject.updateFile(permFile.Name(), arbitraryNode1, NodeDelete, false)
-
-	blob, err := ioutil.ReadFile(permFile.Name())
-	var nodeList []string
-	if err := json.Unmarshal(blob, &nodeList); err != nil {
-		t.Fatal("Failed to load nodes list from file", "fileName", permFile, "err", err)
-		return
+	for _, test := range []struct {
+		status       types.NodeStatus
+		permissioned bool
+	}{
+		{types.NodePendingApproval, false},
+		{types.NodeApproved, true},
+		{types.NodeDeactivated, false},
+		{types.NodeApproved, true},
+		{types.NodeBlackListed, false},
+		{types.NodeRecoveryInitiated, false},
+		{types.NodeApproved, true},
+	} {
+		testObject.updateNode(arbitraryNetworkAdminOrg, arbitraryNode2, test.status)
+		assert.Equal(t, test.status, types.NodeInfoMap.GetNodeByUrl(arbitraryNode2).Status)
+		assert.Equal(t, test.permissioned, testObject.nodes.IsNodePermissioned(node.ID()))
 	}
-	assert.Equal(t, len(nodeList), 1)
-	testObject.updatePermissionedNodes(arbitraryNode1, NodeAdd)
-	testObject.updatePermissionedNodes(arbitraryNode1, NodeDelete)
-
-	blob, err = ioutil.ReadFile(permFile.Name())
-	if err := json.Unmarshal(blob, &nodeList); err != nil {
-		t.Fatal("Failed to load nodes list from file", "fileName", permFile, "err", err)
-		return
-	}
-	assert.Equal(t, len(nodeList), 1)
-
-	testObject.updateDisallowedNodes(arbitraryNode2, NodeAdd)
-	testObject.updateDisallowedNodes(arbitraryNode2, NodeDelete)
-	blob, err = ioutil.ReadFile(d + "/" + "disallowed-nodes.json")
-	if err := json.Unmarshal(blob, &nodeList); err != nil {
-		t.Fatal("Failed to load nodes list from file", "fileName", permFile, "err", err)
-		return
-	}
-	assert.Equal(t, len(nodeList), 0)
-
 }
 
 func TestParsePermissionConfig(t *testing.T) {
@@ -614,10 +681,86 @@ func TestParsePermissionConfig(t *testing.T) {
 
 	_ = os.Remove(fileName)
 	tmpPermCofig.InterfAddress = common.StringToAddress("0xed9d02e382b34818e88b88a309c7fe71e65f419d")
+	tmpPermCofig.UpgrdAddress = permUpgrAddress
+	tmpPermCofig.ImplAddress = permImplAddress
+	tmpPermCofig.NodeAddress = nodeManagerAddress
+	tmpPermCofig.AccountAddress = accountManagerAddress
+	tmpPermCofig.RoleAddress = roleManagerAddress
+	tmpPermCofig.VoterAddress = voterManagerAddress
+	tmpPermCofig.OrgAddress = orgManagerAddress
 	blob, err = json.Marshal(tmpPermCofig)
 	if err := ioutil.WriteFile(fileName, blob, 0644); err != nil {
 		t.Fatal("Error writing new node info to file", "fileName", fileName, "err", err)
 	}
 	permConfig, err := ParsePermissionConfig(d)
+	assert.NoError(t, err)
 	assert.False(t, permConfig.IsEmpty(), "expected non empty object")
+
+	// invalid addresses and unknown fields are rejected
+	for _, blob := range []string{
+		strings.Replace(string(blob), strings.ToLower(permUpgrAddress.Hex()), "0x1234", 1),
+		strings.Replace(string(blob), `"nwAdminOrg"`, `"nwAdminOrgs"`, 1),
+	} {
+		if err := ioutil.WriteFile(fileName, []byte(blob), 0644); err != nil {
+			t.Fatal("Error writing new node info to file", "fileName", fileName, "err", err)
+		}
+		_, err = ParsePermissionConfig(d)
+		assert.Error(t, err)
+	}
+}
+
+func TestPermissionCtrl_PermissionsAt(t *testing.T) {
+	// the contracts are committed to a chain of their own, the shared test
+	// chain keeps them in the pending state
+	key, _ := crypto.GenerateKey()
+	admin := crypto.PubkeyToAddress(key.PublicKey)
+	db := ethdb.NewMemDatabase()
+	genesis := &core.Genesis{Config: params.AllEthashProtocolChanges, GasLimit: 10000000000,
+		Alloc: core.GenesisAlloc{admin: {Balance: big.NewInt(100000000000000)}}}
+	genesis.MustCommit(db)
+	chain, err := core.NewBlockChain(db, nil, genesis.Config, ethash.NewFaker(), vm.Config{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chain.Stop()
+	sim := backends.NewSimulatedBackendFromChain(db, chain)
+
+	transactor := bind.NewKeyedTransactor(key)
+	config, err := deployPermissionContracts(transactor, sim, admin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.NwAdminOrg, config.NwAdminRole, config.OrgAdminRole = arbitraryNetworkAdminOrg, arbitraryNetworkAdminRole, arbitraryOrgAdminRole
+	sim.Commit()
+
+	// nothing is enforced before the network boot completed
+	reader, err := newStateReader(chain, chain.CurrentHeader(), config)
+	assert.NoError(t, err)
+	assert.Nil(t, reader)
+
+	interf, err := pbind.NewPermInterface(config.InterfAddress, sim)
+	if err != nil {
+		t.Fatal(err)
+	}
+	session := &pbind.PermInterfaceSession{Contract: interf, TransactOpts: *transactor}
+	_, err = session.SetPolicy(config.NwAdminOrg, config.NwAdminRole, config.OrgAdminRole)
+	assert.NoError(t, err)
+	_, err = session.Init(big.NewInt(3), big.NewInt(3))
+	assert.NoError(t, err)
+	_, err = session.AddAdminAccount(admin)
+	assert.NoError(t, err)
+	_, err = session.UpdateNetworkBootStatus()
+	assert.NoError(t, err)
+	sim.Commit()
+
+	head := chain.CurrentHeader()
+	reader, err = newStateReader(chain, head, config)
+	assert.NoError(t, err)
+	if assert.NotNil(t, reader) {
+		types.SetDefaults(config.NwAdminRole, config.OrgAdminRole)
+		access := types.NewPermissionSnapshot(reader)
+		assert.Equal(t, types.FullAccess, access.GetAcctAccess(admin, head.Number, 0))
+		assert.Equal(t, types.ReadOnly, access.GetAcctAccess(common.Address{1}, head.Number, 0))
+		assert.NoError(t, access.Err())
+	}
 }
@@ -0,0 +1,257 @@
+package permission
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/core/types"
+	"github.com/ethereum/quorum/internal/ethapi"
+	"github.com/ethereum/quorum/log"
+	pbind "github.com/ethereum/quorum/permission/bind"
+)
+
+// status of a plan step
+const (
+	StepReady    = "ready"    // passes the validations, sent when the plan is applied
+	StepDeferred = "deferred" // depends on an earlier step, validated once that step is approved
+	StepInvalid  = "invalid"  // fails the validations, the plan is not applied
+	StepSent     = "sent"     // transaction sent
+	StepFailed   = "failed"   // transaction could not be sent
+)
+
+var ErrRoleMismatch = ExecStatus{false, "Role exists for the org with different access"}
+
+// Plan is a declarative description of organizations with their sub orgs,
+// roles, accounts and nodes. Applying a plan only adds what is missing from the
+// permission caches, it never removes anything.
+type Plan struct {
+	Orgs []PlanOrg `json:"orgs" yaml:"orgs"`
+}
+
+// PlanOrg describes an org. EnodeId is the node the org is proposed with and
+// Admin the org admin account, which only applies to master orgs. Sub org ids
+// are relative to their parent org.
+type PlanOrg struct {
+	OrgId    string         `json:"orgId" yaml:"orgId"`
+	EnodeId  string         `json:"enodeId" yaml:"enodeId"`
+	Admin    common.Address `json:"admin" yaml:"admin"`
+	Roles    []PlanRole     `json:"roles" yaml:"roles"`
+	Accounts []PlanAccount  `json:"accounts" yaml:"accounts"`
+	Nodes    []string       `json:"nodes" yaml:"nodes"`
+	SubOrgs  []PlanOrg      `json:"subOrgs" yaml:"subOrgs"`
+}
+
+type PlanRole struct {
+	RoleId string `json:"roleId" yaml:"roleId"`
+	Access uint8  `json:"access" yaml:"access"`
+	Voter  bool   `json:"voter" yaml:"voter"`
+	Admin  bool   `json:"admin" yaml:"admin"`
+}
+
+type PlanAccount struct {
+	Account common.Address `json:"account" yaml:"account"`
+	RoleId  string         `json:"roleId" yaml:"roleId"`
+}
+
+// PlanStep is a permission action needed to apply a plan. Action is the name
+// of the matching quorumPermission API and OrgId the full org id.
+type PlanStep struct {
+	Action  string          `json:"action"`
+	OrgId   string          `json:"orgId"`
+	EnodeId string          `json:"enodeId,omitempty"`
+	RoleId  string          `json:"roleId,omitempty"`
+	Account *common.Address `json:"account,omitempty"`
+	Status  string          `json:"status"`
+	Msg     string          `json:"msg,omitempty"`
+	TxHash  *common.Hash    `json:"txHash,omitempty"`
+
+	args txArgs
+}
+
+// PlanResult lists the steps of a plan in the order they are applied. Applied
+// is set once every ready step was sent, nothing is sent if any step is
+// invalid. Deferred steps are not sent, applying the same plan again once the
+// earlier steps were approved sends them.
+type PlanResult struct {
+	Steps   []PlanStep `json:"steps"`
+	Applied bool       `json:"applied"`
+}
+
+// planner diffs a plan against the permission caches.
+type planner struct {
+	q       *QuorumControlsAPI
+	pinterf *pbind.PermInterfaceSession
+	txa     ethapi.SendTxArgs
+
+	newOrgs    map[string]bool // full ids of the orgs added by the plan
+	newRoles   map[string]bool // orgId/roleId of the roles added by the plan
+	orgPending bool            // a new master org awaits network admin approval
+	steps      []PlanStep
+}
+
+func (p *planner) add(step PlanStep, deferred bool, val func(txArgs, *pbind.PermInterfaceSession) ExecStatus) {
+	step.args.txa = p.txa
+	if deferred {
+		step.Status = StepDeferred
+	} else if execStatus := val(step.args, p.pinterf); execStatus != ExecSuccess {
+		step.Status, step.Msg = StepInvalid, execStatus.Msg
+	} else {
+		step.Status = StepReady
+	}
+	p.steps = append(p.steps, step)
+}
+
+func (p *planner) invalid(step PlanStep, execStatus ExecStatus) {
+	step.Status, step.Msg = StepInvalid, execStatus.Msg
+	p.steps = append(p.steps, step)
+}
+
+// planOrg adds the steps for the org and its sub orgs. porgId is the full id
+// of the parent org, empty for master orgs.
+func (p *planner) planOrg(org PlanOrg, porgId string) {
+	fullId := org.OrgId
+	if porgId != "" {
+		fullId = porgId + "." + org.OrgId
+	}
+	ultimateParent := strings.Split(fullId, ".")[0]
+
+	nodes := org.Nodes
+	if types.OrgInfoMap.GetOrg(fullId) != nil {
+		if org.EnodeId != "" {
+			nodes = append([]string{org.EnodeId}, nodes...)
+		}
+	} else if porgId == "" {
+		// only one org can be pending approval at a time
+		step := PlanStep{Action: "addOrg", OrgId: fullId, EnodeId: org.EnodeId, Account: &org.Admin,
+			args: txArgs{orgId: org.OrgId, url: org.EnodeId, acctId: org.Admin}}
+		p.add(step, p.orgPending, p.q.valAddOrg)
+		p.orgPending = true
+		p.newOrgs[fullId] = true
+	} else {
+		step := PlanStep{Action: "addSubOrg", OrgId: fullId, EnodeId: org.EnodeId,
+			args: txArgs{porgId: porgId, orgId: org.OrgId, url: org.EnodeId}}
+		p.add(step, p.newOrgs[porgId], p.q.valAddSubOrg)
+		p.newOrgs[fullId] = true
+	}
+	orgDeferred := p.newOrgs[fullId]
+
+	for _, role := range org.Roles {
+		step := PlanStep{Action: "addNewRole", OrgId: fullId, RoleId: role.RoleId,
+			args: txArgs{orgId: fullId, roleId: role.RoleId, accessType: role.Access, isVoter: role.Voter, isAdmin: role.Admin}}
+		if r := types.RoleInfoMap.GetRole(fullId, role.RoleId); r != nil {
+			// roles cannot be changed, only removed and added again
+			if !r.Active || r.Access != types.AccessType(role.Access) || r.IsVoter != role.Voter || r.IsAdmin != role.Admin {
+				p.invalid(step, ErrRoleMismatch)
+			}
+			continue
+		}
+		p.add(step, orgDeferred, p.q.valAddNewRole)
+		p.newRoles[fullId+"/"+role.RoleId] = true
+	}
+
+	for i := range org.Accounts {
+		acct := org.Accounts[i]
+		step := PlanStep{Action: "addAccountToOrg", OrgId: fullId, RoleId: acct.RoleId, Account: &acct.Account,
+			args: txArgs{orgId: fullId, roleId: acct.RoleId, acctId: acct.Account}}
+		if a := types.AcctInfoMap.GetAccount(acct.Account); a != nil {
+			if a.OrgId == fullId && a.RoleId == acct.RoleId {
+				continue
+			}
+			step.Action = "changeAccountRole"
+		}
+		// roles of the ultimate parent org are valid for its sub orgs too
+		roleDeferred := p.newRoles[fullId+"/"+acct.RoleId] || p.newRoles[ultimateParent+"/"+acct.RoleId]
+		p.add(step, orgDeferred || roleDeferred, p.q.valAssignRole)
+	}
+
+	seen := make(map[string]bool)
+	for _, url := range nodes {
+		if seen[url] {
+			continue
+		}
+		seen[url] = true
+		if n := types.NodeInfoMap.GetNodeByUrl(url); n != nil && n.OrgId == fullId {
+			continue
+		}
+		step := PlanStep{Action: "addNode", OrgId: fullId, EnodeId: url,
+			args: txArgs{orgId: fullId, url: url}}
+		p.add(step, orgDeferred, p.q.valAddNode)
+	}
+
+	for _, subOrg := range org.SubOrgs {
+		p.planOrg(subOrg, fullId)
+	}
+}
+
+// send sends the transaction of a ready step.
+func (p *planner) send(step *PlanStep) {
+	var (
+		tx  *types.Transaction
+		err error
+	)
+	args := step.args
+	switch step.Action {
+	case "addOrg":
+		tx, err = p.pinterf.AddOrg(args.orgId, args.url, args.acctId)
+	case "addSubOrg":
+		tx, err = p.pinterf.AddSubOrg(args.porgId, args.orgId, args.url)
+	case "addNewRole":
+		tx, err = p.pinterf.AddNewRole(args.roleId, args.orgId, big.NewInt(int64(args.accessType)), args.isVoter, args.isAdmin)
+	case "addAccountToOrg", "changeAccountRole":
+		tx, err = p.pinterf.AssignAccountRole(args.acctId, args.orgId, args.roleId)
+	case "addNode":
+		tx, err = p.pinterf.AddNode(args.orgId, args.url)
+	}
+	if err != nil {
+		log.Error("Failed to execute permission plan step", "action", step.Action, "org", step.OrgId, "err", err)
+		step.Status, step.Msg = StepFailed, err.Error()
+		return
+	}
+	hash := tx.Hash()
+	step.Status, step.TxHash = StepSent, &hash
+	log.Debug("executed permission plan step", "action", step.Action, "org", step.OrgId, "tx", tx)
+}
+
+// ApplyPlan validates every step needed to apply the plan and, unless it is a
+// dry run or a step is invalid, sends the transactions of the ready steps in
+// order.
+func (q *QuorumControlsAPI) ApplyPlan(plan Plan, dryRun bool, txa ethapi.SendTxArgs) (PlanResult, error) {
+	pinterf, execStatus := q.initOp(txa)
+	if execStatus != ExecSuccess {
+		_, err := execStatus.OpStatus()
+		return PlanResult{}, err
+	}
+	p := &planner{
+		q:        q,
+		pinterf:  pinterf,
+		txa:      txa,
+		newOrgs:  make(map[string]bool),
+		newRoles: make(map[string]bool),
+	}
+	for _, org := range plan.Orgs {
+		p.planOrg(org, "")
+	}
+	result := PlanResult{Steps: p.steps}
+	if result.Steps == nil {
+		result.Steps = []PlanStep{}
+	}
+	for _, step := range result.Steps {
+		if step.Status == StepInvalid {
+			return result, nil
+		}
+	}
+	if dryRun {
+		return result, nil
+	}
+	for i := range result.Steps {
+		if result.Steps[i].Status != StepReady {
+			continue
+		}
+		if p.send(&result.Steps[i]); result.Steps[i].Status == StepFailed {
+			return result, nil
+		}
+	}
+	result.Applied = true
+	return result, nil
+}
@@ -0,0 +1,85 @@
+package permission
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/ethereum/quorum/core/types"
+	"github.com/ethereum/quorum/crypto"
+	"github.com/ethereum/quorum/internal/ethapi"
+	"github.com/ethereum/quorum/p2p/enode"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func arbitraryNode() string {
+	key, _ := crypto.GenerateKey()
+	return enode.NewV4(&key.PublicKey, net.ParseIP("127.0.0.1"), 21000, 0, 50401).String()
+}
+
+func TestQuorumControlsAPI_ApplyPlan(t *testing.T) {
+	testObject := typicalQuorumControlsAPI(t)
+	txa := ethapi.SendTxArgs{From: guardianAddress}
+	acct := getArbitraryAccount()
+	subNode, node := arbitraryNode(), arbitraryNode()
+
+	var plan Plan
+	assert.NoError(t, yaml.UnmarshalStrict([]byte(fmt.Sprintf(`
+orgs:
+  - orgId: %s
+    roles:
+      - roleId: PLAN_ROLE
+        access: 1
+    accounts:
+      - account: %s
+        roleId: PLAN_ROLE
+    subOrgs:
+      - orgId: PLANSUB
+        enodeId: "%s"
+        nodes: ["%s"]
+`, arbitraryNetworkAdminOrg, acct.Hex(), subNode, node)), &plan))
+
+	statuses := func(result PlanResult) []string {
+		var s []string
+		for _, step := range result.Steps {
+			s = append(s, step.Action+" "+step.OrgId+" "+step.Status)
+		}
+		return s
+	}
+
+	result, err := testObject.ApplyPlan(plan, true, txa)
+	assert.NoError(t, err)
+	assert.False(t, result.Applied)
+	assert.Equal(t, []string{
+		"addNewRole NETWORK_ADMIN ready",
+		"addAccountToOrg NETWORK_ADMIN deferred",
+		"addSubOrg NETWORK_ADMIN.PLANSUB ready",
+		"addNode NETWORK_ADMIN.PLANSUB deferred",
+	}, statuses(result))
+
+	result, err = testObject.ApplyPlan(plan, false, txa)
+	assert.NoError(t, err)
+	assert.True(t, result.Applied)
+	for _, step := range result.Steps {
+		assert.Equal(t, step.Status == StepSent, step.TxHash != nil)
+	}
+
+	// the role was approved, the account can be added now
+	types.RoleInfoMap.UpsertRole(arbitraryNetworkAdminOrg, "PLAN_ROLE", false, false, types.AccessType(1), true)
+	plan.Orgs[0].SubOrgs = nil
+	result, err = testObject.ApplyPlan(plan, true, txa)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"addAccountToOrg NETWORK_ADMIN ready"}, statuses(result))
+
+	// roles cannot be changed, nothing is sent
+	plan.Orgs[0].Roles[0].Access = 2
+	result, err = testObject.ApplyPlan(plan, false, txa)
+	assert.NoError(t, err)
+	assert.False(t, result.Applied)
+	assert.Equal(t, []string{"addNewRole NETWORK_ADMIN invalid", "addAccountToOrg NETWORK_ADMIN ready"}, statuses(result))
+	assert.Nil(t, result.Steps[1].TxHash)
+
+	_, err = testObject.ApplyPlan(plan, true, ethapi.SendTxArgs{From: getArbitraryAccount()})
+	assert.Equal(t, ErrInvalidAccount.Msg, err.Error())
+}
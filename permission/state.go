@@ -0,0 +1,105 @@
+package permission
+
+import (
+	"context"
+	"math"
+	"math/big"
+
+	quorum "github.com/ethereum/quorum"
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/core"
+	"github.com/ethereum/quorum/core/state"
+	"github.com/ethereum/quorum/core/types"
+	"github.com/ethereum/quorum/core/vm"
+	pbind "github.com/ethereum/quorum/permission/bind"
+)
+
+// setPermissionsAt lets the block validator read the permission contracts in
+// the state of a block, independent of the permission caches.
+func (p *PermissionCtrl) setPermissionsAt(bc *core.BlockChain) {
+	types.SetPermissionsAt(func(header *types.Header) (types.PermissionReader, error) {
+		return newStateReader(bc, header, p.permConfig)
+	})
+}
+
+// stateReader reads the permission entries from the permission contracts in
+// the state of a block.
+type stateReader struct {
+	org  *pbind.OrgManagerCallerSession
+	role *pbind.RoleManagerCallerSession
+	acct *pbind.AcctManagerCallerSession
+}
+
+// newStateReader creates a reader of the permission contracts in the state of
+// the block. The network is permissioned once the permission contracts are
+// deployed and the network boot completed, a nil reader is returned before.
+func newStateReader(bc *core.BlockChain, header *types.Header, config *types.PermissionConfig) (*stateReader, error) {
+	publicState, privateState, err := bc.StateAt(header.Root)
+	if err != nil {
+		return nil, err
+	}
+	caller := &stateCaller{bc: bc, header: header, publicState: publicState, privateState: privateState}
+	if len(publicState.GetCode(config.InterfAddress)) == 0 {
+		return nil, nil
+	}
+	interf, err := pbind.NewPermInterfaceCaller(config.InterfAddress, caller)
+	if err != nil {
+		return nil, err
+	}
+	// the interface reverts as long as no implementation is set
+	if booted, err := interf.GetNetworkBootStatus(nil); err != nil || !booted {
+		return nil, nil
+	}
+	org, err := pbind.NewOrgManagerCaller(config.OrgAddress, caller)
+	if err != nil {
+		return nil, err
+	}
+	role, err := pbind.NewRoleManagerCaller(config.RoleAddress, caller)
+	if err != nil {
+		return nil, err
+	}
+	acct, err := pbind.NewAcctManagerCaller(config.AccountAddress, caller)
+	if err != nil {
+		return nil, err
+	}
+	return &stateReader{
+		org:  &pbind.OrgManagerCallerSession{Contract: org},
+		role: &pbind.RoleManagerCallerSession{Contract: role},
+		acct: &pbind.AcctManagerCallerSession{Contract: acct},
+	}, nil
+}
+
+func (r *stateReader) ReadOrg(orgId string) (*types.OrgInfo, error) {
+	return lookupOrg(r.org, orgId)
+}
+
+func (r *stateReader) ReadRole(orgId, roleId string) (*types.RoleInfo, error) {
+	return lookupRole(r.role, orgId, roleId)
+}
+
+func (r *stateReader) ReadAccount(acct common.Address) (*types.AccountInfo, error) {
+	return lookupAccount(r.acct, acct)
+}
+
+// stateCaller runs contract calls on a copy of the state of a block, it
+// implements bind.ContractCaller.
+type stateCaller struct {
+	bc           *core.BlockChain
+	header       *types.Header
+	publicState  *state.StateDB
+	privateState *state.StateDB
+}
+
+func (c *stateCaller) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return c.publicState.GetCode(contract), nil
+}
+
+// CallContract runs the call like eth_call does, a reverted call returns
+// empty output.
+func (c *stateCaller) CallContract(ctx context.Context, call quorum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	msg := types.NewMessage(call.From, call.To, 0, new(big.Int), math.MaxUint64/2, new(big.Int), call.Data, false)
+	evm := vm.NewEVM(core.NewEVMContext(msg, c.header, c.bc, nil), c.publicState, c.privateState, c.bc.Config(), vm.Config{})
+	gp := new(core.GasPool).AddGas(math.MaxUint64)
+	output, _, _, err := core.ApplyMessage(evm, msg, gp)
+	return output, err
+}
@@ -0,0 +1,182 @@
+package permission
+
+import (
+	"fmt"
+
+	"github.com/ethereum/quorum/accounts/abi/bind"
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/core/types"
+	"github.com/ethereum/quorum/log"
+	pbind "github.com/ethereum/quorum/permission/bind"
+)
+
+// CacheMismatch is an entry of the permission caches which differs from the
+// permission contracts. Contract is nil if the entry is missing in the
+// contracts.
+type CacheMismatch struct {
+	Kind     string      `json:"kind"`
+	Key      string      `json:"key"`
+	Cached   interface{} `json:"cached"`
+	Contract interface{} `json:"contract"`
+}
+
+// bindImplementation binds the permissions implementation the upgradable
+// contract points to, which differs from the implAddress of the permission
+// config once the implementation was upgraded.
+func (p *PermissionCtrl) bindImplementation() error {
+	impl, err := p.permUpgr.GetPermImpl(&bind.CallOpts{Pending: true})
+	switch {
+	case err != nil || impl == (common.Address{}):
+		log.Warn("Failed to read the permissions implementation, using the configured one", "impl", p.permConfig.ImplAddress, "err", err)
+		impl = p.permConfig.ImplAddress
+	case impl != p.permConfig.ImplAddress:
+		log.Warn("Permissions implementation was upgraded", "configured", p.permConfig.ImplAddress, "current", impl)
+	}
+	return p.rebindImplementation(impl)
+}
+
+// rebindImplementation binds the permissions implementation at the address.
+func (p *PermissionCtrl) rebindImplementation(impl common.Address) error {
+	permImpl, err := pbind.NewPermImpl(impl, p.ethClnt)
+	if err != nil {
+		return err
+	}
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	p.implAddress, p.permImpl = impl, permImpl
+	return nil
+}
+
+// implementation returns the bound permissions implementation.
+func (p *PermissionCtrl) implementation() (common.Address, *pbind.PermImpl) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	return p.implAddress, p.permImpl
+}
+
+// monitors the implementation changes of the upgradable contract, rebinds the
+// implementation and checks the caches against the contracts after the switch
+func (p *PermissionCtrl) manageImplementationUpgrades() error {
+	chImplChanged := make(chan *pbind.PermUpgrImplementationChanged, 1)
+
+	opts := &bind.WatchOpts{}
+	var blockNumber uint64 = 1
+	opts.Start = &blockNumber
+
+	if _, err := p.permUpgr.PermUpgrFilterer.WatchImplementationChanged(opts, chImplChanged); err != nil {
+		return fmt.Errorf("failed WatchImplementationChanged: %v", err)
+	}
+
+	go func() {
+		stopChan, stopSubscription := p.subscribeStopEvent()
+		defer stopSubscription.Unsubscribe()
+		for {
+			select {
+			case evtImplChanged := <-chImplChanged:
+				p.recordEvent(evtImplChanged)
+				// past changes are replayed as well, follow the contract rather
+				// than the event
+				if err := p.followImplementation(); err != nil {
+					log.Error("Failed to rebind the permissions implementation", "impl", evtImplChanged.NewImpl, "err", err)
+				}
+			case <-stopChan:
+				log.Info("quit upgradable contract watch")
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// followImplementation rebinds the implementation if the upgradable contract
+// points to another one, and reports the caches which are inconsistent with
+// the contracts after the switch.
+func (p *PermissionCtrl) followImplementation() error {
+	impl, err := p.permUpgr.GetPermImpl(&bind.CallOpts{Pending: true})
+	if err != nil {
+		return err
+	}
+	if current, _ := p.implementation(); current == impl {
+		return nil
+	}
+	if err := p.rebindImplementation(impl); err != nil {
+		return err
+	}
+	log.Info("Permissions implementation changed", "impl", impl)
+
+	mismatches, err := p.checkCaches()
+	if err != nil {
+		return fmt.Errorf("cache check failed: %v", err)
+	}
+	for _, m := range mismatches {
+		log.Error("Permission cache inconsistent after implementation change", "kind", m.Kind, "key", m.Key, "cached", m.Cached, "contract", m.Contract)
+	}
+	return nil
+}
+
+// checkCaches compares the permission caches and the network admin policy of
+// the implementation with the pending state of the permission contracts.
+func (p *PermissionCtrl) checkCaches() ([]CacheMismatch, error) {
+	mismatches := make([]CacheMismatch, 0)
+	report := func(kind, key string, cached, contract interface{}) {
+		mismatches = append(mismatches, CacheMismatch{Kind: kind, Key: key, Cached: cached, Contract: contract})
+	}
+
+	// the policy is carried forward to the new implementation by the upgrade
+	impl, permImpl := p.implementation()
+	nwAdminOrg, nwAdminRole, orgAdminRole, _, err := permImpl.GetPolicyDetails(&bind.CallOpts{Pending: true})
+	if err != nil {
+		return nil, err
+	}
+	if nwAdminOrg != p.permConfig.NwAdminOrg || nwAdminRole != p.permConfig.NwAdminRole || orgAdminRole != p.permConfig.OrgAdminRole {
+		report(HistoryImplementation, impl.Hex(),
+			[]string{p.permConfig.NwAdminOrg, p.permConfig.NwAdminRole, p.permConfig.OrgAdminRole},
+			[]string{nwAdminOrg, nwAdminRole, orgAdminRole})
+	}
+
+	pending := bind.CallOpts{Pending: true}
+	orgSession := &pbind.OrgManagerCallerSession{Contract: &p.permOrg.OrgManagerCaller, CallOpts: pending}
+	for _, cached := range types.OrgInfoMap.GetOrgList() {
+		org, err := lookupOrg(orgSession, cached.FullOrgId)
+		if err != nil {
+			return nil, err
+		}
+		if org == nil || org.Status != cached.Status || org.ParentOrgId != cached.ParentOrgId ||
+			org.UltimateParent != cached.UltimateParent || org.Level.Cmp(cached.Level) != 0 {
+			report(HistoryOrg, cached.FullOrgId, cached, org)
+		}
+	}
+	nodeSession := &pbind.NodeManagerCallerSession{Contract: &p.permNode.NodeManagerCaller, CallOpts: pending}
+	for _, cached := range types.NodeInfoMap.GetNodeList() {
+		node, err := lookupNode(nodeSession, cached.Url)
+		if err != nil {
+			return nil, err
+		}
+		if node == nil || node.OrgId != cached.OrgId || node.Status != cached.Status {
+			report(HistoryNode, cached.Url, cached, node)
+		}
+	}
+	roleSession := &pbind.RoleManagerCallerSession{Contract: &p.permRole.RoleManagerCaller, CallOpts: pending}
+	for _, cached := range types.RoleInfoMap.GetRoleList() {
+		role, err := lookupRole(roleSession, cached.OrgId, cached.RoleId)
+		if err != nil {
+			return nil, err
+		}
+		if role == nil || role.IsVoter != cached.IsVoter || role.IsAdmin != cached.IsAdmin ||
+			role.Access != cached.Access || role.Active != cached.Active {
+			report(HistoryRole, cached.OrgId+"/"+cached.RoleId, cached, role)
+		}
+	}
+	acctSession := &pbind.AcctManagerCallerSession{Contract: &p.permAcct.AcctManagerCaller, CallOpts: pending}
+	for _, cached := range types.AcctInfoMap.GetAcctList() {
+		acct, err := lookupAccount(acctSession, cached.AcctId)
+		if err != nil {
+			return nil, err
+		}
+		if acct == nil || acct.OrgId != cached.OrgId || acct.RoleId != cached.RoleId ||
+			acct.IsOrgAdmin != cached.IsOrgAdmin || acct.Status != cached.Status {
+			report(HistoryAccount, cached.AcctId.Hex(), cached, acct)
+		}
+	}
+	return mismatches, nil
+}
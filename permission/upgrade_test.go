@@ -0,0 +1,77 @@
+package permission
+
+import (
+	"testing"
+
+	"github.com/ethereum/quorum/accounts/abi/bind"
+	"github.com/ethereum/quorum/core/types"
+	"github.com/ethereum/quorum/internal/ethapi"
+	pbind "github.com/ethereum/quorum/permission/bind"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuorumControlsAPI_UpgradeImplementation(t *testing.T) {
+	testObject := typicalQuorumControlsAPI(t)
+	txa := ethapi.SendTxArgs{From: guardianAddress}
+
+	impl, _ := testObject.permCtrl.implementation()
+	assert.Equal(t, permImplAddress, impl)
+
+	_, err := testObject.UpgradeImplementation(impl, ethapi.SendTxArgs{From: getArbitraryAccount()})
+	assert.EqualError(t, err, ErrInvalidAccount.Msg)
+	assert.Equal(t, ErrNotGuardian, testObject.valUpgradeImplementation(impl, ethapi.SendTxArgs{From: getArbitraryAccount()}))
+
+	_, err = testObject.UpgradeImplementation(impl, txa)
+	assert.EqualError(t, err, ErrImplUnchanged.Msg)
+
+	_, err = testObject.UpgradeImplementation(getArbitraryAccount(), txa)
+	assert.EqualError(t, err, ErrInvalidImpl.Msg)
+
+	newImpl, _, _, err := pbind.DeployPermImpl(bind.NewKeyedTransactor(guardianKey), backend, permUpgrAddress, orgManagerAddress, roleManagerAddress, accountManagerAddress, voterManagerAddress, nodeManagerAddress)
+	assert.NoError(t, err)
+	_, err = testObject.UpgradeImplementation(newImpl, txa)
+	assert.NoError(t, err)
+
+	// the node follows the upgradable contract
+	assert.NoError(t, testObject.permCtrl.followImplementation())
+	impl, _ = testObject.permCtrl.implementation()
+	assert.Equal(t, newImpl, impl)
+	permImplAddress = newImpl
+
+	mismatches, err := testObject.CheckCaches()
+	assert.NoError(t, err)
+	for _, m := range mismatches {
+		assert.NotEqual(t, HistoryImplementation, m.Kind)
+	}
+}
+
+func TestQuorumControlsAPI_CheckCaches(t *testing.T) {
+	testObject := typicalQuorumControlsAPI(t)
+
+	mismatches, err := testObject.CheckCaches()
+	assert.NoError(t, err)
+	for _, m := range mismatches {
+		assert.NotEqual(t, guardianAddress.Hex(), m.Key)
+		assert.NotEqual(t, HistoryImplementation, m.Kind)
+	}
+
+	// entries missing or differing in the contracts are reported
+	acct := getArbitraryAccount()
+	types.AcctInfoMap.UpsertAccount(arbitraryNetworkAdminOrg, arbitraryNetworkAdminRole, acct, false, types.AcctActive)
+	cached := types.AcctInfoMap.GetAccount(guardianAddress)
+	types.AcctInfoMap.UpsertAccount(cached.OrgId, cached.RoleId, guardianAddress, cached.IsOrgAdmin, types.AcctSuspended)
+	defer types.AcctInfoMap.UpsertAccount(cached.OrgId, cached.RoleId, guardianAddress, cached.IsOrgAdmin, cached.Status)
+
+	mismatches, err = testObject.CheckCaches()
+	assert.NoError(t, err)
+	reported := make(map[string]CacheMismatch)
+	for _, m := range mismatches {
+		reported[m.Key] = m
+	}
+	if assert.Contains(t, reported, acct.Hex()) {
+		assert.Equal(t, (*types.AccountInfo)(nil), reported[acct.Hex()].Contract)
+	}
+	if assert.Contains(t, reported, guardianAddress.Hex()) {
+		assert.Equal(t, types.AcctActive, reported[guardianAddress.Hex()].Contract.(*types.AccountInfo).Status)
+	}
+}
@@ -37,6 +37,9 @@ type RaftService struct {
 	minter           *minter
 	nodeKey          *ecdsa.PrivateKey
 	calcGasLimitFunc func(block *types.Block) uint64
+
+	ethereum     *eth.Ethereum // used to hand block production over to Istanbul
+	istanbulOnce sync.Once
 }
 
 func New(ctx *node.ServiceContext, chainConfig *params.ChainConfig, raftId, raftPort uint16, joinExisting bool, blockTime time.Duration, e *eth.Ethereum, startPeers []*enode.Node, datadir string) (*RaftService, error) {
@@ -50,6 +53,7 @@ func New(ctx *node.ServiceContext, chainConfig *params.ChainConfig, raftId, raft
 		startPeers:       startPeers,
 		nodeKey:          ctx.NodeKey(),
 		calcGasLimitFunc: e.CalcGasLimit,
+		ethereum:         e,
 	}
 
 	service.minter = newMinter(chainConfig, service, blockTime)
@@ -89,9 +93,25 @@ func (service *RaftService) APIs() []rpc.API {
 // of the protocol.
 func (service *RaftService) Start(p2pServer *p2p.Server) error {
 	service.raftProtocolManager.Start(p2pServer)
+
+	// The node may be restarted after the chain moved on to Istanbul
+	if service.minter.reachedIstanbul(service.blockchain.CurrentBlock()) {
+		service.startIstanbul()
+	}
 	return nil
 }
 
+// startIstanbul hands block production over to the Istanbul engine once the
+// chain reached the last block before the Istanbul fork.
+func (service *RaftService) startIstanbul() {
+	service.istanbulOnce.Do(func() {
+		log.Info("Reached Istanbul fork, handing block production over to Istanbul", "number", service.blockchain.Config().IstanbulBlock)
+		if err := service.ethereum.StartMining(0); err != nil {
+			log.Error("Failed to start Istanbul block production", "err", err)
+		}
+	})
+}
+
 // Stop implements node.Service, stopping the background data propagation thread
 // of the protocol.
 func (service *RaftService) Stop() error {
@@ -18,6 +18,7 @@ import (
 	"github.com/ethereum/quorum/core"
 	"github.com/ethereum/quorum/core/types"
 	"github.com/ethereum/quorum/eth/downloader"
+	"github.com/ethereum/quorum/ethdb"
 	"github.com/ethereum/quorum/event"
 	"github.com/ethereum/quorum/log"
 	"github.com/ethereum/quorum/p2p"
@@ -30,7 +31,6 @@ import (
 	"github.com/coreos/etcd/rafthttp"
 	"github.com/ethereum/quorum/p2p/enode"
 	"github.com/ethereum/quorum/p2p/enr"
-	"github.com/syndtr/goleveldb/leveldb"
 
 	mapset "github.com/deckarep/golang-set"
 )
@@ -89,7 +89,7 @@ type ProtocolManager struct {
 	wal    *wal.WAL
 
 	// Storage
-	quorumRaftDb *leveldb.DB             // Persistent storage for last-applied raft index
+	quorumRaftDb ethdb.Database          // Persistent storage for last-applied raft index
 	raftStorage  *etcdRaft.MemoryStorage // Volatile raft storage
 }
 
@@ -564,10 +564,16 @@ func (pm *ProtocolManager) handleRoleChange(roleC <-chan interface{}) {
 	}
 }
 
+// minedBroadcastLoop proposes the blocks minted locally to raft. The blocks
+// sealed by Istanbul once the chain passed the Istanbul fork are propagated by
+// the eth protocol instead.
 func (pm *ProtocolManager) minedBroadcastLoop() {
 	for obj := range pm.minedBlockSub.Chan() {
 		switch ev := obj.Data.(type) {
 		case core.NewMinedBlockEvent:
+			if pm.blockchain.Config().IsIstanbul(ev.Block.Number()) {
+				continue
+			}
 			select {
 			case pm.blockProposalC <- ev.Block:
 			case <-pm.quitSync:
@@ -0,0 +1,62 @@
+package raft
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/quorum/consensus/ethash"
+	"github.com/ethereum/quorum/core"
+	"github.com/ethereum/quorum/core/types"
+	"github.com/ethereum/quorum/core/vm"
+	"github.com/ethereum/quorum/ethdb"
+	"github.com/ethereum/quorum/event"
+	"github.com/ethereum/quorum/params"
+)
+
+// Tests that the blocks minted before the Istanbul fork are proposed to raft,
+// and the blocks sealed by Istanbul after it are not.
+func TestMinedBroadcastLoopIstanbulFork(t *testing.T) {
+	var (
+		db     = ethdb.NewMemDatabase()
+		config = &params.ChainConfig{ChainID: big.NewInt(10), IstanbulBlock: big.NewInt(5)}
+		gspec  = &core.Genesis{Config: config}
+	)
+	gspec.MustCommit(db)
+	blockchain, err := core.NewBlockChain(db, nil, config, ethash.NewFaker(), vm.Config{}, nil)
+	if err != nil {
+		t.Fatalf("failed to create blockchain: %v", err)
+	}
+	defer blockchain.Stop()
+
+	mux := new(event.TypeMux)
+	pm := &ProtocolManager{
+		blockchain:     blockchain,
+		eventMux:       mux,
+		minedBlockSub:  mux.Subscribe(core.NewMinedBlockEvent{}),
+		blockProposalC: make(chan *types.Block, 2),
+		quitSync:       make(chan struct{}),
+	}
+	go pm.minedBroadcastLoop()
+	defer mux.Stop()
+
+	for _, number := range []int64{4, 5, 6} {
+		block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(number)})
+		if err := mux.Post(core.NewMinedBlockEvent{Block: block}); err != nil {
+			t.Fatalf("failed to post mined block: %v", err)
+		}
+	}
+	select {
+	case block := <-pm.blockProposalC:
+		if block.NumberU64() != 4 {
+			t.Fatalf("proposed block mismatch: have %d, want %d", block.NumberU64(), 4)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("block before the fork not proposed")
+	}
+	select {
+	case block := <-pm.blockProposalC:
+		t.Fatalf("block %d past the fork proposed", block.NumberU64())
+	case <-time.After(100 * time.Millisecond):
+	}
+}
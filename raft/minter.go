@@ -160,6 +160,10 @@ func (minter *minter) eventLoop() {
 		case ev := <-minter.chainHeadChan:
 			newHeadBlock := ev.Block
 
+			if minter.reachedIstanbul(newHeadBlock) {
+				minter.eth.startIstanbul()
+			}
+
 			if atomic.LoadInt32(&minter.minting) == 1 {
 				minter.updateSpeculativeChainPerNewHead(newHeadBlock)
 
@@ -281,14 +285,21 @@ func (minter *minter) createWork() *work {
 	}
 }
 
-func (minter *minter) getTransactions() *types.TransactionsByPriceAndNonce {
+// getTransactions returns the pending transactions which were not proposed yet
+// by the lane of their sender, in priority order.
+func (minter *minter) getTransactions() []*types.TransactionsByPriceAndNonce {
 	allAddrTxes, err := minter.eth.TxPool().Pending()
 	if err != nil { // TODO: handle
 		panic(err)
 	}
 	addrTxes := minter.speculativeChain.withoutProposedTxes(allAddrTxes)
 	signer := types.MakeSigner(minter.chain.Config(), minter.chain.CurrentBlock().Number())
-	return types.NewTransactionsByPriceAndNonce(signer, addrTxes)
+
+	var lanes []*types.TransactionsByPriceAndNonce
+	for _, laneTxes := range minter.eth.TxPool().SplitLanes(addrTxes) {
+		lanes = append(lanes, types.NewTransactionsByPriceAndNonce(signer, laneTxes))
+	}
+	return lanes
 }
 
 // Sends-off events asynchronously.
@@ -306,10 +317,21 @@ func (minter *minter) firePendingBlockEvents(logs []*types.Log) {
 	}()
 }
 
+// reachedIstanbul returns whether the block following head is produced by the
+// Istanbul engine instead of raft.
+func (minter *minter) reachedIstanbul(head *types.Block) bool {
+	return minter.config.IsIstanbul(new(big.Int).Add(head.Number(), common.Big1))
+}
+
 func (minter *minter) mintNewBlock() {
 	minter.mu.Lock()
 	defer minter.mu.Unlock()
 
+	if minter.reachedIstanbul(minter.speculativeChain.head) {
+		log.Info("Not minting a new block since Istanbul took over at the fork block")
+		return
+	}
+
 	work := minter.createWork()
 	transactions := minter.getTransactions()
 
@@ -367,7 +389,7 @@ func (minter *minter) mintNewBlock() {
 	log.Info("🔨  Mined block", "number", block.Number(), "hash", fmt.Sprintf("%x", block.Hash().Bytes()[:4]), "elapsed", elapsed)
 }
 
-func (env *work) commitTransactions(txes *types.TransactionsByPriceAndNonce, bc *core.BlockChain) (types.Transactions, types.Receipts, types.Receipts, []*types.Log) {
+func (env *work) commitTransactions(lanes []*types.TransactionsByPriceAndNonce, bc *core.BlockChain) (types.Transactions, types.Receipts, types.Receipts, []*types.Log) {
 	var allLogs []*types.Log
 	var committedTxes types.Transactions
 	var publicReceipts types.Receipts
@@ -376,33 +398,50 @@ func (env *work) commitTransactions(txes *types.TransactionsByPriceAndNonce, bc
 	gp := new(core.GasPool).AddGas(env.header.GasLimit)
 	txCount := 0
 
-	for {
-		tx := txes.Peek()
-		if tx == nil {
-			break
-		}
+	// Lanes are committed in priority order
+	for _, txes := range lanes {
+		for {
+			tx := txes.Peek()
+			if tx == nil {
+				break
+			}
 
-		env.publicState.Prepare(tx.Hash(), common.Hash{}, txCount)
+			// Skip the sender if it isn't permissioned for the transaction
+			from, err := types.Sender(types.MakeSigner(env.config, env.header.Number), tx)
+			if err != nil {
+				log.Info("TX with invalid signature, will be removed", "hash", tx.Hash(), "err", err)
+				txes.Pop()
+				continue
+			}
+			if err := core.CheckAccountAccess(env.config, types.CachedPermissions, env.header.Number, core.AccessTime(env.header), from, tx); err != nil {
+				log.Info("Unauthorized TX, will be removed", "hash", tx.Hash(), "err", err)
+				txes.Pop()
+				continue
+			}
 
-		publicReceipt, privateReceipt, err := env.commitTransaction(tx, bc, gp)
-		switch {
-		case err != nil:
-			log.Info("TX failed, will be removed", "hash", tx.Hash(), "err", err)
-			txes.Pop() // skip rest of txes from this account
-		default:
-			txCount++
-			committedTxes = append(committedTxes, tx)
+			env.publicState.Prepare(tx.Hash(), common.Hash{}, txCount)
 
-			publicReceipts = append(publicReceipts, publicReceipt)
-			allLogs = append(allLogs, publicReceipt.Logs...)
+			publicReceipt, privateReceipt, err := env.commitTransaction(tx, bc, gp)
+			switch {
+			case err != nil:
+				log.Info("TX failed, will be removed", "hash", tx.Hash(), "err", err)
+				txes.Pop() // skip rest of txes from this account
+			default:
+				txCount++
+				committedTxes = append(committedTxes, tx)
 
-			if privateReceipt != nil {
-				privateReceipts = append(privateReceipts, privateReceipt)
-				allLogs = append(allLogs, privateReceipt.Logs...)
-			}
+				publicReceipts = append(publicReceipts, publicReceipt)
+				allLogs = append(allLogs, publicReceipt.Logs...)
+
+				if privateReceipt != nil {
+					privateReceipts = append(privateReceipts, privateReceipt)
+					allLogs = append(allLogs, privateReceipt.Logs...)
+				}
 
-			txes.Shift()
+				txes.Shift()
+			}
 		}
+
 	}
 
 	return committedTxes, publicReceipts, privateReceipts, allLogs
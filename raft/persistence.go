@@ -3,40 +3,28 @@ package raft
 import (
 	"encoding/binary"
 
+	"github.com/ethereum/quorum/ethdb"
 	"github.com/ethereum/quorum/log"
-
-	"github.com/syndtr/goleveldb/leveldb"
-	"github.com/syndtr/goleveldb/leveldb/errors"
-	"github.com/syndtr/goleveldb/leveldb/opt"
-)
-
-var (
-	noFsync = &opt.WriteOptions{
-		NoWriteMerge: false,
-		Sync:         false,
-	}
 )
 
-func openQuorumRaftDb(path string) (db *leveldb.DB, err error) {
+func openQuorumRaftDb(path string) (ethdb.Database, error) {
 	// Open the db and recover any potential corruptions
-	db, err = leveldb.OpenFile(path, &opt.Options{
-		OpenFilesCacheCapacity: -1, // -1 means 0??
-		BlockCacheCapacity:     -1,
-	})
-	if _, corrupted := err.(*errors.ErrCorrupted); corrupted {
-		db, err = leveldb.RecoverFile(path, nil)
+	db, err := ethdb.NewLDBDatabase(path, 0, 0)
+	if err != nil {
+		return nil, err
 	}
-	return
+	return db, nil
 }
 
 func (pm *ProtocolManager) loadAppliedIndex() uint64 {
-	dat, err := pm.quorumRaftDb.Get(appliedDbKey, nil)
 	var lastAppliedIndex uint64
-	if err == errors.ErrNotFound {
-		lastAppliedIndex = 0
-	} else if err != nil {
+	if has, err := pm.quorumRaftDb.Has(appliedDbKey); err != nil {
 		fatalf("loadAppliedIndex error: %s", err)
-	} else {
+	} else if has {
+		dat, err := pm.quorumRaftDb.Get(appliedDbKey)
+		if err != nil {
+			fatalf("loadAppliedIndex error: %s", err)
+		}
 		lastAppliedIndex = binary.LittleEndian.Uint64(dat)
 	}
 
@@ -53,5 +41,5 @@ func (pm *ProtocolManager) writeAppliedIndex(index uint64) {
 	log.Info("persisted the latest applied index", "index", index)
 	buf := make([]byte, 8)
 	binary.LittleEndian.PutUint64(buf, index)
-	pm.quorumRaftDb.Put(appliedDbKey, buf, noFsync)
+	pm.quorumRaftDb.Put(appliedDbKey, buf)
 }
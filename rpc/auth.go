@@ -0,0 +1,259 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/ethereum/quorum/common"
+	"github.com/ethereum/quorum/common/hexutil"
+)
+
+var (
+	errNoCredentials  = errors.New("missing bearer token or client certificate")
+	errUnknownIssuer  = errors.New("unknown token issuer")
+	errUnknownClient  = errors.New("unknown client certificate")
+	errInvalidAccount = errors.New("invalid account claim")
+)
+
+// Identity is an authenticated RPC client.
+type Identity struct {
+	Subject string          // subject of the token or common name of the client certificate
+	Account *common.Address // account the identity is bound to, if any
+	Scopes  []string        // methods the identity may call, see Allows
+}
+
+// Allows reports whether the scopes of the identity cover the method of the
+// service. A scope is either "*", "<service>_*" or "<service>_<method>". The
+// rpc_modules method is always allowed.
+func (id *Identity) Allows(service, method string) bool {
+	if service == MetadataApi {
+		return true
+	}
+	for _, scope := range id.Scopes {
+		if scope == "*" || scope == service+serviceMethodSeparator+"*" || scope == service+serviceMethodSeparator+method {
+			return true
+		}
+	}
+	return false
+}
+
+type identityKey struct{}
+
+// IdentityFromContext returns the identity authenticated for the request, if
+// the server has an Authenticator.
+func IdentityFromContext(ctx context.Context) (*Identity, bool) {
+	id, ok := ctx.Value(identityKey{}).(*Identity)
+	return id, ok
+}
+
+// Authenticator authenticates the HTTP and websocket requests of a server.
+type Authenticator interface {
+	Authenticate(r *http.Request) (*Identity, error)
+}
+
+// Authorizer is called for the authenticated requests to the methods of an
+// API once the scopes of the identity allow the method.
+type Authorizer func(id *Identity, method string) error
+
+// unauthorizedError is returned for requests the identity is not allowed to
+// make.
+type unauthorizedError struct{ message string }
+
+func (e *unauthorizedError) ErrorCode() int { return -32001 }
+
+func (e *unauthorizedError) Error() string { return e.message }
+
+// AuthConfig configures the authentication of HTTP and websocket clients with
+// bearer JWTs signed by trusted issuers and with TLS client certificates.
+type AuthConfig struct {
+	Issuers []JWTIssuer `json:"issuers"`
+
+	// CertFile and KeyFile enable TLS on the endpoints, ClientCAFile verifies
+	// the client certificates of the Clients.
+	CertFile     string      `json:"certFile"`
+	KeyFile      string      `json:"keyFile"`
+	ClientCAFile string      `json:"clientCAFile"`
+	Clients      []TLSClient `json:"clients"`
+}
+
+// JWTIssuer is a trusted issuer of bearer tokens, identified by the iss claim.
+// Tokens are verified with the PEM encoded RSA or ECDSA public key in KeyFile
+// or with the hex encoded HMAC Secret. The scopes of a token are given by the
+// space separated scope claim and its account by the account claim.
+type JWTIssuer struct {
+	Issuer  string `json:"issuer"`
+	KeyFile string `json:"keyFile"`
+	Secret  string `json:"secret"`
+}
+
+// TLSClient maps the common name of a client certificate to an identity.
+type TLSClient struct {
+	CommonName string          `json:"commonName"`
+	Account    *common.Address `json:"account"`
+	Scopes     []string        `json:"scopes"`
+}
+
+// LoadAuthConfig reads a JSON AuthConfig.
+func LoadAuthConfig(file string) (*AuthConfig, error) {
+	blob, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	config := new(AuthConfig)
+	if err := json.Unmarshal(blob, config); err != nil {
+		return nil, fmt.Errorf("invalid auth config %s: %v", file, err)
+	}
+	return config, nil
+}
+
+// Authenticator creates the Authenticator of the config.
+func (c *AuthConfig) Authenticator() (Authenticator, error) {
+	a := &authenticator{issuers: make(map[string]interface{}), clients: make(map[string]*TLSClient)}
+	for _, issuer := range c.Issuers {
+		switch {
+		case issuer.Issuer == "":
+			return nil, errors.New("issuer without name")
+		case (issuer.KeyFile == "") == (issuer.Secret == ""):
+			return nil, fmt.Errorf("issuer %s needs either a key file or a secret", issuer.Issuer)
+		case issuer.Secret != "":
+			secret, err := hexutil.Decode(issuer.Secret)
+			if err != nil {
+				return nil, fmt.Errorf("invalid secret of issuer %s: %v", issuer.Issuer, err)
+			}
+			a.issuers[issuer.Issuer] = secret
+		default:
+			pem, err := ioutil.ReadFile(issuer.KeyFile)
+			if err != nil {
+				return nil, err
+			}
+			if key, err := jwt.ParseRSAPublicKeyFromPEM(pem); err == nil {
+				a.issuers[issuer.Issuer] = key
+			} else if key, err := jwt.ParseECPublicKeyFromPEM(pem); err == nil {
+				a.issuers[issuer.Issuer] = key
+			} else {
+				return nil, fmt.Errorf("invalid key of issuer %s: not a RSA or ECDSA public key", issuer.Issuer)
+			}
+		}
+	}
+	for i := range c.Clients {
+		a.clients[c.Clients[i].CommonName] = &c.Clients[i]
+	}
+	if len(a.clients) > 0 && c.ClientCAFile == "" {
+		return nil, errors.New("client certificates need a client CA file")
+	}
+	return a, nil
+}
+
+// ServerTLS returns the TLS config of the endpoints, nil if TLS is disabled.
+func (c *AuthConfig) ServerTLS() (*tls.Config, error) {
+	if c.CertFile == "" {
+		return nil, nil
+	}
+	cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	config := &tls.Config{Certificates: []tls.Certificate{cert}}
+	if c.ClientCAFile != "" {
+		pem, err := ioutil.ReadFile(c.ClientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		config.ClientCAs = x509.NewCertPool()
+		if !config.ClientCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate in %s", c.ClientCAFile)
+		}
+		// clients may authenticate with a token instead
+		config.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	return config, nil
+}
+
+// authenticator authenticates bearer tokens and verified client certificates.
+type authenticator struct {
+	issuers map[string]interface{} // issuer => []byte, *rsa.PublicKey or *ecdsa.PublicKey
+	clients map[string]*TLSClient
+}
+
+func (a *authenticator) Authenticate(r *http.Request) (*Identity, error) {
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		if !strings.HasPrefix(auth, "Bearer ") {
+			return nil, errors.New("unsupported authorization scheme")
+		}
+		return a.authenticateToken(strings.TrimPrefix(auth, "Bearer "))
+	}
+	if r.TLS != nil && len(r.TLS.VerifiedChains) > 0 {
+		client, ok := a.clients[r.TLS.VerifiedChains[0][0].Subject.CommonName]
+		if !ok {
+			return nil, errUnknownClient
+		}
+		return &Identity{Subject: client.CommonName, Account: client.Account, Scopes: client.Scopes}, nil
+	}
+	return nil, errNoCredentials
+}
+
+func (a *authenticator) authenticateToken(raw string) (*Identity, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(raw, claims, func(token *jwt.Token) (interface{}, error) {
+		issuer, _ := claims["iss"].(string)
+		key, ok := a.issuers[issuer]
+		if !ok {
+			return nil, errUnknownIssuer
+		}
+		// the key type decides the algorithms, tokens can't downgrade to HMAC
+		var valid bool
+		switch key.(type) {
+		case []byte:
+			_, valid = token.Method.(*jwt.SigningMethodHMAC)
+		default:
+			_, rsa := token.Method.(*jwt.SigningMethodRSA)
+			_, ecdsa := token.Method.(*jwt.SigningMethodECDSA)
+			valid = rsa || ecdsa
+		}
+		if !valid {
+			return nil, fmt.Errorf("unexpected signing method %v", token.Header["alg"])
+		}
+		return key, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	id := new(Identity)
+	id.Subject, _ = claims["sub"].(string)
+	if scope, ok := claims["scope"].(string); ok {
+		id.Scopes = strings.Fields(scope)
+	}
+	if account, ok := claims["account"]; ok {
+		s, _ := account.(string)
+		if !common.IsHexAddress(s) {
+			return nil, errInvalidAccount
+		}
+		addr := common.HexToAddress(s)
+		id.Account = &addr
+	}
+	return id, nil
+}
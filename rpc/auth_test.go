@@ -0,0 +1,149 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/ethereum/quorum/common"
+)
+
+var testAuthSecret = []byte("rpc auth test secret")
+
+func testToken(t *testing.T, issuer string, claims jwt.MapClaims) string {
+	claims["iss"] = issuer
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(testAuthSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+// bearerTransport adds a bearer token to the requests.
+type bearerTransport string
+
+func (b bearerTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r.Header.Set("Authorization", "Bearer "+string(b))
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func TestAuthHTTP(t *testing.T) {
+	server := newTestServer("service", new(Service))
+	config := &AuthConfig{Issuers: []JWTIssuer{{Issuer: "test", Secret: common.ToHex(testAuthSecret)}}}
+	authenticator, err := config.Authenticator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	server.SetAuthenticator(authenticator)
+	server.registerAuthorizer("service", func(id *Identity, method string) error {
+		if method == "rets" && id.Account == nil {
+			return errors.New("no account")
+		}
+		return nil
+	})
+	hs := httptest.NewServer(server)
+	defer hs.Close()
+
+	call := func(token, method string) error {
+		client, err := DialHTTPWithClient(hs.URL, &http.Client{Transport: bearerTransport(token)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer client.Close()
+		var result interface{}
+		return client.Call(&result, method)
+	}
+
+	// unauthenticated requests are rejected
+	client, _ := DialHTTP(hs.URL)
+	if err := client.Call(nil, "rpc_modules"); err == nil || !strings.Contains(err.Error(), errNoCredentials.Error()) {
+		t.Errorf("expected %v, got %v", errNoCredentials, err)
+	}
+	if err := call(testToken(t, "other", jwt.MapClaims{"scope": "*"}), "rpc_modules"); err == nil {
+		t.Error("token of unknown issuer accepted")
+	}
+	expired := testToken(t, "test", jwt.MapClaims{"scope": "*", "exp": time.Now().Add(-time.Minute).Unix()})
+	if err := call(expired, "rpc_modules"); err == nil {
+		t.Error("expired token accepted")
+	}
+
+	// scopes restrict the methods
+	token := testToken(t, "test", jwt.MapClaims{"sub": "alice", "scope": "service_noArgsRets"})
+	if err := call(token, "rpc_modules"); err != nil {
+		t.Error("rpc_modules:", err)
+	}
+	if err := call(token, "service_noArgsRets"); err != nil {
+		t.Error("service_noArgsRets:", err)
+	}
+	if err := call(token, "service_rets"); err == nil || err.Error() != "service_rets not allowed for alice" {
+		t.Error("service_rets: unexpected error", err)
+	}
+
+	// the authorizer of the service is called once the scopes allow the method
+	token = testToken(t, "test", jwt.MapClaims{"scope": "service_*"})
+	if err := call(token, "service_rets"); err == nil || err.Error() != "no account" {
+		t.Error("service_rets: unexpected error", err)
+	}
+	token = testToken(t, "test", jwt.MapClaims{"scope": "service_*", "account": "0x0000000000000000000000000000000000000001"})
+	if err := call(token, "service_rets"); err != nil {
+		t.Error("service_rets:", err)
+	}
+	if err := call(testToken(t, "test", jwt.MapClaims{"account": "1"}), "rpc_modules"); err == nil {
+		t.Error("invalid account accepted")
+	}
+}
+
+func TestAuthClientCertificate(t *testing.T) {
+	account := common.HexToAddress("0x0000000000000000000000000000000000000002")
+	config := &AuthConfig{ClientCAFile: "ca.pem", Clients: []TLSClient{{CommonName: "client", Account: &account, Scopes: []string{"*"}}}}
+	authenticator, err := config.Authenticator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	request := func(cn string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "https://node", nil)
+		cert := &x509.Certificate{Subject: pkix.Name{CommonName: cn}}
+		r.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}
+		return r
+	}
+
+	id, err := authenticator.Authenticate(request("client"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id.Subject != "client" || *id.Account != account || !id.Allows("admin", "peers") {
+		t.Errorf("unexpected identity %+v", id)
+	}
+	if _, err := authenticator.Authenticate(request("other")); err != errUnknownClient {
+		t.Errorf("expected %v, got %v", errUnknownClient, err)
+	}
+	// certificates that were not verified don't authenticate
+	r := request("client")
+	r.TLS.VerifiedChains = nil
+	if _, err := authenticator.Authenticate(r); err != errNoCredentials {
+		t.Errorf("expected %v, got %v", errNoCredentials, err)
+	}
+}
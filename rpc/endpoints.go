@@ -17,13 +17,15 @@
 package rpc
 
 import (
+	"crypto/tls"
 	"net"
 
 	"github.com/ethereum/quorum/log"
 )
 
-// StartHTTPEndpoint starts the HTTP RPC endpoint, configured with cors/vhosts/modules
-func StartHTTPEndpoint(endpoint string, apis []API, modules []string, cors []string, vhosts []string, timeouts HTTPTimeouts) (net.Listener, *Server, error) {
+// StartHTTPEndpoint starts the HTTP RPC endpoint, configured with cors/vhosts/modules.
+// Requests are authenticated if auth is not nil.
+func StartHTTPEndpoint(endpoint string, apis []API, modules []string, cors []string, vhosts []string, timeouts HTTPTimeouts, auth *AuthConfig) (net.Listener, *Server, error) {
 	// Generate the whitelist based on the allowed modules
 	whitelist := make(map[string]bool)
 	for _, module := range modules {
@@ -44,15 +46,16 @@ func StartHTTPEndpoint(endpoint string, apis []API, modules []string, cors []str
 		listener net.Listener
 		err      error
 	)
-	if listener, err = net.Listen("tcp", endpoint); err != nil {
+	if listener, err = listenAuth(endpoint, apis, handler, auth); err != nil {
 		return nil, nil, err
 	}
 	go NewHTTPServer(cors, vhosts, timeouts, handler).Serve(listener)
 	return listener, handler, err
 }
 
-// StartWSEndpoint starts a websocket endpoint
-func StartWSEndpoint(endpoint string, apis []API, modules []string, wsOrigins []string, exposeAll bool) (net.Listener, *Server, error) {
+// StartWSEndpoint starts a websocket endpoint. Connections are authenticated if
+// auth is not nil.
+func StartWSEndpoint(endpoint string, apis []API, modules []string, wsOrigins []string, exposeAll bool, auth *AuthConfig) (net.Listener, *Server, error) {
 
 	// Generate the whitelist based on the allowed modules
 	whitelist := make(map[string]bool)
@@ -74,7 +77,7 @@ func StartWSEndpoint(endpoint string, apis []API, modules []string, wsOrigins []
 		listener net.Listener
 		err      error
 	)
-	if listener, err = net.Listen("tcp", endpoint); err != nil {
+	if listener, err = listenAuth(endpoint, apis, handler, auth); err != nil {
 		return nil, nil, err
 	}
 	go NewWSServer(wsOrigins, handler).Serve(listener)
@@ -100,3 +103,32 @@ func StartIPCEndpoint(ipcEndpoint string, apis []API) (net.Listener, *Server, er
 	go handler.ServeListener(listener)
 	return listener, handler, nil
 }
+
+// listenAuth sets up the authentication of the handler and starts the TCP
+// listener, serving TLS if the auth config has a certificate.
+func listenAuth(endpoint string, apis []API, handler *Server, auth *AuthConfig) (net.Listener, error) {
+	var tlsConfig *tls.Config
+	if auth != nil {
+		authenticator, err := auth.Authenticator()
+		if err != nil {
+			return nil, err
+		}
+		if tlsConfig, err = auth.ServerTLS(); err != nil {
+			return nil, err
+		}
+		handler.SetAuthenticator(authenticator)
+		for _, api := range apis {
+			if api.Authorize != nil {
+				handler.registerAuthorizer(api.Namespace, api.Authorize)
+			}
+		}
+	}
+	listener, err := net.Listen("tcp", endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig != nil {
+		listener = tls.NewListener(listener, tlsConfig)
+	}
+	return listener, nil
+}
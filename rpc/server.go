@@ -116,6 +116,44 @@ func (s *Server) RegisterName(name string, rcvr interface{}) error {
 	return nil
 }
 
+// SetAuthenticator makes the server authenticate HTTP and websocket requests
+// and only serve the methods allowed to the identity. It must be called before
+// the server serves requests.
+func (s *Server) SetAuthenticator(a Authenticator) {
+	s.authenticator = a
+}
+
+// registerAuthorizer sets the authorization of the authenticated requests to
+// the methods of a service.
+func (s *Server) registerAuthorizer(name string, authorize Authorizer) {
+	if s.authorizers == nil {
+		s.authorizers = make(map[string]Authorizer)
+	}
+	s.authorizers[name] = authorize
+}
+
+// authorize checks that the request is allowed to the authenticated identity.
+// Requests are not checked if the server has no Authenticator or is served
+// over a transport without authentication.
+func (s *Server) authorize(ctx context.Context, req *serverRequest) Error {
+	if s.authenticator == nil {
+		return nil
+	}
+	id, ok := IdentityFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	if !id.Allows(req.svcname, req.method) {
+		return &unauthorizedError{fmt.Sprintf("%s%s%s not allowed for %s", req.svcname, serviceMethodSeparator, req.method, id.Subject)}
+	}
+	if authorize := s.authorizers[req.svcname]; authorize != nil {
+		if err := authorize(id, req.method); err != nil {
+			return &unauthorizedError{err.Error()}
+		}
+	}
+	return nil
+}
+
 // serveRequest will reads requests from the codec, calls the RPC callback and
 // writes the response to the given codec.
 //
@@ -212,8 +250,12 @@ func (s *Server) serveRequest(ctx context.Context, codec ServerCodec, singleShot
 // response back using the given codec. It will block until the codec is closed or the server is
 // stopped. In either case the codec is closed.
 func (s *Server) ServeCodec(codec ServerCodec, options CodecOption) {
+	s.serveCodec(context.Background(), codec, options)
+}
+
+func (s *Server) serveCodec(ctx context.Context, codec ServerCodec, options CodecOption) {
 	defer codec.Close()
-	s.serveRequest(context.Background(), codec, false, options)
+	s.serveRequest(ctx, codec, false, options)
 }
 
 // ServeSingleRequest reads and processes a single RPC request from the given codec. It will not
@@ -274,6 +316,10 @@ func (s *Server) handle(ctx context.Context, codec ServerCodec, req *serverReque
 		return codec.CreateErrorResponse(&req.id, &invalidParamsError{"Expected subscription id as first argument"}), nil
 	}
 
+	if err := s.authorize(ctx, req); err != nil {
+		return codec.CreateErrorResponse(&req.id, err), nil
+	}
+
 	if req.callb.isSubscribe {
 		subid, err := s.createSubscription(ctx, codec, req)
 		if err != nil {
@@ -411,7 +457,7 @@ func (s *Server) readRequest(codec ServerCodec) ([]*serverRequest, bool, Error)
 
 		if r.isPubSub { // eth_subscribe, r.method contains the subscription method name
 			if callb, ok := svc.subscriptions[r.method]; ok {
-				requests[i] = &serverRequest{id: r.id, svcname: svc.name, callb: callb}
+				requests[i] = &serverRequest{id: r.id, svcname: svc.name, method: r.method, callb: callb}
 				if r.params != nil && len(callb.argTypes) > 0 {
 					argTypes := []reflect.Type{reflect.TypeOf("")}
 					argTypes = append(argTypes, callb.argTypes...)
@@ -428,7 +474,7 @@ func (s *Server) readRequest(codec ServerCodec) ([]*serverRequest, bool, Error)
 		}
 
 		if callb, ok := svc.callbacks[r.method]; ok { // lookup RPC method
-			requests[i] = &serverRequest{id: r.id, svcname: svc.name, callb: callb}
+			requests[i] = &serverRequest{id: r.id, svcname: svc.name, method: r.method, callb: callb}
 			if r.params != nil && len(callb.argTypes) > 0 {
 				if args, err := codec.ParseRequestArguments(callb.argTypes, r.params); err == nil {
 					requests[i].args = args
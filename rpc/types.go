@@ -33,6 +33,7 @@ type API struct {
 	Version   string      // api version for DApp's
 	Service   interface{} // receiver instance which holds the methods
 	Public    bool        // indication if the methods must be considered safe for public use
+	Authorize Authorizer  // optional authorization of authenticated requests
 }
 
 // callback is a method callback which was registered in the server
@@ -57,6 +58,7 @@ type service struct {
 type serverRequest struct {
 	id            interface{}
 	svcname       string
+	method        string
 	callb         *callback
 	args          []reflect.Value
 	isUnsubscribe bool
@@ -74,6 +76,9 @@ type Server struct {
 	run      int32
 	codecsMu sync.Mutex
 	codecs   mapset.Set
+
+	authenticator Authenticator
+	authorizers   map[string]Authorizer
 }
 
 // rpcRequest represents a raw incoming RPC request
@@ -57,8 +57,21 @@ var websocketJSONCodec = websocket.Codec{
 // allowedOrigins should be a comma-separated list of allowed origin URLs.
 // To allow connections with any origin, pass "*".
 func (srv *Server) WebsocketHandler(allowedOrigins []string) http.Handler {
+	validateOrigin := wsHandshakeValidator(allowedOrigins)
 	return websocket.Server{
-		Handshake: wsHandshakeValidator(allowedOrigins),
+		Handshake: func(cfg *websocket.Config, req *http.Request) error {
+			if err := validateOrigin(cfg, req); err != nil {
+				return err
+			}
+			// reject the connection before upgrading it
+			if srv.authenticator != nil {
+				if _, err := srv.authenticator.Authenticate(req); err != nil {
+					log.Debug("Websocket authentication failed", "remote", req.RemoteAddr, "err", err)
+					return err
+				}
+			}
+			return nil
+		},
 		Handler: func(conn *websocket.Conn) {
 			// Create a custom encode/decode pair to enforce payload size and number encoding
 			conn.MaxPayloadBytes = maxRequestContentLength
@@ -69,7 +82,16 @@ func (srv *Server) WebsocketHandler(allowedOrigins []string) http.Handler {
 			decoder := func(v interface{}) error {
 				return websocketJSONCodec.Receive(conn, v)
 			}
-			srv.ServeCodec(NewCodec(conn, encoder, decoder), OptionMethodInvocation|OptionSubscriptions)
+			ctx := context.Background()
+			if srv.authenticator != nil {
+				id, err := srv.authenticator.Authenticate(conn.Request())
+				if err != nil {
+					conn.Close()
+					return
+				}
+				ctx = context.WithValue(ctx, identityKey{}, id)
+			}
+			srv.serveCodec(ctx, NewCodec(conn, encoder, decoder), OptionMethodInvocation|OptionSubscriptions)
 		},
 	}
 }